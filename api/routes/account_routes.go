@@ -361,11 +361,12 @@ func (h *AccountHandler) FilterAccounts(c *fiber.Ctx) error {
 
 // GetAccountSummary godoc
 // @Summary Get account summary
-// @Description Get summary statistics for all user accounts
+// @Description Get summary statistics for all user accounts with every account and asset valued in a base currency
 // @Tags accounts
 // @Accept json
 // @Produce json
 // @Security BearerAuth
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
 // @Success 200 {object} map[string]presentation.AccountSummaryResponse
 // @Failure 401 {object} map[string]string
 // @Router /accounts/summary [get]
@@ -378,7 +379,8 @@ func (h *AccountHandler) GetAccountSummary(c *fiber.Ctx) error {
 	}
 
 	query := account.GetAccountSummaryQuery{
-		UserID: userIDValue.String(),
+		UserID:       userIDValue.String(),
+		BaseCurrency: c.Query("base"),
 	}
 
 	summary, err := h.accountService.HandleGetAccountSummaryQuery(c.Context(), query)
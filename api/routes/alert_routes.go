@@ -0,0 +1,445 @@
+package routes
+
+import (
+	"strconv"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/alert"
+	presentation "siyahsensei/wallet-service/presentation/alert"
+)
+
+type AlertHandler struct {
+	alertService *alert.Handler
+}
+
+func NewAlertHandler(alertService *alert.Handler) *AlertHandler {
+	return &AlertHandler{
+		alertService: alertService,
+	}
+}
+
+func (h *AlertHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	alertGroup := router.Group("/alerts", authMiddleware)
+
+	alertGroup.Post("/", h.CreateAlert)
+	alertGroup.Get("/", h.GetUserAlerts)
+	alertGroup.Get("/inbox", h.GetInbox)
+	alertGroup.Post("/inbox/read", h.MarkInboxRead)
+	alertGroup.Post("/inbox/:id/read", h.MarkNotificationRead)
+	alertGroup.Get("/:id", h.GetAlertByID)
+	alertGroup.Put("/:id", h.UpdateAlert)
+	alertGroup.Delete("/:id", h.DeleteAlert)
+	alertGroup.Get("/:id/triggers", h.GetTriggers)
+}
+
+// CreateAlert godoc
+// @Summary Create an alert
+// @Description Watch the price of a definition (PRICE_ABOVE, PRICE_BELOW, or PRICE_CHANGE for a move of at least the threshold percent in 24 hours) or the portfolio (NET_WORTH_BELOW, or ACCOUNT_SHARE_ABOVE for an account holding more than the threshold percent of the gross assets). Alerts are checked whenever new prices are recorded and fire once each time their condition becomes true, over the INBOX (default), WEBHOOK and EMAIL channels. Webhook URLs must resolve to public addresses and redirects are not followed. Webhook deliveries are signed like event webhooks, with X-Wallet-Timestamp and X-Wallet-Signature keyed with the webhookSecret returned here. Email alerts are only sent to the account email address
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param alert body presentation.CreateAlertRequest true "Alert data"
+// @Success 201 {object} map[string]presentation.AlertResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts [post]
+func (h *AlertHandler) CreateAlert(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateAlertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := alert.CreateAlertCommand{
+		UserID:       userIDValue.String(),
+		Name:         req.Name,
+		Kind:         alert.Kind(req.Kind),
+		DefinitionID: req.DefinitionID,
+		AccountID:    req.AccountID,
+		Currency:     req.Currency,
+		Threshold:    req.Threshold,
+		Channels:     toChannels(req.Channels),
+		WebhookURL:   req.WebhookURL,
+		Email:        req.Email,
+	}
+
+	a, err := h.alertService.HandleCreateAlertCommand(c.Context(), command)
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"alert": presentation.ToSavedAlertResponse(a),
+	})
+}
+
+// GetUserAlerts godoc
+// @Summary List alerts
+// @Description List the alerts of the authenticated user with the value each was last checked against
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.AlertsListResponse
+// @Failure 401 {object} map[string]string
+// @Router /alerts [get]
+func (h *AlertHandler) GetUserAlerts(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	alerts, err := h.alertService.HandleGetUserAlertsQuery(c.Context(), alert.GetUserAlertsQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	var alertResponses []presentation.AlertResponse
+	for _, a := range alerts {
+		alertResponses = append(alertResponses, presentation.ToAlertResponse(a))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.AlertsListResponse{
+		Alerts: alertResponses,
+		Total:  len(alertResponses),
+	})
+}
+
+// GetInbox godoc
+// @Summary Get the alert inbox
+// @Description List the triggered alerts delivered to the in-app inbox, newest first, with the number still unread
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param unread query bool false "Only unread notifications"
+// @Param limit query int false "Limit number of results"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} presentation.InboxResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /alerts/inbox [get]
+func (h *AlertHandler) GetInbox(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	unreadOnly, err := strconv.ParseBool(c.Query("unread", "false"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid unread",
+		})
+	}
+
+	query := alert.GetInboxQuery{
+		UserID:     userIDValue.String(),
+		UnreadOnly: unreadOnly,
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			query.Limit = val
+		}
+	}
+	if offset := c.Query("offset"); offset != "" {
+		if val, err := strconv.Atoi(offset); err == nil {
+			query.Offset = val
+		}
+	}
+
+	triggers, unread, err := h.alertService.HandleGetInboxQuery(c.Context(), query)
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	var notifications []presentation.TriggerResponse
+	for _, t := range triggers {
+		notifications = append(notifications, presentation.ToTriggerResponse(t))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.InboxResponse{
+		Notifications: notifications,
+		Total:         len(notifications),
+		Unread:        unread,
+	})
+}
+
+// MarkInboxRead godoc
+// @Summary Mark the whole inbox as read
+// @Description Mark every unread notification in the alert inbox as read
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} map[string]int
+// @Failure 401 {object} map[string]string
+// @Router /alerts/inbox/read [post]
+func (h *AlertHandler) MarkInboxRead(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	marked, err := h.alertService.HandleMarkReadCommand(c.Context(), alert.MarkReadCommand{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"marked": marked,
+	})
+}
+
+// MarkNotificationRead godoc
+// @Summary Mark a notification as read
+// @Description Mark one notification of the alert inbox as read
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Notification ID"
+// @Success 200 {object} map[string]int
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts/inbox/{id}/read [post]
+func (h *AlertHandler) MarkNotificationRead(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	marked, err := h.alertService.HandleMarkReadCommand(c.Context(), alert.MarkReadCommand{
+		UserID:    userIDValue.String(),
+		TriggerID: c.Params("id"),
+	})
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"marked": marked,
+	})
+}
+
+// GetAlertByID godoc
+// @Summary Get alert by ID
+// @Description Get a specific alert by ID for the authenticated user
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Success 200 {object} map[string]presentation.AlertResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts/{id} [get]
+func (h *AlertHandler) GetAlertByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	a, err := h.alertService.HandleGetAlertByIDQuery(c.Context(), alert.GetAlertByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"alert": presentation.ToAlertResponse(a),
+	})
+}
+
+// UpdateAlert godoc
+// @Summary Update an alert
+// @Description Change the threshold, currency, channels or enabled state of an alert. Changing the condition arms the alert again. The response carries the webhook signing secret, generated the first time the alert uses the WEBHOOK channel
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Param alert body presentation.UpdateAlertRequest true "Alert data"
+// @Success 200 {object} map[string]presentation.AlertResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts/{id} [put]
+func (h *AlertHandler) UpdateAlert(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateAlertRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := alert.UpdateAlertCommand{
+		ID:         c.Params("id"),
+		UserID:     userIDValue.String(),
+		Name:       req.Name,
+		Currency:   req.Currency,
+		Threshold:  req.Threshold,
+		Channels:   toChannels(req.Channels),
+		WebhookURL: req.WebhookURL,
+		Email:      req.Email,
+		Enabled:    req.Enabled,
+	}
+
+	a, err := h.alertService.HandleUpdateAlertCommand(c.Context(), command)
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"alert": presentation.ToSavedAlertResponse(a),
+	})
+}
+
+// DeleteAlert godoc
+// @Summary Delete an alert
+// @Description Delete an alert together with its trigger history and inbox notifications
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts/{id} [delete]
+func (h *AlertHandler) DeleteAlert(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.alertService.HandleDeleteAlertCommand(c.Context(), alert.DeleteAlertCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetTriggers godoc
+// @Summary List the triggers of an alert
+// @Description List every time the alert fired, newest first, with the outcome of each channel delivery
+// @Tags alerts
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Alert ID"
+// @Param limit query int false "Limit number of results"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} presentation.TriggersListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /alerts/{id}/triggers [get]
+func (h *AlertHandler) GetTriggers(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := alert.GetTriggersQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			query.Limit = val
+		}
+	}
+	if offset := c.Query("offset"); offset != "" {
+		if val, err := strconv.Atoi(offset); err == nil {
+			query.Offset = val
+		}
+	}
+
+	triggers, err := h.alertService.HandleGetTriggersQuery(c.Context(), query)
+	if err != nil {
+		return alertError(c, err)
+	}
+
+	var triggerResponses []presentation.TriggerResponse
+	for _, t := range triggers {
+		triggerResponses = append(triggerResponses, presentation.ToTriggerResponse(t))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.TriggersListResponse{
+		Triggers: triggerResponses,
+		Total:    len(triggerResponses),
+	})
+}
+
+func toChannels(values []string) []alert.Channel {
+	channels := make([]alert.Channel, 0, len(values))
+	for _, v := range values {
+		channels = append(channels, alert.Channel(v))
+	}
+	return channels
+}
+
+func alertError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "alert not found", "unauthorized: alert does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Alert not found",
+		})
+	case "notification not found", "unauthorized: notification does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Notification not found",
+		})
+	case "definition not found", "account not found", "unauthorized: account does not belong to user", "user not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -24,10 +24,12 @@ func (h *AssetHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.
 	assetGroup := router.Group("/assets", authMiddleware)
 
 	assetGroup.Post("/", h.CreateAsset)
+	assetGroup.Post("/transfer", h.TransferAsset)
 	assetGroup.Put("/:id", h.UpdateAsset)
 	assetGroup.Delete("/:id", h.DeleteAsset)
 	assetGroup.Get("/", h.GetUserAssets)
 	assetGroup.Get("/filter", h.FilterAssets)
+	assetGroup.Get("/performance", h.GetAssetPerformance)
 	assetGroup.Get("/:id", h.GetAssetByID)
 }
 
@@ -44,7 +46,7 @@ func (h *AssetHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.
 // @Failure 401 {object} map[string]string
 // @Router /assets [post]
 func (h *AssetHandler) CreateAsset(c *fiber.Ctx) error {
-	userIDValue, ok := c.Locals("userID").(string)
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
 	if !ok {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 			"error": "Unauthorized",
@@ -60,13 +62,16 @@ func (h *AssetHandler) CreateAsset(c *fiber.Ctx) error {
 
 	// Map request to command with UserID from JWT token
 	command := asset.CreateAssetCommand{
-		UserID:       userIDValue,
-		AccountID:    req.AccountID,
-		DefinitionID: req.DefinitionID,
-		Type:         req.Type,
-		Quantity:     req.Quantity,
-		Notes:        req.Notes,
-		PurchaseDate: req.PurchaseDate,
+		UserID:           userIDValue.String(),
+		AccountID:        req.AccountID,
+		DefinitionID:     req.DefinitionID,
+		Type:             req.Type,
+		Quantity:         req.Quantity,
+		Notes:            req.Notes,
+		PurchaseDate:     req.PurchaseDate,
+		PurchasePrice:    req.PurchasePrice,
+		PurchaseCurrency: req.PurchaseCurrency,
+		CostBasisMethod:  req.CostBasisMethod,
 	}
 
 	createdAsset, err := h.assetService.HandleCreateAssetCommand(c.Context(), command)
@@ -83,7 +88,7 @@ func (h *AssetHandler) CreateAsset(c *fiber.Ctx) error {
 
 // UpdateAsset godoc
 // @Summary Update an asset
-// @Description Update an existing asset for the authenticated user
+// @Description Update an existing asset for the authenticated user. Quantity changes go through /transactions
 // @Tags assets
 // @Accept json
 // @Produce json
@@ -96,7 +101,7 @@ func (h *AssetHandler) CreateAsset(c *fiber.Ctx) error {
 // @Failure 404 {object} map[string]string
 // @Router /assets/{id} [put]
 func (h *AssetHandler) UpdateAsset(c *fiber.Ctx) error {
-	userIDValue, ok := c.Locals("userID").(string)
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
 	if !ok {
 		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
 			"error": "Unauthorized",
@@ -119,14 +124,14 @@ func (h *AssetHandler) UpdateAsset(c *fiber.Ctx) error {
 
 	// Map request to command with UserID from JWT token and ID from URL params
 	command := asset.UpdateAssetCommand{
-		ID:           assetID,
-		UserID:       userIDValue,
-		AccountID:    req.AccountID,
-		DefinitionID: req.DefinitionID,
-		Type:         req.Type,
-		Quantity:     req.Quantity,
-		Notes:        req.Notes,
-		PurchaseDate: req.PurchaseDate,
+		ID:              assetID,
+		UserID:          userIDValue.String(),
+		AccountID:       req.AccountID,
+		DefinitionID:    req.DefinitionID,
+		Type:            req.Type,
+		Notes:           req.Notes,
+		PurchaseDate:    req.PurchaseDate,
+		CostBasisMethod: req.CostBasisMethod,
 	}
 
 	updatedAsset, err := h.assetService.HandleUpdateAssetCommand(c.Context(), command)
@@ -151,6 +156,67 @@ func (h *AssetHandler) UpdateAsset(c *fiber.Ctx) error {
 	})
 }
 
+// TransferAsset godoc
+// @Summary Transfer an asset between accounts
+// @Description Atomically move a quantity of an asset into the same definition in another account of the authenticated user, with an optional fee charged on the source
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param transfer body presentation.TransferAssetRequest true "Transfer data"
+// @Success 201 {object} map[string]presentation.TransferResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /assets/transfer [post]
+func (h *AssetHandler) TransferAsset(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.TransferAssetRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := asset.TransferAssetCommand{
+		UserID:          userIDValue.String(),
+		SourceAssetID:   req.SourceAssetID,
+		TargetAccountID: req.TargetAccountID,
+		Quantity:        req.Quantity,
+		Fee:             req.Fee,
+		Notes:           req.Notes,
+		TransferDate:    req.TransferDate,
+	}
+
+	result, err := h.assetService.HandleTransferAssetCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "asset not found" || err.Error() == "account not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		if err.Error() == "unauthorized: asset does not belong to user" || err.Error() == "unauthorized: account does not belong to user" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"transfer": presentation.ToTransferResponse(result),
+	})
+}
+
 // DeleteAsset godoc
 // @Summary Delete an asset
 // @Description Delete an existing asset for the authenticated user
@@ -386,3 +452,68 @@ func (h *AssetHandler) FilterAssets(c *fiber.Ctx) error {
 		Total:  len(assetResponses),
 	})
 }
+
+// GetAssetPerformance godoc
+// @Summary Get asset performance
+// @Description Get initial value, current value, realized and unrealized profit/loss per asset of the authenticated user over a date range. Holdings, open cost and prices are taken as of the end date; costs booked in other currencies are converted with the exchange rates known then
+// @Tags assets
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param startDate query string false "Start Date (RFC3339), defaults to the beginning of time"
+// @Param endDate query string false "End Date (RFC3339), defaults to now"
+// @Success 200 {object} presentation.AssetPerformanceListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /assets/performance [get]
+func (h *AssetHandler) GetAssetPerformance(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := asset.GetAssetPerformanceQuery{
+		UserID:    userIDValue.String(),
+		StartDate: time.Unix(0, 0),
+		EndDate:   time.Now(),
+	}
+
+	if startDate := c.Query("startDate"); startDate != "" {
+		val, err := time.Parse(time.RFC3339, startDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid start date",
+			})
+		}
+		query.StartDate = val
+	}
+
+	if endDate := c.Query("endDate"); endDate != "" {
+		val, err := time.Parse(time.RFC3339, endDate)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid end date",
+			})
+		}
+		query.EndDate = val
+	}
+
+	performances, err := h.assetService.HandleGetAssetPerformanceQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var performanceResponses []presentation.AssetPerformanceResponse
+	for _, p := range performances {
+		performanceResponses = append(performanceResponses, presentation.ToAssetPerformanceResponse(p))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.AssetPerformanceListResponse{
+		Performance: performanceResponses,
+		Total:       len(performanceResponses),
+	})
+}
@@ -234,7 +234,7 @@ func (h *AuthRoute) ChangePassword(c *fiber.Ctx) error {
 
 // DeleteUser godoc
 // @Summary Delete user account
-// @Description Delete current authenticated user account
+// @Description Delete current authenticated user account and all of its data. GET /me/export downloads the data beforehand
 // @Tags auth
 // @Accept json
 // @Produce json
@@ -0,0 +1,419 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/bond"
+	presentation "siyahsensei/wallet-service/presentation/bond"
+)
+
+type BondHandler struct {
+	bondService *bond.Handler
+}
+
+func NewBondHandler(bondService *bond.Handler) *BondHandler {
+	return &BondHandler{
+		bondService: bondService,
+	}
+}
+
+func (h *BondHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	bondGroup := router.Group("/bonds", authMiddleware)
+
+	bondGroup.Post("/", h.CreateBond)
+	bondGroup.Get("/", h.GetUserBonds)
+	bondGroup.Get("/coupons", h.GetCoupons)
+	bondGroup.Get("/:id", h.GetBondByID)
+	bondGroup.Put("/:id", h.UpdateBond)
+	bondGroup.Delete("/:id", h.DeleteBond)
+	bondGroup.Get("/:id/coupons", h.GetBondCoupons)
+	bondGroup.Get("/:id/pricing", h.GetPricing)
+}
+
+// CreateBond godoc
+// @Summary Set the terms of a bond
+// @Description Attach face value, coupon, issue and maturity dates and day count convention to a BOND asset
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param bond body presentation.CreateBondRequest true "Bond data"
+// @Success 201 {object} map[string]presentation.BondResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds [post]
+func (h *BondHandler) CreateBond(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateBondRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := bond.CreateBondCommand{
+		UserID:          userIDValue.String(),
+		AssetID:         req.AssetID,
+		FaceValue:       req.FaceValue,
+		Currency:        req.Currency,
+		CouponRate:      req.CouponRate,
+		CouponFrequency: req.CouponFrequency,
+		IssueDate:       req.IssueDate,
+		MaturityDate:    req.MaturityDate,
+		DayCount:        req.DayCount,
+	}
+
+	createdBond, err := h.bondService.HandleCreateBondCommand(c.Context(), command)
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"bond": presentation.ToBondResponse(createdBond),
+	})
+}
+
+// GetUserBonds godoc
+// @Summary List bonds
+// @Description List the bonds of the authenticated user, soonest maturity first
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.BondsListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /bonds [get]
+func (h *BondHandler) GetUserBonds(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	bonds, err := h.bondService.HandleGetUserBondsQuery(c.Context(), bond.GetUserBondsQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var bondResponses []presentation.BondResponse
+	for _, b := range bonds {
+		bondResponses = append(bondResponses, presentation.ToBondResponse(b))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.BondsListResponse{
+		Bonds: bondResponses,
+		Total: len(bondResponses),
+	})
+}
+
+// GetCoupons godoc
+// @Summary Get the coupon calendar
+// @Description List the coupon and redemption payments of all bonds of the authenticated user, sized to the quantity held
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Success 200 {object} presentation.CouponsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /bonds/coupons [get]
+func (h *BondHandler) GetCoupons(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query, err := couponsQuery(c, userIDValue)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return h.coupons(c, query)
+}
+
+// GetBondByID godoc
+// @Summary Get bond by ID
+// @Description Get a specific bond by ID for the authenticated user
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bond ID"
+// @Success 200 {object} map[string]presentation.BondResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds/{id} [get]
+func (h *BondHandler) GetBondByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	foundBond, err := h.bondService.HandleGetBondByIDQuery(c.Context(), bond.GetBondByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"bond": presentation.ToBondResponse(foundBond),
+	})
+}
+
+// UpdateBond godoc
+// @Summary Update a bond
+// @Description Update the terms of a bond
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bond ID"
+// @Param bond body presentation.UpdateBondRequest true "Bond data"
+// @Success 200 {object} map[string]presentation.BondResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds/{id} [put]
+func (h *BondHandler) UpdateBond(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateBondRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := bond.UpdateBondCommand{
+		ID:              c.Params("id"),
+		UserID:          userIDValue.String(),
+		FaceValue:       req.FaceValue,
+		Currency:        req.Currency,
+		CouponRate:      req.CouponRate,
+		CouponFrequency: req.CouponFrequency,
+		IssueDate:       req.IssueDate,
+		MaturityDate:    req.MaturityDate,
+		DayCount:        req.DayCount,
+	}
+
+	updatedBond, err := h.bondService.HandleUpdateBondCommand(c.Context(), command)
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"bond": presentation.ToBondResponse(updatedBond),
+	})
+}
+
+// DeleteBond godoc
+// @Summary Delete a bond
+// @Description Remove the terms of a bond. The asset and its ledger are kept
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bond ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds/{id} [delete]
+func (h *BondHandler) DeleteBond(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.bondService.HandleDeleteBondCommand(c.Context(), bond.DeleteBondCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetBondCoupons godoc
+// @Summary Get the coupon calendar of a bond
+// @Description List the coupon and redemption payments of one bond, sized to the quantity held
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bond ID"
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Success 200 {object} presentation.CouponsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds/{id}/coupons [get]
+func (h *BondHandler) GetBondCoupons(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query, err := couponsQuery(c, userIDValue)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	bondID := c.Params("id")
+	query.ID = &bondID
+
+	return h.coupons(c, query)
+}
+
+// GetPricing godoc
+// @Summary Price a bond
+// @Description Get accrued interest, dirty price, current yield and yield to maturity of a bond at a clean price quoted as a percentage of face value. Without a price the latest stored price of one bond in its currency is used
+// @Tags bonds
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Bond ID"
+// @Param price query number false "Clean Price (percentage of face value)"
+// @Param date query string false "Settlement Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.PricingResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /bonds/{id}/pricing [get]
+func (h *BondHandler) GetPricing(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := bond.GetPricingQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+
+	if price := c.Query("price"); price != "" {
+		val, err := strconv.ParseFloat(price, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid price",
+			})
+		}
+		query.CleanPrice = &val
+	}
+
+	if date := c.Query("date"); date != "" {
+		val, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid date, expected RFC3339",
+			})
+		}
+		query.SettlementDate = val
+	}
+
+	pricing, err := h.bondService.HandleGetPricingQuery(c.Context(), query)
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToPricingResponse(pricing))
+}
+
+func (h *BondHandler) coupons(c *fiber.Ctx, query bond.GetCouponsQuery) error {
+	coupons, err := h.bondService.HandleGetCouponsQuery(c.Context(), query)
+	if err != nil {
+		return bondError(c, err)
+	}
+
+	var couponResponses []presentation.CouponResponse
+	for _, coupon := range coupons {
+		couponResponses = append(couponResponses, presentation.ToCouponResponse(coupon))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.CouponsListResponse{
+		Coupons: couponResponses,
+		Total:   len(couponResponses),
+	})
+}
+
+func couponsQuery(c *fiber.Ctx, userID uuid.UUID) (bond.GetCouponsQuery, error) {
+	query := bond.GetCouponsQuery{
+		UserID: userID.String(),
+	}
+
+	if from := c.Query("from"); from != "" {
+		val, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return query, fiber.NewError(fiber.StatusBadRequest, "Invalid from date, expected RFC3339")
+		}
+		query.From = &val
+	}
+
+	if to := c.Query("to"); to != "" {
+		val, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return query, fiber.NewError(fiber.StatusBadRequest, "Invalid to date, expected RFC3339")
+		}
+		query.To = &val
+	}
+
+	return query, nil
+}
+
+func bondError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "bond not found", "unauthorized: bond does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Bond not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
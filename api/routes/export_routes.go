@@ -0,0 +1,256 @@
+package routes
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/archive"
+	presentation "siyahsensei/wallet-service/presentation/archive"
+)
+
+// exportsPath is where generated exports are listed and downloaded from.
+const exportsPath = "/api/me/exports"
+
+type ExportHandler struct {
+	archiveService *archive.Handler
+}
+
+func NewExportHandler(archiveService *archive.Handler) *ExportHandler {
+	return &ExportHandler{
+		archiveService: archiveService,
+	}
+}
+
+func (h *ExportHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	meGroup := router.Group("/me", authMiddleware)
+
+	meGroup.Get("/export", h.Export)
+	meGroup.Post("/import", h.Import)
+	meGroup.Get("/exports", h.GetExports)
+	meGroup.Get("/exports/:id", h.GetExportByID)
+	meGroup.Get("/exports/:id/download", h.DownloadExport)
+}
+
+// Export godoc
+// @Summary Export all of the user's data
+// @Description Download a ZIP with the profile, accounts, assets, the definitions they refer to and all history, as export.json and a CSV file per table. Webhook signing secrets are left out. Large exports, or any with async=true, are generated in the background instead: the response is 202 with the export, whose downloadUrl is set once it is ready
+// @Tags me
+// @Produce application/zip
+// @Produce json
+// @Security BearerAuth
+// @Param async query bool false "Always generate the export in the background"
+// @Success 200 {file} file "ZIP archive"
+// @Success 202 {object} map[string]presentation.ExportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /me/export [get]
+func (h *ExportHandler) Export(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	async, err := strconv.ParseBool(c.Query("async", "false"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid async",
+		})
+	}
+
+	result, err := h.archiveService.HandleExportCommand(c.Context(), archive.ExportCommand{
+		UserID: userIDValue.String(),
+		Async:  async,
+	})
+	if err != nil {
+		return exportError(c, err)
+	}
+
+	if result.Archive == nil {
+		c.Location(exportsPath + "/" + result.Export.ID.String())
+		return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+			"export": presentation.ToExportResponse(result.Export, exportsPath),
+		})
+	}
+
+	var body bytes.Buffer
+	if err := result.Archive.WriteZip(&body); err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": "Failed to write export",
+		})
+	}
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Attachment(result.Archive.FileName())
+	return c.Status(fiber.StatusOK).Send(body.Bytes())
+}
+
+// Import godoc
+// @Summary Restore an exported archive
+// @Description Restore the export.json of an export, or the whole export ZIP, into the authenticated user's account in one transaction. Every row gets a new ID, definitions are matched by abbreviation and created for the user when missing. MERGE adds the archive next to the existing data, skipping rows that clash such as a snapshot of the same day along with the rows that belong to them; REPLACE deletes the user's data first. Restored webhooks and alerts get new signing secrets, returned once in the response
+// @Tags me
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "export.json or export ZIP"
+// @Param mode formData string false "MERGE (default) or REPLACE"
+// @Success 200 {object} map[string]presentation.RestoreResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /me/import [post]
+func (h *ExportHandler) Import(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	data, _, err := formFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	result, err := h.archiveService.HandleRestoreCommand(c.Context(), archive.RestoreCommand{
+		UserID: userIDValue.String(),
+		Data:   data,
+		Mode:   archive.Mode(strings.ToUpper(c.FormValue("mode"))),
+	})
+	if err != nil {
+		return exportError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"restore": presentation.ToRestoreResponse(result),
+	})
+}
+
+// GetExports godoc
+// @Summary List data exports
+// @Description List the exports generated in the background for the authenticated user, newest first
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.ExportsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /me/exports [get]
+func (h *ExportHandler) GetExports(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	exports, err := h.archiveService.HandleGetUserExportsQuery(c.Context(), archive.GetUserExportsQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return exportError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToExportsListResponse(exports, exportsPath))
+}
+
+// GetExportByID godoc
+// @Summary Get a data export
+// @Description Get the status of an export; downloadUrl is set once it is ready
+// @Tags me
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Export ID"
+// @Success 200 {object} map[string]presentation.ExportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /me/exports/{id} [get]
+func (h *ExportHandler) GetExportByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	export, err := h.archiveService.HandleGetExportByIDQuery(c.Context(), archive.GetExportByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return exportError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"export": presentation.ToExportResponse(export, exportsPath),
+	})
+}
+
+// DownloadExport godoc
+// @Summary Download a data export
+// @Description Download the ZIP of an export that is ready
+// @Tags me
+// @Produce application/zip
+// @Security BearerAuth
+// @Param id path string true "Export ID"
+// @Success 200 {file} file "ZIP archive"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Failure 409 {object} map[string]string
+// @Failure 410 {object} map[string]string
+// @Router /me/exports/{id}/download [get]
+func (h *ExportHandler) DownloadExport(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	export, file, err := h.archiveService.HandleOpenExportQuery(c.Context(), archive.OpenExportQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return exportError(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, "application/zip")
+	c.Attachment(export.FileName)
+	// The file is closed once it has been sent
+	return c.Status(fiber.StatusOK).SendStream(file, int(export.Size))
+}
+
+func exportError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "export not found", "unauthorized: export does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Export not found",
+		})
+	case "user not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	case "export is not ready":
+		return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	case "export has expired", "export file not found":
+		return c.Status(fiber.StatusGone).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
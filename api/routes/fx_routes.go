@@ -0,0 +1,128 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"siyahsensei/wallet-service/domain/fx"
+	presentation "siyahsensei/wallet-service/presentation/fx"
+)
+
+type FXHandler struct {
+	fxService *fx.Handler
+}
+
+func NewFXHandler(fxService *fx.Handler) *FXHandler {
+	return &FXHandler{
+		fxService: fxService,
+	}
+}
+
+func (h *FXHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	fxGroup := router.Group("/fx", authMiddleware)
+
+	fxGroup.Post("/rates/bulk", h.RecordRates)
+	fxGroup.Get("/convert", h.Convert)
+}
+
+// RecordRates godoc
+// @Summary Record exchange rates in bulk
+// @Description Store a batch of exchange rates; a rate for the same pair, time and source is overwritten
+// @Tags fx
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rates body presentation.RecordRatesRequest true "Rates to record"
+// @Success 201 {object} presentation.RatesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /fx/rates/bulk [post]
+func (h *FXHandler) RecordRates(c *fiber.Ctx) error {
+	var req presentation.RecordRatesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := fx.RecordRatesCommand{}
+	for _, item := range req.Rates {
+		command.Rates = append(command.Rates, fx.RateItem{
+			BaseCurrency:  item.BaseCurrency,
+			QuoteCurrency: item.QuoteCurrency,
+			Rate:          item.Rate,
+			ObservedAt:    item.ObservedAt,
+			Source:        item.Source,
+		})
+	}
+
+	rates, err := h.fxService.HandleRecordRatesCommand(c.Context(), command)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(presentation.ToRatesListResponse(rates))
+}
+
+// Convert godoc
+// @Summary Convert an amount between currencies
+// @Description Convert an amount with the rates known at a date, triangulating through the pivot currency when no direct rate exists
+// @Tags fx
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string true "From Currency"
+// @Param to query string true "To Currency"
+// @Param amount query number false "Amount" default(1)
+// @Param date query string false "Date (RFC3339, defaults to now)"
+// @Success 200 {object} map[string]presentation.ConversionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /fx/convert [get]
+func (h *FXHandler) Convert(c *fiber.Ctx) error {
+	query := fx.ConvertQuery{
+		From:   c.Query("from"),
+		To:     c.Query("to"),
+		Amount: 1,
+	}
+
+	if amount := c.Query("amount"); amount != "" {
+		val, err := strconv.ParseFloat(amount, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid amount",
+			})
+		}
+		query.Amount = val
+	}
+
+	if date := c.Query("date"); date != "" {
+		val, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid date, expected RFC3339",
+			})
+		}
+		query.At = val
+	}
+
+	conversion, err := h.fxService.HandleConvertQuery(c.Context(), query)
+	if err != nil {
+		if err.Error() == "exchange rate not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Exchange rate not found",
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"conversion": presentation.ToConversionResponse(conversion),
+	})
+}
@@ -0,0 +1,218 @@
+package routes
+
+import (
+	"encoding/json"
+	"io"
+	"strconv"
+	"unicode/utf8"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/importer"
+	"siyahsensei/wallet-service/domain/transaction"
+	presentation "siyahsensei/wallet-service/presentation/importer"
+)
+
+type ImportHandler struct {
+	importService *importer.Handler
+}
+
+func NewImportHandler(importService *importer.Handler) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+	}
+}
+
+func (h *ImportHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	importGroup := router.Group("/import", authMiddleware)
+
+	importGroup.Post("/csv", h.ImportCSV)
+	importGroup.Post("/statement", h.ImportStatement)
+}
+
+// ImportCSV godoc
+// @Summary Import holdings and transactions from CSV
+// @Description Upload a CSV file with a column mapping. Symbols are resolved against definition abbreviations and accounts by ID or name. With dryRun (the default) nothing is saved and every row is reported with its errors; otherwise all rows are saved in one transaction, or none when any row fails
+// @Tags import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "CSV file"
+// @Param mapping formData string true "Column mapping as JSON, e.g. {\"date\":\"Date\",\"symbol\":\"Ticker\",\"quantity\":\"Shares\",\"price\":\"Price\",\"account\":\"Account\",\"type\":\"Action\"}. Columns are named by header or 1-based position"
+// @Param dryRun formData bool false "Only preview the import (default true)"
+// @Param header formData bool false "The first line is a header (default true)"
+// @Param delimiter formData string false "Field delimiter (default comma, \"tab\" for tabs)"
+// @Param dateFormat formData string false "Go time layout of the date column, e.g. 02/01/2006"
+// @Param decimalComma formData bool false "Numbers use a decimal comma"
+// @Param defaultAccountId formData string false "Account for rows without one"
+// @Param defaultType formData string false "Transaction type for rows without one (default BUY)"
+// @Param defaultAssetType formData string false "Type of the assets created (default OTHER)"
+// @Param currency formData string false "Currency for rows without one (default base currency)"
+// @Success 200 {object} presentation.ImportResultResponse
+// @Success 201 {object} presentation.ImportResultResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 422 {object} presentation.ImportResultResponse
+// @Router /import/csv [post]
+func (h *ImportHandler) ImportCSV(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	command, err := importCSVCommand(c, userIDValue)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	result, err := h.importService.HandleImportCSVCommand(c.Context(), command)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(importStatus(result)).JSON(presentation.ToImportResultResponse(result))
+}
+
+// ImportStatement godoc
+// @Summary Import a bank statement
+// @Description Upload an OFX, QFX or QIF statement. Each statement account goes to the given account, else to the account it was imported into before, else to an account of the same name, else to a new account. Lines are posted on the cash holding of the statement currency; lines whose FITID was imported into the account before are skipped, and an opening balance is derived from the closing balance for a holding without history. With dryRun (the default) nothing is saved
+// @Tags import
+// @Accept multipart/form-data
+// @Produce json
+// @Security BearerAuth
+// @Param file formData file true "OFX, QFX or QIF file"
+// @Param format formData string false "OFX or QIF, detected from the file when empty"
+// @Param dryRun formData bool false "Only preview the import (default true)"
+// @Param accountId formData string false "Account to import a single statement into"
+// @Param currency formData string false "Currency of statements that do not state one (default base currency)"
+// @Param dateFormat formData string false "Go time layout of QIF dates, month first when empty"
+// @Param decimalComma formData bool false "QIF amounts use a decimal comma"
+// @Success 200 {object} presentation.ImportResultResponse
+// @Success 201 {object} presentation.ImportResultResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 422 {object} presentation.ImportResultResponse
+// @Router /import/statement [post]
+func (h *ImportHandler) ImportStatement(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	data, fileName, err := formFile(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	command := importer.ImportStatementCommand{
+		UserID:    userIDValue.String(),
+		Data:      data,
+		FileName:  fileName,
+		Format:    importer.Format(c.FormValue("format")),
+		AccountID: c.FormValue("accountId"),
+		Currency:  c.FormValue("currency"),
+		Options: importer.QIFOptions{
+			DateFormat: c.FormValue("dateFormat"),
+		},
+	}
+	if command.DryRun, err = strconv.ParseBool(c.FormValue("dryRun", "true")); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid dryRun",
+		})
+	}
+	if command.Options.DecimalComma, err = strconv.ParseBool(c.FormValue("decimalComma", "false")); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid decimalComma",
+		})
+	}
+
+	result, err := h.importService.HandleImportStatementCommand(c.Context(), command)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(importStatus(result)).JSON(presentation.ToImportResultResponse(result))
+}
+
+// importStatus is 201 for a saved import, 422 for one that was rejected and 200 for a preview.
+func importStatus(result *importer.Result) int {
+	switch {
+	case result.Committed:
+		return fiber.StatusCreated
+	case !result.DryRun:
+		return fiber.StatusUnprocessableEntity
+	default:
+		return fiber.StatusOK
+	}
+}
+
+func formFile(c *fiber.Ctx) ([]byte, string, error) {
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return nil, "", fiber.NewError(fiber.StatusBadRequest, "File is required")
+	}
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, "", fiber.NewError(fiber.StatusBadRequest, "Invalid file")
+	}
+	defer file.Close()
+	data, err := io.ReadAll(file)
+	if err != nil {
+		return nil, "", fiber.NewError(fiber.StatusBadRequest, "Invalid file")
+	}
+	return data, fileHeader.Filename, nil
+}
+
+func importCSVCommand(c *fiber.Ctx, userID uuid.UUID) (importer.ImportCSVCommand, error) {
+	command := importer.ImportCSVCommand{
+		UserID:           userID.String(),
+		DefaultAccountID: c.FormValue("defaultAccountId"),
+		DefaultType:      transaction.TransactionType(c.FormValue("defaultType")),
+		DefaultAssetType: asset.AssetType(c.FormValue("defaultAssetType")),
+		Currency:         c.FormValue("currency"),
+	}
+
+	data, _, err := formFile(c)
+	if err != nil {
+		return command, err
+	}
+	command.Data = data
+
+	if err := json.Unmarshal([]byte(c.FormValue("mapping")), &command.Mapping); err != nil {
+		return command, fiber.NewError(fiber.StatusBadRequest, "Invalid mapping, expected a JSON object")
+	}
+
+	if command.DryRun, err = strconv.ParseBool(c.FormValue("dryRun", "true")); err != nil {
+		return command, fiber.NewError(fiber.StatusBadRequest, "Invalid dryRun")
+	}
+	if command.Options.HasHeader, err = strconv.ParseBool(c.FormValue("header", "true")); err != nil {
+		return command, fiber.NewError(fiber.StatusBadRequest, "Invalid header")
+	}
+	if command.Options.DecimalComma, err = strconv.ParseBool(c.FormValue("decimalComma", "false")); err != nil {
+		return command, fiber.NewError(fiber.StatusBadRequest, "Invalid decimalComma")
+	}
+	command.Options.DateFormat = c.FormValue("dateFormat")
+
+	switch delimiter := c.FormValue("delimiter"); {
+	case delimiter == "":
+	case delimiter == "tab" || delimiter == `\t`:
+		command.Options.Delimiter = '\t'
+	case utf8.RuneCountInString(delimiter) == 1:
+		command.Options.Delimiter, _ = utf8.DecodeRuneInString(delimiter)
+	default:
+		return command, fiber.NewError(fiber.StatusBadRequest, "Invalid delimiter, expected a single character")
+	}
+
+	return command, nil
+}
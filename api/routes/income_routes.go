@@ -0,0 +1,325 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/income"
+	presentation "siyahsensei/wallet-service/presentation/income"
+)
+
+type IncomeHandler struct {
+	incomeService *income.Handler
+}
+
+func NewIncomeHandler(incomeService *income.Handler) *IncomeHandler {
+	return &IncomeHandler{
+		incomeService: incomeService,
+	}
+}
+
+func (h *IncomeHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	incomeGroup := router.Group("/income", authMiddleware)
+
+	incomeGroup.Get("/", h.GetReport)
+	incomeGroup.Post("/", h.CreateIncome)
+	incomeGroup.Get("/records", h.FilterIncome)
+	incomeGroup.Get("/:id", h.GetIncomeByID)
+	incomeGroup.Put("/:id", h.UpdateIncome)
+	incomeGroup.Delete("/:id", h.DeleteIncome)
+}
+
+// GetReport godoc
+// @Summary Get the income report of a year
+// @Description Aggregate dividend, interest, coupon, staking and rental income of a year by month, type and asset in a base currency, with yield on cost per asset
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param year query int false "Year (defaults to the current year)"
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
+// @Success 200 {object} presentation.ReportResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /income [get]
+func (h *IncomeHandler) GetReport(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := income.GetReportQuery{
+		UserID:       userIDValue.String(),
+		BaseCurrency: c.Query("base"),
+	}
+
+	if year := c.Query("year"); year != "" {
+		val, err := strconv.Atoi(year)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid year",
+			})
+		}
+		query.Year = val
+	}
+
+	report, err := h.incomeService.HandleGetReportQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToReportResponse(report))
+}
+
+// CreateIncome godoc
+// @Summary Record income
+// @Description Record a dividend, interest, coupon, staking reward or rent payment received on an asset
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param income body presentation.CreateIncomeRequest true "Income data"
+// @Success 201 {object} map[string]presentation.IncomeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /income [post]
+func (h *IncomeHandler) CreateIncome(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateIncomeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := income.CreateIncomeCommand{
+		UserID:         userIDValue.String(),
+		AssetID:        req.AssetID,
+		Type:           req.Type,
+		GrossAmount:    req.GrossAmount,
+		WithholdingTax: req.WithholdingTax,
+		Currency:       req.Currency,
+		PayDate:        req.PayDate,
+		Notes:          req.Notes,
+	}
+
+	createdIncome, err := h.incomeService.HandleCreateIncomeCommand(c.Context(), command)
+	if err != nil {
+		return incomeError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"income": presentation.ToIncomeResponse(createdIncome),
+	})
+}
+
+// FilterIncome godoc
+// @Summary List income records
+// @Description List the income records of the authenticated user with optional filters
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param assetId query string false "Asset ID"
+// @Param type query string false "Income Type" Enums(DIVIDEND, INTEREST, COUPON, STAKING_REWARD, RENT)
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Success 200 {object} presentation.IncomeListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /income/records [get]
+func (h *IncomeHandler) FilterIncome(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := income.FilterIncomeQuery{
+		UserID: userIDValue.String(),
+	}
+
+	if assetID := c.Query("assetId"); assetID != "" {
+		query.AssetID = &assetID
+	}
+
+	if incomeType := c.Query("type"); incomeType != "" {
+		it := income.IncomeType(incomeType)
+		query.Type = &it
+	}
+
+	if from := c.Query("from"); from != "" {
+		if val, err := time.Parse(time.RFC3339, from); err == nil {
+			query.From = &val
+		}
+	}
+
+	if to := c.Query("to"); to != "" {
+		if val, err := time.Parse(time.RFC3339, to); err == nil {
+			query.To = &val
+		}
+	}
+
+	records, err := h.incomeService.HandleFilterIncomeQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var incomeResponses []presentation.IncomeResponse
+	for _, record := range records {
+		incomeResponses = append(incomeResponses, presentation.ToIncomeResponse(record))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.IncomeListResponse{
+		Income: incomeResponses,
+		Total:  len(incomeResponses),
+	})
+}
+
+// GetIncomeByID godoc
+// @Summary Get income by ID
+// @Description Get a specific income record by ID for the authenticated user
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Income ID"
+// @Success 200 {object} map[string]presentation.IncomeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /income/{id} [get]
+func (h *IncomeHandler) GetIncomeByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	foundIncome, err := h.incomeService.HandleGetIncomeByIDQuery(c.Context(), income.GetIncomeByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return incomeError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"income": presentation.ToIncomeResponse(foundIncome),
+	})
+}
+
+// UpdateIncome godoc
+// @Summary Update income
+// @Description Update an income record
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Income ID"
+// @Param income body presentation.UpdateIncomeRequest true "Income data"
+// @Success 200 {object} map[string]presentation.IncomeResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /income/{id} [put]
+func (h *IncomeHandler) UpdateIncome(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateIncomeRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := income.UpdateIncomeCommand{
+		ID:             c.Params("id"),
+		UserID:         userIDValue.String(),
+		Type:           req.Type,
+		GrossAmount:    req.GrossAmount,
+		WithholdingTax: req.WithholdingTax,
+		Currency:       req.Currency,
+		PayDate:        req.PayDate,
+		Notes:          req.Notes,
+	}
+
+	updatedIncome, err := h.incomeService.HandleUpdateIncomeCommand(c.Context(), command)
+	if err != nil {
+		return incomeError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"income": presentation.ToIncomeResponse(updatedIncome),
+	})
+}
+
+// DeleteIncome godoc
+// @Summary Delete income
+// @Description Delete an income record
+// @Tags income
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Income ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /income/{id} [delete]
+func (h *IncomeHandler) DeleteIncome(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.incomeService.HandleDeleteIncomeCommand(c.Context(), income.DeleteIncomeCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return incomeError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+func incomeError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "income not found", "unauthorized: income does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Income not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,422 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/loan"
+	presentation "siyahsensei/wallet-service/presentation/loan"
+)
+
+type LoanHandler struct {
+	loanService *loan.Handler
+}
+
+func NewLoanHandler(loanService *loan.Handler) *LoanHandler {
+	return &LoanHandler{
+		loanService: loanService,
+	}
+}
+
+func (h *LoanHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	loanGroup := router.Group("/loans", authMiddleware)
+
+	loanGroup.Post("/", h.CreateLoan)
+	loanGroup.Get("/", h.GetUserLoans)
+	loanGroup.Get("/:id", h.GetLoanByID)
+	loanGroup.Put("/:id", h.UpdateLoan)
+	loanGroup.Delete("/:id", h.DeleteLoan)
+	loanGroup.Get("/:id/schedule", h.GetSchedule)
+	loanGroup.Post("/:id/payments", h.RecordPayment)
+	loanGroup.Get("/:id/payments", h.GetPayments)
+	loanGroup.Get("/:id/status", h.GetStatus)
+}
+
+// CreateLoan godoc
+// @Summary Set the terms of a loan
+// @Description Attach principal, rate, term, payment frequency and amortization method to a DEBT asset. The principal defaults to the asset quantity
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param loan body presentation.CreateLoanRequest true "Loan data"
+// @Success 201 {object} map[string]presentation.LoanResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans [post]
+func (h *LoanHandler) CreateLoan(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateLoanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := loan.CreateLoanCommand{
+		UserID:           userIDValue.String(),
+		AssetID:          req.AssetID,
+		Principal:        req.Principal,
+		AnnualRate:       req.AnnualRate,
+		TermPeriods:      req.TermPeriods,
+		PaymentFrequency: req.PaymentFrequency,
+		Method:           req.Method,
+		StartDate:        req.StartDate,
+		FirstPaymentDate: req.FirstPaymentDate,
+	}
+
+	l, err := h.loanService.HandleCreateLoanCommand(c.Context(), command)
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"loan": presentation.ToLoanResponse(l),
+	})
+}
+
+// GetUserLoans godoc
+// @Summary List loans
+// @Description List the loans of the authenticated user
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.LoansListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /loans [get]
+func (h *LoanHandler) GetUserLoans(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	loans, err := h.loanService.HandleGetUserLoansQuery(c.Context(), loan.GetUserLoansQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var loanResponses []presentation.LoanResponse
+	for _, l := range loans {
+		loanResponses = append(loanResponses, presentation.ToLoanResponse(l))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.LoansListResponse{
+		Loans: loanResponses,
+		Total: len(loanResponses),
+	})
+}
+
+// GetLoanByID godoc
+// @Summary Get loan by ID
+// @Description Get a specific loan by ID for the authenticated user
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Success 200 {object} map[string]presentation.LoanResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id} [get]
+func (h *LoanHandler) GetLoanByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	l, err := h.loanService.HandleGetLoanByIDQuery(c.Context(), loan.GetLoanByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"loan": presentation.ToLoanResponse(l),
+	})
+}
+
+// UpdateLoan godoc
+// @Summary Update a loan
+// @Description Update the principal, rate, term, frequency or method of a loan
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Param loan body presentation.UpdateLoanRequest true "Loan data"
+// @Success 200 {object} map[string]presentation.LoanResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id} [put]
+func (h *LoanHandler) UpdateLoan(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateLoanRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := loan.UpdateLoanCommand{
+		ID:               c.Params("id"),
+		UserID:           userIDValue.String(),
+		Principal:        req.Principal,
+		AnnualRate:       req.AnnualRate,
+		TermPeriods:      req.TermPeriods,
+		PaymentFrequency: req.PaymentFrequency,
+		Method:           req.Method,
+		StartDate:        req.StartDate,
+		FirstPaymentDate: req.FirstPaymentDate,
+	}
+
+	l, err := h.loanService.HandleUpdateLoanCommand(c.Context(), command)
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"loan": presentation.ToLoanResponse(l),
+	})
+}
+
+// DeleteLoan godoc
+// @Summary Delete a loan
+// @Description Remove the terms and payment history of a loan. The asset and its ledger are kept
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id} [delete]
+func (h *LoanHandler) DeleteLoan(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.loanService.HandleDeleteLoanCommand(c.Context(), loan.DeleteLoanCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetSchedule godoc
+// @Summary Get the amortization schedule of a loan
+// @Description Get every installment of a loan with its due date and the split between principal and interest
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Success 200 {object} presentation.ScheduleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id}/schedule [get]
+func (h *LoanHandler) GetSchedule(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	installments, err := h.loanService.HandleGetScheduleQuery(c.Context(), loan.GetScheduleQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToScheduleResponse(installments))
+}
+
+// RecordPayment godoc
+// @Summary Record a loan payment
+// @Description Record a payment on a loan. The principal part reduces the debt; with fromAssetId the whole amount is also withdrawn from that asset
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Param payment body presentation.RecordPaymentRequest true "Payment data"
+// @Success 201 {object} map[string]presentation.PaymentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id}/payments [post]
+func (h *LoanHandler) RecordPayment(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.RecordPaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := loan.RecordPaymentCommand{
+		LoanID:      c.Params("id"),
+		UserID:      userIDValue.String(),
+		Amount:      req.Amount,
+		Interest:    req.Interest,
+		PaidAt:      req.PaidAt,
+		FromAssetID: req.FromAssetID,
+		Notes:       req.Notes,
+	}
+
+	payment, err := h.loanService.HandleRecordPaymentCommand(c.Context(), command)
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"payment": presentation.ToPaymentResponse(payment),
+	})
+}
+
+// GetPayments godoc
+// @Summary List loan payments
+// @Description List the payments recorded on a loan, oldest first
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Success 200 {object} presentation.PaymentsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id}/payments [get]
+func (h *LoanHandler) GetPayments(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	payments, err := h.loanService.HandleGetPaymentsQuery(c.Context(), loan.GetPaymentsQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	var paymentResponses []presentation.PaymentResponse
+	for _, p := range payments {
+		paymentResponses = append(paymentResponses, presentation.ToPaymentResponse(p))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.PaymentsListResponse{
+		Payments: paymentResponses,
+		Total:    len(paymentResponses),
+	})
+}
+
+// GetStatus godoc
+// @Summary Get the status of a loan
+// @Description Compare the payments made with the schedule as of a date: remaining principal, interest paid, arrears and the next installment
+// @Tags loans
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Loan ID"
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.StatusResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /loans/{id}/status [get]
+func (h *LoanHandler) GetStatus(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := loan.GetStatusQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+
+	if date := c.Query("date"); date != "" {
+		val, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid date, expected RFC3339",
+			})
+		}
+		query.AsOf = val
+	}
+
+	status, err := h.loanService.HandleGetStatusQuery(c.Context(), query)
+	if err != nil {
+		return loanError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToStatusResponse(status))
+}
+
+func loanError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "loan not found", "unauthorized: loan does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Loan not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user", "definition not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,139 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/lot"
+	presentation "siyahsensei/wallet-service/presentation/lot"
+)
+
+type LotHandler struct {
+	lotService *lot.Handler
+}
+
+func NewLotHandler(lotService *lot.Handler) *LotHandler {
+	return &LotHandler{
+		lotService: lotService,
+	}
+}
+
+func (h *LotHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	lotGroup := router.Group("/lots", authMiddleware)
+
+	lotGroup.Get("/", h.GetAssetLots)
+	lotGroup.Get("/disposals", h.GetDisposals)
+}
+
+// GetAssetLots godoc
+// @Summary Get the lots of an asset
+// @Description Get the tax lots of an asset with its current cost basis
+// @Tags lots
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param assetId query string true "Asset ID"
+// @Param open query bool false "Only return lots that still hold a quantity"
+// @Success 200 {object} map[string]presentation.AssetLotsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /lots [get]
+func (h *LotHandler) GetAssetLots(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	assetID := c.Query("assetId")
+	if assetID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Asset ID is required",
+		})
+	}
+
+	query := lot.GetAssetLotsQuery{
+		UserID:   userIDValue.String(),
+		AssetID:  assetID,
+		OpenOnly: c.Query("open") == "true",
+	}
+
+	assetLots, err := h.lotService.HandleGetAssetLotsQuery(c.Context(), query)
+	if err != nil {
+		if err.Error() == "asset not found" || err.Error() == "unauthorized: asset does not belong to user" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Asset not found",
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"lots": presentation.ToAssetLotsResponse(assetLots),
+	})
+}
+
+// GetDisposals godoc
+// @Summary Get lot disposals
+// @Description Get the lot disposals of the authenticated user, optionally for one asset and date range
+// @Tags lots
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param assetId query string false "Asset ID"
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Success 200 {object} presentation.DisposalsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /lots/disposals [get]
+func (h *LotHandler) GetDisposals(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := lot.GetDisposalsQuery{
+		UserID: userIDValue.String(),
+	}
+
+	if assetID := c.Query("assetId"); assetID != "" {
+		query.AssetID = &assetID
+	}
+
+	if from := c.Query("from"); from != "" {
+		if val, err := time.Parse(time.RFC3339, from); err == nil {
+			query.From = &val
+		}
+	}
+
+	if to := c.Query("to"); to != "" {
+		if val, err := time.Parse(time.RFC3339, to); err == nil {
+			query.To = &val
+		}
+	}
+
+	disposals, err := h.lotService.HandleGetDisposalsQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var disposalResponses []presentation.DisposalResponse
+	for _, d := range disposals {
+		disposalResponses = append(disposalResponses, presentation.ToDisposalResponse(d))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.DisposalsListResponse{
+		Disposals: disposalResponses,
+		Total:     len(disposalResponses),
+	})
+}
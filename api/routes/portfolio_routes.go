@@ -0,0 +1,355 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/portfolio"
+	presentation "siyahsensei/wallet-service/presentation/portfolio"
+)
+
+type PortfolioHandler struct {
+	portfolioService *portfolio.Handler
+}
+
+func NewPortfolioHandler(portfolioService *portfolio.Handler) *PortfolioHandler {
+	return &PortfolioHandler{
+		portfolioService: portfolioService,
+	}
+}
+
+func (h *PortfolioHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	portfolioGroup := router.Group("/portfolio", authMiddleware)
+
+	portfolioGroup.Get("/history", h.GetHistory)
+	portfolioGroup.Get("/net-worth", h.GetNetWorth)
+	portfolioGroup.Get("/allocation", h.GetAllocation)
+	portfolioGroup.Put("/targets", h.SetTargets)
+	portfolioGroup.Get("/targets", h.GetTargets)
+	portfolioGroup.Get("/rebalance", h.GetRebalance)
+	portfolioGroup.Post("/snapshots", h.TakeSnapshot)
+}
+
+// GetHistory godoc
+// @Summary Get portfolio value history
+// @Description Get the daily portfolio snapshots of the authenticated user, sampled to the last snapshot of every interval
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param from query string false "From Date (RFC3339, defaults to one year before to)"
+// @Param to query string false "To Date (RFC3339, defaults to now)"
+// @Param interval query string false "Interval" Enums(day, week, month) default(day)
+// @Success 200 {object} presentation.HistoryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/history [get]
+func (h *PortfolioHandler) GetHistory(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := portfolio.GetHistoryQuery{
+		UserID:   userIDValue.String(),
+		Interval: portfolio.Interval(c.Query("interval")),
+	}
+
+	if from := c.Query("from"); from != "" {
+		val, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid from date, expected RFC3339",
+			})
+		}
+		query.From = val
+	}
+
+	if to := c.Query("to"); to != "" {
+		val, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid to date, expected RFC3339",
+			})
+		}
+		query.To = val
+	}
+
+	snapshots, err := h.portfolioService.HandleGetHistoryQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	interval := query.Interval
+	if interval == "" {
+		interval = portfolio.Daily
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToHistoryResponse(interval, snapshots))
+}
+
+// TakeSnapshot godoc
+// @Summary Snapshot the portfolio now
+// @Description Value the authenticated user's portfolio now and store it as today's snapshot
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 201 {object} map[string]presentation.SnapshotResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/snapshots [post]
+func (h *PortfolioHandler) TakeSnapshot(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	command := portfolio.TakeSnapshotCommand{
+		UserID: userIDValue.String(),
+	}
+
+	snapshot, err := h.portfolioService.HandleTakeSnapshotCommand(c.Context(), command)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"snapshot": presentation.ToSnapshotResponse(snapshot),
+	})
+}
+
+// GetNetWorth godoc
+// @Summary Get net worth
+// @Description Get gross assets, gross liabilities and net worth of the authenticated user in a base currency, broken down by account. Debts and credit card balances count as liabilities.
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
+// @Success 200 {object} map[string]presentation.NetWorthResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/net-worth [get]
+func (h *PortfolioHandler) GetNetWorth(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := portfolio.GetNetWorthQuery{
+		UserID:       userIDValue.String(),
+		BaseCurrency: c.Query("base"),
+	}
+
+	netWorth, err := h.portfolioService.HandleGetNetWorthQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"netWorth": presentation.ToNetWorthResponse(netWorth),
+	})
+}
+
+// GetAllocation godoc
+// @Summary Get portfolio allocation
+// @Description Get the value and share of total of every group of the authenticated user's holdings at current prices. Liabilities are excluded.
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param groupBy query string false "Group By" Enums(assetType, accountType, account, definition) default(assetType)
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
+// @Success 200 {object} map[string]presentation.AllocationResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/allocation [get]
+func (h *PortfolioHandler) GetAllocation(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := portfolio.GetAllocationQuery{
+		UserID:       userIDValue.String(),
+		BaseCurrency: c.Query("base"),
+		GroupBy:      portfolio.GroupBy(c.Query("groupBy")),
+	}
+
+	allocation, err := h.portfolioService.HandleGetAllocationQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"allocation": presentation.ToAllocationResponse(allocation),
+	})
+}
+
+// SetTargets godoc
+// @Summary Set target allocation
+// @Description Replace the target weights of the authenticated user. Targets are grouped by asset type or by definition and their weights must add up to 100; an empty list clears them.
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param targets body presentation.SetTargetsRequest true "Target weights"
+// @Success 200 {object} presentation.TargetsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /portfolio/targets [put]
+func (h *PortfolioHandler) SetTargets(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.SetTargetsRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := portfolio.SetTargetsCommand{
+		UserID:  userIDValue.String(),
+		GroupBy: portfolio.GroupBy(req.GroupBy),
+	}
+	for _, t := range req.Targets {
+		command.Targets = append(command.Targets, portfolio.TargetItem{
+			Key:    t.Key,
+			Weight: t.Weight,
+		})
+	}
+
+	targets, err := h.portfolioService.HandleSetTargetsCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "definition not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Definition not found",
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToTargetsListResponse(targets))
+}
+
+// GetTargets godoc
+// @Summary Get target allocation
+// @Description Get the target weights of the authenticated user
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.TargetsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/targets [get]
+func (h *PortfolioHandler) GetTargets(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := portfolio.GetTargetsQuery{
+		UserID: userIDValue.String(),
+	}
+
+	targets, err := h.portfolioService.HandleGetTargetsQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToTargetsListResponse(targets))
+}
+
+// GetRebalance godoc
+// @Summary Get rebalancing suggestions
+// @Description Compare the current allocation with the targets and suggest buys and sells, in base currency and quantity, that bring groups drifting beyond the tolerance back to target
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
+// @Param tolerance query number false "Tolerance band in percentage points" default(5)
+// @Param noSell query bool false "Only suggest buys"
+// @Param cash query number false "New cash to invest" default(0)
+// @Success 200 {object} map[string]presentation.RebalancePlanResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /portfolio/rebalance [get]
+func (h *PortfolioHandler) GetRebalance(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := portfolio.GetRebalanceQuery{
+		UserID:       userIDValue.String(),
+		BaseCurrency: c.Query("base"),
+		Tolerance:    5,
+		NoSell:       c.Query("noSell") == "true",
+	}
+
+	if tolerance := c.Query("tolerance"); tolerance != "" {
+		val, err := strconv.ParseFloat(tolerance, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid tolerance",
+			})
+		}
+		query.Tolerance = val
+	}
+
+	if cash := c.Query("cash"); cash != "" {
+		val, err := strconv.ParseFloat(cash, 64)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid cash amount",
+			})
+		}
+		query.Cash = val
+	}
+
+	plan, err := h.portfolioService.HandleGetRebalanceQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rebalance": presentation.ToRebalancePlanResponse(plan),
+	})
+}
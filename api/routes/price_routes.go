@@ -0,0 +1,217 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"siyahsensei/wallet-service/domain/price"
+	presentation "siyahsensei/wallet-service/presentation/price"
+)
+
+type PriceHandler struct {
+	priceService *price.Handler
+}
+
+func NewPriceHandler(priceService *price.Handler) *PriceHandler {
+	return &PriceHandler{
+		priceService: priceService,
+	}
+}
+
+// RegisterRoutes serves the price lookups to users. Recorded prices are shared by every
+// user's valuations and alerts, so recording them takes the key of a trusted service
+// instead; the route is registered ahead of the group so the user middleware never runs.
+func (h *PriceHandler) RegisterRoutes(router fiber.Router, authMiddleware, ingestMiddleware fiber.Handler) {
+	router.Post("/prices/bulk", ingestMiddleware, h.RecordPrices)
+
+	priceGroup := router.Group("/prices", authMiddleware)
+	priceGroup.Get("/:definitionId/latest", h.GetLatestPrice)
+	priceGroup.Get("/:definitionId/at", h.GetPriceAt)
+	priceGroup.Get("/:definitionId", h.GetPriceHistory)
+}
+
+// RecordPrices godoc
+// @Summary Record prices in bulk
+// @Description Store a batch of definition prices; a price for the same definition, currency, time and source is overwritten. Prices are shared by all users, so only trusted services holding the ingestion API key may record them
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Security APIKeyAuth
+// @Param prices body presentation.RecordPricesRequest true "Prices to record"
+// @Success 201 {object} presentation.PriceListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /prices/bulk [post]
+func (h *PriceHandler) RecordPrices(c *fiber.Ctx) error {
+	var req presentation.RecordPricesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := price.RecordPricesCommand{}
+	for _, item := range req.Prices {
+		command.Prices = append(command.Prices, price.PriceItem{
+			DefinitionID:  item.DefinitionID,
+			QuoteCurrency: item.QuoteCurrency,
+			PricedAt:      item.PricedAt,
+			Price:         item.Price,
+			Source:        item.Source,
+		})
+	}
+
+	prices, err := h.priceService.HandleRecordPricesCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "definition not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Definition not found",
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(presentation.ToPriceListResponse(prices))
+}
+
+// GetLatestPrice godoc
+// @Summary Get the latest price of a definition
+// @Description Get the most recent recorded price of a definition in a quote currency
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param definitionId path string true "Definition ID"
+// @Param currency query string true "Quote Currency"
+// @Success 200 {object} map[string]presentation.PriceResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /prices/{definitionId}/latest [get]
+func (h *PriceHandler) GetLatestPrice(c *fiber.Ctx) error {
+	query := price.GetLatestPriceQuery{
+		DefinitionID:  c.Params("definitionId"),
+		QuoteCurrency: c.Query("currency"),
+	}
+
+	p, err := h.priceService.HandleGetLatestPriceQuery(c.Context(), query)
+	if err != nil {
+		return priceError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"price": presentation.ToPriceResponse(p),
+	})
+}
+
+// GetPriceAt godoc
+// @Summary Get the price of a definition at a date
+// @Description Get the last recorded price of a definition at or before the given date
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param definitionId path string true "Definition ID"
+// @Param currency query string true "Quote Currency"
+// @Param date query string true "Date (RFC3339)"
+// @Success 200 {object} map[string]presentation.PriceResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /prices/{definitionId}/at [get]
+func (h *PriceHandler) GetPriceAt(c *fiber.Ctx) error {
+	at, err := time.Parse(time.RFC3339, c.Query("date"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid date, expected RFC3339",
+		})
+	}
+
+	query := price.GetPriceAtQuery{
+		DefinitionID:  c.Params("definitionId"),
+		QuoteCurrency: c.Query("currency"),
+		At:            at,
+	}
+
+	p, err := h.priceService.HandleGetPriceAtQuery(c.Context(), query)
+	if err != nil {
+		return priceError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"price": presentation.ToPriceResponse(p),
+	})
+}
+
+// GetPriceHistory godoc
+// @Summary Get the price history of a definition
+// @Description Get recorded prices of a definition in a quote currency, newest first
+// @Tags prices
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param definitionId path string true "Definition ID"
+// @Param currency query string true "Quote Currency"
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Param limit query int false "Limit" default(100)
+// @Param offset query int false "Offset" default(0)
+// @Success 200 {object} presentation.PriceListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /prices/{definitionId} [get]
+func (h *PriceHandler) GetPriceHistory(c *fiber.Ctx) error {
+	query := price.GetPriceHistoryQuery{
+		DefinitionID:  c.Params("definitionId"),
+		QuoteCurrency: c.Query("currency"),
+	}
+
+	if from := c.Query("from"); from != "" {
+		if val, err := time.Parse(time.RFC3339, from); err == nil {
+			query.From = &val
+		}
+	}
+
+	if to := c.Query("to"); to != "" {
+		if val, err := time.Parse(time.RFC3339, to); err == nil {
+			query.To = &val
+		}
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			query.Limit = val
+		}
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		if val, err := strconv.Atoi(offset); err == nil {
+			query.Offset = val
+		}
+	}
+
+	prices, err := h.priceService.HandleGetPriceHistoryQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToPriceListResponse(prices))
+}
+
+func priceError(c *fiber.Ctx, err error) error {
+	if err.Error() == "price not found" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Price not found",
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
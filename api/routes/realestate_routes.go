@@ -0,0 +1,562 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/realestate"
+	presentation "siyahsensei/wallet-service/presentation/realestate"
+)
+
+type PropertyHandler struct {
+	propertyService *realestate.Handler
+}
+
+func NewPropertyHandler(propertyService *realestate.Handler) *PropertyHandler {
+	return &PropertyHandler{
+		propertyService: propertyService,
+	}
+}
+
+func (h *PropertyHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	propertyGroup := router.Group("/properties", authMiddleware)
+
+	propertyGroup.Post("/", h.CreateProperty)
+	propertyGroup.Get("/", h.GetUserProperties)
+	propertyGroup.Get("/:id", h.GetPropertyByID)
+	propertyGroup.Put("/:id", h.UpdateProperty)
+	propertyGroup.Delete("/:id", h.DeleteProperty)
+	propertyGroup.Post("/:id/appraisals", h.AddAppraisal)
+	propertyGroup.Get("/:id/appraisals", h.GetAppraisals)
+	propertyGroup.Delete("/:id/appraisals/:appraisalId", h.DeleteAppraisal)
+	propertyGroup.Post("/:id/expenses", h.AddExpense)
+	propertyGroup.Get("/:id/expenses", h.GetExpenses)
+	propertyGroup.Delete("/:id/expenses/:expenseId", h.DeleteExpense)
+	propertyGroup.Get("/:id/performance", h.GetPerformance)
+}
+
+// CreateProperty godoc
+// @Summary Set the purchase details of a property
+// @Description Attach purchase price, purchase costs and an optional mortgage loan to a REAL_ESTATE asset or an asset in a HOME account
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param property body presentation.CreatePropertyRequest true "Property data"
+// @Success 201 {object} map[string]presentation.PropertyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties [post]
+func (h *PropertyHandler) CreateProperty(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreatePropertyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := realestate.CreatePropertyCommand{
+		UserID:         userIDValue.String(),
+		AssetID:        req.AssetID,
+		Currency:       req.Currency,
+		PurchasePrice:  req.PurchasePrice,
+		PurchaseCosts:  req.PurchaseCosts,
+		PurchaseDate:   req.PurchaseDate,
+		MortgageLoanID: req.MortgageLoanID,
+	}
+
+	property, err := h.propertyService.HandleCreatePropertyCommand(c.Context(), command)
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"property": presentation.ToPropertyResponse(property),
+	})
+}
+
+// GetUserProperties godoc
+// @Summary List properties
+// @Description List the properties of the authenticated user, oldest purchase first
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.PropertiesListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /properties [get]
+func (h *PropertyHandler) GetUserProperties(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	properties, err := h.propertyService.HandleGetUserPropertiesQuery(c.Context(), realestate.GetUserPropertiesQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var propertyResponses []presentation.PropertyResponse
+	for _, p := range properties {
+		propertyResponses = append(propertyResponses, presentation.ToPropertyResponse(p))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.PropertiesListResponse{
+		Properties: propertyResponses,
+		Total:      len(propertyResponses),
+	})
+}
+
+// GetPropertyByID godoc
+// @Summary Get property by ID
+// @Description Get a specific property by ID for the authenticated user
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Success 200 {object} map[string]presentation.PropertyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id} [get]
+func (h *PropertyHandler) GetPropertyByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	property, err := h.propertyService.HandleGetPropertyByIDQuery(c.Context(), realestate.GetPropertyByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"property": presentation.ToPropertyResponse(property),
+	})
+}
+
+// UpdateProperty godoc
+// @Summary Update a property
+// @Description Update the currency, purchase details or mortgage loan of a property
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param property body presentation.UpdatePropertyRequest true "Property data"
+// @Success 200 {object} map[string]presentation.PropertyResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id} [put]
+func (h *PropertyHandler) UpdateProperty(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdatePropertyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := realestate.UpdatePropertyCommand{
+		ID:             c.Params("id"),
+		UserID:         userIDValue.String(),
+		Currency:       req.Currency,
+		PurchasePrice:  req.PurchasePrice,
+		PurchaseCosts:  req.PurchaseCosts,
+		PurchaseDate:   req.PurchaseDate,
+		MortgageLoanID: req.MortgageLoanID,
+	}
+
+	property, err := h.propertyService.HandleUpdatePropertyCommand(c.Context(), command)
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"property": presentation.ToPropertyResponse(property),
+	})
+}
+
+// DeleteProperty godoc
+// @Summary Delete a property
+// @Description Remove the purchase details, appraisals and expenses of a property. The asset and its ledger are kept
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id} [delete]
+func (h *PropertyHandler) DeleteProperty(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.propertyService.HandleDeletePropertyCommand(c.Context(), realestate.DeletePropertyCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// AddAppraisal godoc
+// @Summary Record an appraisal
+// @Description Record the estimated market value of a property on a date. The latest appraisal also values the property in the portfolio
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param appraisal body presentation.AddAppraisalRequest true "Appraisal data"
+// @Success 201 {object} map[string]presentation.AppraisalResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/appraisals [post]
+func (h *PropertyHandler) AddAppraisal(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.AddAppraisalRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	appraisal, err := h.propertyService.HandleAddAppraisalCommand(c.Context(), realestate.AddAppraisalCommand{
+		PropertyID:  c.Params("id"),
+		UserID:      userIDValue.String(),
+		Value:       req.Value,
+		AppraisedAt: req.AppraisedAt,
+		Source:      req.Source,
+		Notes:       req.Notes,
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"appraisal": presentation.ToAppraisalResponse(appraisal),
+	})
+}
+
+// GetAppraisals godoc
+// @Summary List appraisals
+// @Description List the appraisals of a property, oldest first
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Success 200 {object} presentation.AppraisalsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/appraisals [get]
+func (h *PropertyHandler) GetAppraisals(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	appraisals, err := h.propertyService.HandleGetAppraisalsQuery(c.Context(), realestate.GetAppraisalsQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	var appraisalResponses []presentation.AppraisalResponse
+	for _, a := range appraisals {
+		appraisalResponses = append(appraisalResponses, presentation.ToAppraisalResponse(a))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.AppraisalsListResponse{
+		Appraisals: appraisalResponses,
+		Total:      len(appraisalResponses),
+	})
+}
+
+// DeleteAppraisal godoc
+// @Summary Delete an appraisal
+// @Description Delete an appraisal of a property
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param appraisalId path string true "Appraisal ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/appraisals/{appraisalId} [delete]
+func (h *PropertyHandler) DeleteAppraisal(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.propertyService.HandleDeleteAppraisalCommand(c.Context(), realestate.DeleteAppraisalCommand{
+		PropertyID:  c.Params("id"),
+		AppraisalID: c.Params("appraisalId"),
+		UserID:      userIDValue.String(),
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// AddExpense godoc
+// @Summary Record a property expense
+// @Description Record a running cost of a property such as maintenance, property tax or insurance, in the property currency
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param expense body presentation.AddExpenseRequest true "Expense data"
+// @Success 201 {object} map[string]presentation.ExpenseResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/expenses [post]
+func (h *PropertyHandler) AddExpense(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.AddExpenseRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	expense, err := h.propertyService.HandleAddExpenseCommand(c.Context(), realestate.AddExpenseCommand{
+		PropertyID: c.Params("id"),
+		UserID:     userIDValue.String(),
+		Category:   req.Category,
+		Amount:     req.Amount,
+		PaidAt:     req.PaidAt,
+		Notes:      req.Notes,
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"expense": presentation.ToExpenseResponse(expense),
+	})
+}
+
+// GetExpenses godoc
+// @Summary List property expenses
+// @Description List the expenses of a property, oldest first, optionally within a date range
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Success 200 {object} presentation.ExpensesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/expenses [get]
+func (h *PropertyHandler) GetExpenses(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := realestate.GetExpensesQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+
+	if from := c.Query("from"); from != "" {
+		val, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid from date, expected RFC3339",
+			})
+		}
+		query.From = &val
+	}
+
+	if to := c.Query("to"); to != "" {
+		val, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid to date, expected RFC3339",
+			})
+		}
+		query.To = &val
+	}
+
+	expenses, err := h.propertyService.HandleGetExpensesQuery(c.Context(), query)
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	var expenseResponses []presentation.ExpenseResponse
+	for _, e := range expenses {
+		expenseResponses = append(expenseResponses, presentation.ToExpenseResponse(e))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ExpensesListResponse{
+		Expenses: expenseResponses,
+		Total:    len(expenseResponses),
+	})
+}
+
+// DeleteExpense godoc
+// @Summary Delete a property expense
+// @Description Delete an expense of a property
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param expenseId path string true "Expense ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/expenses/{expenseId} [delete]
+func (h *PropertyHandler) DeleteExpense(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.propertyService.HandleDeleteExpenseCommand(c.Context(), realestate.DeleteExpenseCommand{
+		PropertyID: c.Params("id"),
+		ExpenseID:  c.Params("expenseId"),
+		UserID:     userIDValue.String(),
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetPerformance godoc
+// @Summary Get the performance of a property
+// @Description Get the value, appreciation, equity after the mortgage and gross and net rental yield of a property as of a date. Rent is the RENT income recorded on the asset over the trailing year
+// @Tags properties
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Property ID"
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.PerformanceResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /properties/{id}/performance [get]
+func (h *PropertyHandler) GetPerformance(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	asOf, err := asOfQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	performance, err := h.propertyService.HandleGetPerformanceQuery(c.Context(), realestate.GetPerformanceQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+		AsOf:   asOf,
+	})
+	if err != nil {
+		return propertyError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToPerformanceResponse(performance))
+}
+
+func propertyError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "property not found", "unauthorized: property does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Property not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user",
+		"loan not found", "unauthorized: loan does not belong to user",
+		"appraisal not found", "expense not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
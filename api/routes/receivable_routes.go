@@ -0,0 +1,500 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/receivable"
+	presentation "siyahsensei/wallet-service/presentation/receivable"
+)
+
+type ReceivableHandler struct {
+	receivableService *receivable.Handler
+}
+
+func NewReceivableHandler(receivableService *receivable.Handler) *ReceivableHandler {
+	return &ReceivableHandler{
+		receivableService: receivableService,
+	}
+}
+
+func (h *ReceivableHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	receivableGroup := router.Group("/receivables", authMiddleware)
+
+	receivableGroup.Post("/", h.CreateReceivable)
+	receivableGroup.Get("/", h.GetUserReceivables)
+	receivableGroup.Get("/overdue", h.GetOverdueReceivables)
+	receivableGroup.Get("/aging", h.GetAging)
+	receivableGroup.Get("/:id", h.GetReceivableByID)
+	receivableGroup.Put("/:id", h.UpdateReceivable)
+	receivableGroup.Delete("/:id", h.DeleteReceivable)
+	receivableGroup.Post("/:id/repayments", h.RecordRepayment)
+	receivableGroup.Get("/:id/repayments", h.GetRepayments)
+	receivableGroup.Post("/:id/write-off", h.WriteOff)
+}
+
+// CreateReceivable godoc
+// @Summary Set the terms of a receivable
+// @Description Attach a counterparty, amount and due date to a RECEIVABLE asset. The amount defaults to the asset quantity
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param receivable body presentation.CreateReceivableRequest true "Receivable data"
+// @Success 201 {object} map[string]presentation.ReceivableResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables [post]
+func (h *ReceivableHandler) CreateReceivable(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateReceivableRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := receivable.CreateReceivableCommand{
+		UserID:              userIDValue.String(),
+		AssetID:             req.AssetID,
+		CounterpartyName:    req.CounterpartyName,
+		CounterpartyContact: req.CounterpartyContact,
+		Amount:              req.Amount,
+		IssueDate:           req.IssueDate,
+		DueDate:             req.DueDate,
+	}
+
+	r, err := h.receivableService.HandleCreateReceivableCommand(c.Context(), command)
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"receivable": presentation.ToReceivableResponse(r, time.Now()),
+	})
+}
+
+// GetUserReceivables godoc
+// @Summary List receivables
+// @Description List the receivables of the authenticated user, soonest due first, optionally only those with a status as of a date
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "Status (OPEN, PARTIALLY_PAID, OVERDUE, PAID, WRITTEN_OFF)"
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.ReceivablesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /receivables [get]
+func (h *ReceivableHandler) GetUserReceivables(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	asOf, err := asOfQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	query := receivable.GetUserReceivablesQuery{
+		UserID: userIDValue.String(),
+		AsOf:   asOf,
+	}
+	if status := c.Query("status"); status != "" {
+		val := receivable.Status(status)
+		query.Status = &val
+	}
+
+	receivables, err := h.receivableService.HandleGetUserReceivablesQuery(c.Context(), query)
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(toReceivablesList(receivables, asOf))
+}
+
+// GetOverdueReceivables godoc
+// @Summary List overdue receivables
+// @Description List the receivables past their due date with an amount still outstanding, longest overdue first
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.ReceivablesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /receivables/overdue [get]
+func (h *ReceivableHandler) GetOverdueReceivables(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	asOf, err := asOfQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	receivables, err := h.receivableService.HandleGetOverdueQuery(c.Context(), receivable.GetOverdueQuery{
+		UserID: userIDValue.String(),
+		AsOf:   asOf,
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(toReceivablesList(receivables, asOf))
+}
+
+// GetAging godoc
+// @Summary Get the receivables aging report
+// @Description Group what is still owed by days past due (0-30, 31-60, 61-90 and 90+) in the base currency, with amounts not yet due kept apart
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Param base query string false "Base Currency (defaults to the configured base currency)"
+// @Success 200 {object} presentation.AgingResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /receivables/aging [get]
+func (h *ReceivableHandler) GetAging(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	asOf, err := asOfQuery(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	aging, err := h.receivableService.HandleGetAgingQuery(c.Context(), receivable.GetAgingQuery{
+		UserID:       userIDValue.String(),
+		AsOf:         asOf,
+		BaseCurrency: c.Query("base"),
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToAgingResponse(aging))
+}
+
+// GetReceivableByID godoc
+// @Summary Get receivable by ID
+// @Description Get a specific receivable by ID for the authenticated user
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Success 200 {object} map[string]presentation.ReceivableResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id} [get]
+func (h *ReceivableHandler) GetReceivableByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	r, err := h.receivableService.HandleGetReceivableByIDQuery(c.Context(), receivable.GetReceivableByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"receivable": presentation.ToReceivableResponse(r, time.Now()),
+	})
+}
+
+// UpdateReceivable godoc
+// @Summary Update a receivable
+// @Description Update the counterparty, amount or dates of a receivable
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Param receivable body presentation.UpdateReceivableRequest true "Receivable data"
+// @Success 200 {object} map[string]presentation.ReceivableResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id} [put]
+func (h *ReceivableHandler) UpdateReceivable(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateReceivableRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := receivable.UpdateReceivableCommand{
+		ID:                  c.Params("id"),
+		UserID:              userIDValue.String(),
+		CounterpartyName:    req.CounterpartyName,
+		CounterpartyContact: req.CounterpartyContact,
+		Amount:              req.Amount,
+		IssueDate:           req.IssueDate,
+		DueDate:             req.DueDate,
+	}
+
+	r, err := h.receivableService.HandleUpdateReceivableCommand(c.Context(), command)
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"receivable": presentation.ToReceivableResponse(r, time.Now()),
+	})
+}
+
+// DeleteReceivable godoc
+// @Summary Delete a receivable
+// @Description Remove the terms and repayment history of a receivable. The asset and its ledger are kept
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id} [delete]
+func (h *ReceivableHandler) DeleteReceivable(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.receivableService.HandleDeleteReceivableCommand(c.Context(), receivable.DeleteReceivableCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// RecordRepayment godoc
+// @Summary Record a repayment
+// @Description Record money received back on a receivable. The amount reduces the receivable; with toAssetId it is also deposited into that asset
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Param repayment body presentation.RecordRepaymentRequest true "Repayment data"
+// @Success 201 {object} map[string]presentation.RepaymentResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id}/repayments [post]
+func (h *ReceivableHandler) RecordRepayment(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.RecordRepaymentRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := receivable.RecordRepaymentCommand{
+		ReceivableID: c.Params("id"),
+		UserID:       userIDValue.String(),
+		Amount:       req.Amount,
+		RepaidAt:     req.RepaidAt,
+		ToAssetID:    req.ToAssetID,
+		Notes:        req.Notes,
+	}
+
+	repayment, err := h.receivableService.HandleRecordRepaymentCommand(c.Context(), command)
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"repayment": presentation.ToRepaymentResponse(repayment),
+	})
+}
+
+// GetRepayments godoc
+// @Summary List repayments
+// @Description List the repayments recorded on a receivable, oldest first
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Success 200 {object} presentation.RepaymentsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id}/repayments [get]
+func (h *ReceivableHandler) GetRepayments(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	repayments, err := h.receivableService.HandleGetRepaymentsQuery(c.Context(), receivable.GetRepaymentsQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	var repaymentResponses []presentation.RepaymentResponse
+	for _, r := range repayments {
+		repaymentResponses = append(repaymentResponses, presentation.ToRepaymentResponse(r))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.RepaymentsListResponse{
+		Repayments: repaymentResponses,
+		Total:      len(repaymentResponses),
+	})
+}
+
+// WriteOff godoc
+// @Summary Write off a receivable
+// @Description Give up on what is still outstanding. The remaining amount is withdrawn from the receivable asset
+// @Tags receivables
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Receivable ID"
+// @Param writeOff body presentation.WriteOffRequest false "Write-off data"
+// @Success 200 {object} map[string]presentation.ReceivableResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /receivables/{id}/write-off [post]
+func (h *ReceivableHandler) WriteOff(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.WriteOffRequest
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	r, err := h.receivableService.HandleWriteOffCommand(c.Context(), receivable.WriteOffCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+		Date:   req.Date,
+		Notes:  req.Notes,
+	})
+	if err != nil {
+		return receivableError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"receivable": presentation.ToReceivableResponse(r, time.Now()),
+	})
+}
+
+func toReceivablesList(receivables []*receivable.Receivable, asOf time.Time) presentation.ReceivablesListResponse {
+	if asOf.IsZero() {
+		asOf = time.Now()
+	}
+
+	var receivableResponses []presentation.ReceivableResponse
+	for _, r := range receivables {
+		receivableResponses = append(receivableResponses, presentation.ToReceivableResponse(r, asOf))
+	}
+
+	return presentation.ReceivablesListResponse{
+		Receivables: receivableResponses,
+		Total:       len(receivableResponses),
+	}
+}
+
+// asOfQuery reads the optional date query parameter, zero when it is absent.
+func asOfQuery(c *fiber.Ctx) (time.Time, error) {
+	date := c.Query("date")
+	if date == "" {
+		return time.Time{}, nil
+	}
+	val, err := time.Parse(time.RFC3339, date)
+	if err != nil {
+		return time.Time{}, fiber.NewError(fiber.StatusBadRequest, "Invalid date, expected RFC3339")
+	}
+	return val, nil
+}
+
+func receivableError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "receivable not found", "unauthorized: receivable does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Receivable not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user", "definition not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,417 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/recurring"
+	presentation "siyahsensei/wallet-service/presentation/recurring"
+)
+
+type RecurringHandler struct {
+	recurringService *recurring.Handler
+}
+
+func NewRecurringHandler(recurringService *recurring.Handler) *RecurringHandler {
+	return &RecurringHandler{
+		recurringService: recurringService,
+	}
+}
+
+func (h *RecurringHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	recurringGroup := router.Group("/recurring", authMiddleware)
+
+	recurringGroup.Post("/", h.CreateRule)
+	recurringGroup.Get("/", h.GetUserRules)
+	recurringGroup.Get("/upcoming", h.GetUpcoming)
+	recurringGroup.Get("/:id", h.GetRuleByID)
+	recurringGroup.Put("/:id", h.UpdateRule)
+	recurringGroup.Delete("/:id", h.DeleteRule)
+	recurringGroup.Get("/:id/occurrences", h.GetOccurrences)
+	recurringGroup.Get("/:id/preview", h.PreviewRule)
+}
+
+// CreateRule godoc
+// @Summary Create a recurring rule
+// @Description Create a rule that posts a transaction into an account on a weekly, monthly or yearly schedule
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param rule body presentation.CreateRuleRequest true "Rule data"
+// @Success 201 {object} map[string]presentation.RuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring [post]
+func (h *RecurringHandler) CreateRule(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := recurring.CreateRuleCommand{
+		UserID:          userIDValue.String(),
+		AccountID:       req.AccountID,
+		DefinitionID:    req.DefinitionID,
+		Name:            req.Name,
+		AssetType:       req.AssetType,
+		TransactionType: req.TransactionType,
+		Amount:          req.Amount,
+		Price:           req.Price,
+		Currency:        req.Currency,
+		Frequency:       req.Frequency,
+		Interval:        req.Interval,
+		DayOfMonth:      req.DayOfMonth,
+		DayOfWeek:       req.DayOfWeek,
+		MonthOfYear:     req.MonthOfYear,
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		Notes:           req.Notes,
+	}
+
+	rule, err := h.recurringService.HandleCreateRuleCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "account not found" || err.Error() == "unauthorized: account does not belong to user" ||
+			err.Error() == "definition not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"rule": presentation.ToRuleResponse(rule),
+	})
+}
+
+// GetUserRules godoc
+// @Summary List recurring rules
+// @Description List the recurring rules of the authenticated user
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.RulesListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /recurring [get]
+func (h *RecurringHandler) GetUserRules(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	rules, err := h.recurringService.HandleGetUserRulesQuery(c.Context(), recurring.GetUserRulesQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var ruleResponses []presentation.RuleResponse
+	for _, rule := range rules {
+		ruleResponses = append(ruleResponses, presentation.ToRuleResponse(rule))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.RulesListResponse{
+		Rules: ruleResponses,
+		Total: len(ruleResponses),
+	})
+}
+
+// GetUpcoming godoc
+// @Summary Preview upcoming occurrences
+// @Description List the upcoming occurrences of all active recurring rules of the authenticated user
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param until query string false "Until Date (RFC3339, defaults to three months from now)"
+// @Param count query int false "Maximum number of occurrences" default(100)
+// @Success 200 {object} presentation.PreviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /recurring/upcoming [get]
+func (h *RecurringHandler) GetUpcoming(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query, err := previewQuery(c, userIDValue)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return h.preview(c, query)
+}
+
+// GetRuleByID godoc
+// @Summary Get recurring rule by ID
+// @Description Get a specific recurring rule by ID for the authenticated user
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Success 200 {object} map[string]presentation.RuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring/{id} [get]
+func (h *RecurringHandler) GetRuleByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	rule, err := h.recurringService.HandleGetRuleByIDQuery(c.Context(), recurring.GetRuleByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return recurringError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rule": presentation.ToRuleResponse(rule),
+	})
+}
+
+// UpdateRule godoc
+// @Summary Update a recurring rule
+// @Description Update the amount, schedule or state of a recurring rule. A paused rule that is resumed does not post the occurrences it missed
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Param rule body presentation.UpdateRuleRequest true "Rule data"
+// @Success 200 {object} map[string]presentation.RuleResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring/{id} [put]
+func (h *RecurringHandler) UpdateRule(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateRuleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := recurring.UpdateRuleCommand{
+		ID:          c.Params("id"),
+		UserID:      userIDValue.String(),
+		Name:        req.Name,
+		Amount:      req.Amount,
+		Price:       req.Price,
+		Currency:    req.Currency,
+		Frequency:   req.Frequency,
+		Interval:    req.Interval,
+		DayOfMonth:  req.DayOfMonth,
+		DayOfWeek:   req.DayOfWeek,
+		MonthOfYear: req.MonthOfYear,
+		EndDate:     req.EndDate,
+		Active:      req.Active,
+		Notes:       req.Notes,
+	}
+
+	rule, err := h.recurringService.HandleUpdateRuleCommand(c.Context(), command)
+	if err != nil {
+		return recurringError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rule": presentation.ToRuleResponse(rule),
+	})
+}
+
+// DeleteRule godoc
+// @Summary Delete a recurring rule
+// @Description Delete a recurring rule. Transactions it already posted are kept
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring/{id} [delete]
+func (h *RecurringHandler) DeleteRule(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.recurringService.HandleDeleteRuleCommand(c.Context(), recurring.DeleteRuleCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return recurringError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetOccurrences godoc
+// @Summary List materialised occurrences
+// @Description List the occurrences a recurring rule has posted or skipped
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Success 200 {object} presentation.OccurrencesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring/{id}/occurrences [get]
+func (h *RecurringHandler) GetOccurrences(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	occurrences, err := h.recurringService.HandleGetOccurrencesQuery(c.Context(), recurring.GetOccurrencesQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return recurringError(c, err)
+	}
+
+	var occurrenceResponses []presentation.OccurrenceResponse
+	for _, o := range occurrences {
+		occurrenceResponses = append(occurrenceResponses, presentation.ToOccurrenceResponse(o))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.OccurrencesListResponse{
+		Occurrences: occurrenceResponses,
+		Total:       len(occurrenceResponses),
+	})
+}
+
+// PreviewRule godoc
+// @Summary Preview upcoming occurrences of a rule
+// @Description List the upcoming occurrences of one recurring rule
+// @Tags recurring
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Rule ID"
+// @Param until query string false "Until Date (RFC3339, defaults to three months from now)"
+// @Param count query int false "Maximum number of occurrences" default(100)
+// @Success 200 {object} presentation.PreviewResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /recurring/{id}/preview [get]
+func (h *RecurringHandler) PreviewRule(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query, err := previewQuery(c, userIDValue)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	ruleID := c.Params("id")
+	query.RuleID = &ruleID
+
+	return h.preview(c, query)
+}
+
+func (h *RecurringHandler) preview(c *fiber.Ctx, query recurring.PreviewQuery) error {
+	upcoming, err := h.recurringService.HandlePreviewQuery(c.Context(), query)
+	if err != nil {
+		return recurringError(c, err)
+	}
+
+	var upcomingResponses []presentation.UpcomingOccurrenceResponse
+	for _, u := range upcoming {
+		upcomingResponses = append(upcomingResponses, presentation.ToUpcomingOccurrenceResponse(u))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.PreviewResponse{
+		Upcoming: upcomingResponses,
+		Total:    len(upcomingResponses),
+	})
+}
+
+func previewQuery(c *fiber.Ctx, userID uuid.UUID) (recurring.PreviewQuery, error) {
+	query := recurring.PreviewQuery{
+		UserID: userID.String(),
+	}
+
+	if until := c.Query("until"); until != "" {
+		val, err := time.Parse(time.RFC3339, until)
+		if err != nil {
+			return query, fiber.NewError(fiber.StatusBadRequest, "Invalid until date, expected RFC3339")
+		}
+		query.Until = val
+	}
+
+	if count := c.Query("count"); count != "" {
+		if val, err := strconv.Atoi(count); err == nil {
+			query.Count = val
+		}
+	}
+
+	return query, nil
+}
+
+func recurringError(c *fiber.Ctx, err error) error {
+	if err.Error() == "recurring rule not found" || err.Error() == "unauthorized: recurring rule does not belong to user" {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Recurring rule not found",
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,96 @@
+package routes
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/capitalgains"
+	presentation "siyahsensei/wallet-service/presentation/capitalgains"
+)
+
+type ReportHandler struct {
+	capitalGainsService *capitalgains.Handler
+}
+
+func NewReportHandler(capitalGainsService *capitalgains.Handler) *ReportHandler {
+	return &ReportHandler{
+		capitalGainsService: capitalGainsService,
+	}
+}
+
+func (h *ReportHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	reportGroup := router.Group("/reports", authMiddleware)
+
+	reportGroup.Get("/capital-gains", h.GetCapitalGains)
+}
+
+// GetCapitalGains godoc
+// @Summary Get the capital gains report of a fiscal year
+// @Description List the gain of every lot disposed by a sale in the fiscal year with its acquisition and disposal dates, proceeds, cost and short or long term holding period, under the rules of a jurisdiction (GENERIC, US, UK or DE). Amounts are converted into the report currency at the rates of the dates the jurisdiction prescribes. format=csv, or an Accept header of text/csv, downloads the lines as CSV
+// @Tags reports
+// @Accept json
+// @Produce json
+// @Produce text/csv
+// @Security BearerAuth
+// @Param year query int true "Fiscal Year"
+// @Param jurisdiction query string false "Jurisdiction (defaults to the configured jurisdiction)"
+// @Param currency query string false "Report Currency (defaults to the jurisdiction's currency, then the configured base currency)"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} presentation.CapitalGainsResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /reports/capital-gains [get]
+func (h *ReportHandler) GetCapitalGains(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	year, err := strconv.Atoi(c.Query("year"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid year",
+		})
+	}
+
+	format := strings.ToLower(c.Query("format"))
+	if format == "" && c.Accepts(fiber.MIMEApplicationJSON, "text/csv") == "text/csv" {
+		format = "csv"
+	}
+	if format != "" && format != "json" && format != "csv" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid format",
+		})
+	}
+
+	report, err := h.capitalGainsService.HandleGetReportQuery(c.Context(), capitalgains.GetReportQuery{
+		UserID:       userIDValue.String(),
+		Year:         year,
+		Jurisdiction: c.Query("jurisdiction"),
+		Currency:     c.Query("currency"),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if format == "csv" {
+		var body bytes.Buffer
+		if err := report.WriteCSV(&body); err != nil {
+			return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+				"error": "Failed to write report",
+			})
+		}
+		c.Set(fiber.HeaderContentType, "text/csv; charset=utf-8")
+		c.Attachment(report.FileName())
+		return c.Status(fiber.StatusOK).Send(body.Bytes())
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToCapitalGainsResponse(report))
+}
@@ -0,0 +1,299 @@
+package routes
+
+import (
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/termdeposit"
+	presentation "siyahsensei/wallet-service/presentation/termdeposit"
+)
+
+type TermDepositHandler struct {
+	termDepositService *termdeposit.Handler
+}
+
+func NewTermDepositHandler(termDepositService *termdeposit.Handler) *TermDepositHandler {
+	return &TermDepositHandler{
+		termDepositService: termDepositService,
+	}
+}
+
+func (h *TermDepositHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	termDepositGroup := router.Group("/term-deposits", authMiddleware)
+
+	termDepositGroup.Post("/", h.CreateTermDeposit)
+	termDepositGroup.Get("/", h.GetUserTermDeposits)
+	termDepositGroup.Get("/:id", h.GetTermDepositByID)
+	termDepositGroup.Put("/:id", h.UpdateTermDeposit)
+	termDepositGroup.Delete("/:id", h.DeleteTermDeposit)
+	termDepositGroup.Get("/:id/accrual", h.GetAccrual)
+}
+
+// CreateTermDeposit godoc
+// @Summary Set the terms of a term deposit
+// @Description Attach principal, rate, compounding, term, withholding tax and maturity action to a TERM_DEPOSIT asset. The principal defaults to the asset quantity
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param termDeposit body presentation.CreateTermDepositRequest true "Term deposit data"
+// @Success 201 {object} map[string]presentation.TermDepositResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /term-deposits [post]
+func (h *TermDepositHandler) CreateTermDeposit(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateTermDepositRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := termdeposit.CreateTermDepositCommand{
+		UserID:             userIDValue.String(),
+		AssetID:            req.AssetID,
+		Principal:          req.Principal,
+		AnnualRate:         req.AnnualRate,
+		Compounding:        req.Compounding,
+		StartDate:          req.StartDate,
+		MaturityDate:       req.MaturityDate,
+		WithholdingTaxRate: req.WithholdingTaxRate,
+		MaturityAction:     req.MaturityAction,
+		PayoutAccountID:    req.PayoutAccountID,
+	}
+
+	deposit, err := h.termDepositService.HandleCreateTermDepositCommand(c.Context(), command)
+	if err != nil {
+		return termDepositError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"termDeposit": presentation.ToTermDepositResponse(deposit),
+	})
+}
+
+// GetUserTermDeposits godoc
+// @Summary List term deposits
+// @Description List the term deposits of the authenticated user, soonest maturity first
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.TermDepositsListResponse
+// @Failure 401 {object} map[string]string
+// @Failure 500 {object} map[string]string
+// @Router /term-deposits [get]
+func (h *TermDepositHandler) GetUserTermDeposits(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	deposits, err := h.termDepositService.HandleGetUserTermDepositsQuery(c.Context(), termdeposit.GetUserTermDepositsQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var depositResponses []presentation.TermDepositResponse
+	for _, d := range deposits {
+		depositResponses = append(depositResponses, presentation.ToTermDepositResponse(d))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.TermDepositsListResponse{
+		TermDeposits: depositResponses,
+		Total:        len(depositResponses),
+	})
+}
+
+// GetTermDepositByID godoc
+// @Summary Get term deposit by ID
+// @Description Get a specific term deposit by ID for the authenticated user
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Term Deposit ID"
+// @Success 200 {object} map[string]presentation.TermDepositResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /term-deposits/{id} [get]
+func (h *TermDepositHandler) GetTermDepositByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	deposit, err := h.termDepositService.HandleGetTermDepositByIDQuery(c.Context(), termdeposit.GetTermDepositByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return termDepositError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"termDeposit": presentation.ToTermDepositResponse(deposit),
+	})
+}
+
+// UpdateTermDeposit godoc
+// @Summary Update a term deposit
+// @Description Update the rate, maturity, withholding tax or maturity action of an active term deposit
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Term Deposit ID"
+// @Param termDeposit body presentation.UpdateTermDepositRequest true "Term deposit data"
+// @Success 200 {object} map[string]presentation.TermDepositResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /term-deposits/{id} [put]
+func (h *TermDepositHandler) UpdateTermDeposit(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateTermDepositRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := termdeposit.UpdateTermDepositCommand{
+		ID:                 c.Params("id"),
+		UserID:             userIDValue.String(),
+		AnnualRate:         req.AnnualRate,
+		Compounding:        req.Compounding,
+		MaturityDate:       req.MaturityDate,
+		WithholdingTaxRate: req.WithholdingTaxRate,
+		MaturityAction:     req.MaturityAction,
+		PayoutAccountID:    req.PayoutAccountID,
+	}
+
+	deposit, err := h.termDepositService.HandleUpdateTermDepositCommand(c.Context(), command)
+	if err != nil {
+		return termDepositError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"termDeposit": presentation.ToTermDepositResponse(deposit),
+	})
+}
+
+// DeleteTermDeposit godoc
+// @Summary Delete a term deposit
+// @Description Remove the terms of a term deposit. The asset and its ledger are kept
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Term Deposit ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /term-deposits/{id} [delete]
+func (h *TermDepositHandler) DeleteTermDeposit(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.termDepositService.HandleDeleteTermDepositCommand(c.Context(), termdeposit.DeleteTermDepositCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return termDepositError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetAccrual godoc
+// @Summary Get accrued interest of a term deposit
+// @Description Get the interest accrued up to a date and the expected payout at maturity, both before and after withholding tax
+// @Tags term-deposits
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Term Deposit ID"
+// @Param date query string false "As Of Date (RFC3339, defaults to now)"
+// @Success 200 {object} presentation.AccrualResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /term-deposits/{id}/accrual [get]
+func (h *TermDepositHandler) GetAccrual(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := termdeposit.GetAccrualQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+
+	if date := c.Query("date"); date != "" {
+		val, err := time.Parse(time.RFC3339, date)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid date, expected RFC3339",
+			})
+		}
+		query.AsOf = val
+	}
+
+	accrual, err := h.termDepositService.HandleGetAccrualQuery(c.Context(), query)
+	if err != nil {
+		return termDepositError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.ToAccrualResponse(accrual))
+}
+
+func termDepositError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "term deposit not found", "unauthorized: term deposit does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Term deposit not found",
+		})
+	case "asset not found", "unauthorized: asset does not belong to user",
+		"account not found", "unauthorized: account does not belong to user", "definition not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
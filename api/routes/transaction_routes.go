@@ -0,0 +1,275 @@
+package routes
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/transaction"
+	presentation "siyahsensei/wallet-service/presentation/transaction"
+)
+
+type TransactionHandler struct {
+	transactionService *transaction.Handler
+}
+
+func NewTransactionHandler(transactionService *transaction.Handler) *TransactionHandler {
+	return &TransactionHandler{
+		transactionService: transactionService,
+	}
+}
+
+func (h *TransactionHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	transactionGroup := router.Group("/transactions", authMiddleware)
+
+	transactionGroup.Post("/", h.CreateTransaction)
+	transactionGroup.Delete("/:id", h.DeleteTransaction)
+	transactionGroup.Get("/", h.FilterTransactions)
+	transactionGroup.Get("/:id", h.GetTransactionByID)
+}
+
+// CreateTransaction godoc
+// @Summary Record a transaction
+// @Description Record a buy, sell, deposit, withdraw, fee or adjustment against an asset. The asset quantity is re-derived from its transaction history
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param transaction body presentation.CreateTransactionRequest true "Transaction data"
+// @Success 201 {object} map[string]presentation.TransactionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 403 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /transactions [post]
+func (h *TransactionHandler) CreateTransaction(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateTransactionRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := transaction.CreateTransactionCommand{
+		UserID:          userIDValue.String(),
+		AssetID:         req.AssetID,
+		Type:            req.Type,
+		Quantity:        req.Quantity,
+		Price:           req.Price,
+		Currency:        req.Currency,
+		Notes:           req.Notes,
+		TransactionDate: req.TransactionDate,
+	}
+
+	createdTransaction, err := h.transactionService.HandleCreateTransactionCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "asset not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		if err.Error() == "unauthorized: asset does not belong to user" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"transaction": presentation.ToTransactionResponse(createdTransaction),
+	})
+}
+
+// DeleteTransaction godoc
+// @Summary Delete a transaction
+// @Description Delete a transaction and re-derive the quantity of its asset
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transaction ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /transactions/{id} [delete]
+func (h *TransactionHandler) DeleteTransaction(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	transactionID := c.Params("id")
+	if transactionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction ID is required",
+		})
+	}
+
+	command := transaction.DeleteTransactionCommand{
+		ID:     transactionID,
+		UserID: userIDValue.String(),
+	}
+
+	err := h.transactionService.HandleDeleteTransactionCommand(c.Context(), command)
+	if err != nil {
+		if err.Error() == "transaction not found" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		if err.Error() == "unauthorized: transaction does not belong to user" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetTransactionByID godoc
+// @Summary Get transaction by ID
+// @Description Get a specific transaction by ID for the authenticated user
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Transaction ID"
+// @Success 200 {object} map[string]presentation.TransactionResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /transactions/{id} [get]
+func (h *TransactionHandler) GetTransactionByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	transactionID := c.Params("id")
+	if transactionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Transaction ID is required",
+		})
+	}
+
+	query := transaction.GetTransactionByIDQuery{
+		ID:     transactionID,
+		UserID: userIDValue.String(),
+	}
+
+	foundTransaction, err := h.transactionService.HandleGetTransactionByIDQuery(c.Context(), query)
+	if err != nil {
+		if err.Error() == "transaction not found" || err.Error() == "unauthorized: transaction does not belong to user" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "Transaction not found",
+			})
+		}
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"transaction": presentation.ToTransactionResponse(foundTransaction),
+	})
+}
+
+// FilterTransactions godoc
+// @Summary List transactions
+// @Description List transactions of the authenticated user with optional filters
+// @Tags transactions
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param assetId query string false "Asset ID"
+// @Param type query string false "Transaction Type"
+// @Param from query string false "From Date (RFC3339)"
+// @Param to query string false "To Date (RFC3339)"
+// @Param limit query int false "Limit number of results"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} presentation.TransactionsListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /transactions [get]
+func (h *TransactionHandler) FilterTransactions(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := transaction.FilterTransactionsQuery{
+		UserID: userIDValue.String(),
+	}
+
+	if assetID := c.Query("assetId"); assetID != "" {
+		query.AssetID = &assetID
+	}
+
+	if transactionType := c.Query("type"); transactionType != "" {
+		tt := transaction.TransactionType(transactionType)
+		query.Type = &tt
+	}
+
+	if from := c.Query("from"); from != "" {
+		if val, err := time.Parse(time.RFC3339, from); err == nil {
+			query.From = &val
+		}
+	}
+
+	if to := c.Query("to"); to != "" {
+		if val, err := time.Parse(time.RFC3339, to); err == nil {
+			query.To = &val
+		}
+	}
+
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			query.Limit = val
+		}
+	}
+
+	if offset := c.Query("offset"); offset != "" {
+		if val, err := strconv.Atoi(offset); err == nil {
+			query.Offset = val
+		}
+	}
+
+	transactions, err := h.transactionService.HandleFilterTransactionsQuery(c.Context(), query)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var transactionResponses []presentation.TransactionResponse
+	for _, t := range transactions {
+		transactionResponses = append(transactionResponses, presentation.ToTransactionResponse(t))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.TransactionsListResponse{
+		Transactions: transactionResponses,
+		Total:        len(transactionResponses),
+	})
+}
@@ -0,0 +1,399 @@
+package routes
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/webhook"
+	presentation "siyahsensei/wallet-service/presentation/webhook"
+)
+
+type WebhookHandler struct {
+	webhookService *webhook.Handler
+}
+
+func NewWebhookHandler(webhookService *webhook.Handler) *WebhookHandler {
+	return &WebhookHandler{
+		webhookService: webhookService,
+	}
+}
+
+func (h *WebhookHandler) RegisterRoutes(router fiber.Router, authMiddleware fiber.Handler) {
+	webhookGroup := router.Group("/webhooks", authMiddleware)
+
+	webhookGroup.Post("/", h.CreateWebhook)
+	webhookGroup.Get("/", h.GetUserWebhooks)
+	webhookGroup.Get("/event-types", h.GetEventTypes)
+	webhookGroup.Get("/:id", h.GetWebhookByID)
+	webhookGroup.Put("/:id", h.UpdateWebhook)
+	webhookGroup.Delete("/:id", h.DeleteWebhook)
+	webhookGroup.Get("/:id/deliveries", h.GetDeliveries)
+	webhookGroup.Get("/:id/deliveries/:deliveryId", h.GetDeliveryByID)
+	webhookGroup.Post("/:id/deliveries/:deliveryId/redeliver", h.Redeliver)
+}
+
+// CreateWebhook godoc
+// @Summary Create a webhook
+// @Description Post the given event types of the authenticated user, or every event with "*", to a URL, which must resolve to public addresses only. Each request carries the X-Wallet-Event, X-Wallet-Delivery and X-Wallet-Timestamp headers and X-Wallet-Signature: sha256= followed by the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the secret. A secret is generated when none is given and is only returned here. Deliveries answered with anything but a 2xx status are retried with exponential backoff, and redirects are not followed
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param webhook body presentation.CreateWebhookRequest true "Webhook data"
+// @Success 201 {object} map[string]presentation.WebhookResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Router /webhooks [post]
+func (h *WebhookHandler) CreateWebhook(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.CreateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := webhook.CreateSubscriptionCommand{
+		UserID:      userIDValue.String(),
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      req.Secret,
+		Description: req.Description,
+	}
+
+	subscription, err := h.webhookService.HandleCreateSubscriptionCommand(c.Context(), command)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"webhook": presentation.ToCreatedWebhookResponse(subscription),
+	})
+}
+
+// GetUserWebhooks godoc
+// @Summary List webhooks
+// @Description List the webhooks of the authenticated user
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.WebhooksListResponse
+// @Failure 401 {object} map[string]string
+// @Router /webhooks [get]
+func (h *WebhookHandler) GetUserWebhooks(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	subscriptions, err := h.webhookService.HandleGetUserSubscriptionsQuery(c.Context(), webhook.GetUserSubscriptionsQuery{
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	var webhookResponses []presentation.WebhookResponse
+	for _, s := range subscriptions {
+		webhookResponses = append(webhookResponses, presentation.ToWebhookResponse(s))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.WebhooksListResponse{
+		Webhooks: webhookResponses,
+		Total:    len(webhookResponses),
+	})
+}
+
+// GetEventTypes godoc
+// @Summary List webhook event types
+// @Description List the event types a webhook can subscribe to. Transaction events cover every ledger entry, including those made by transfers, imports, recurring rules, term deposit settlements and loan and receivable payments; restoring an archive emits no events
+// @Tags webhooks
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} presentation.EventTypesResponse
+// @Failure 401 {object} map[string]string
+// @Router /webhooks/event-types [get]
+func (h *WebhookHandler) GetEventTypes(c *fiber.Ctx) error {
+	eventTypes := make([]string, 0, len(event.Types))
+	for _, t := range event.Types {
+		eventTypes = append(eventTypes, string(t))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.EventTypesResponse{
+		EventTypes: eventTypes,
+	})
+}
+
+// GetWebhookByID godoc
+// @Summary Get a webhook
+// @Description Get a webhook of the authenticated user
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Success 200 {object} map[string]presentation.WebhookResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id} [get]
+func (h *WebhookHandler) GetWebhookByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	subscription, err := h.webhookService.HandleGetSubscriptionByIDQuery(c.Context(), webhook.GetSubscriptionByIDQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"webhook": presentation.ToWebhookResponse(subscription),
+	})
+}
+
+// UpdateWebhook godoc
+// @Summary Update a webhook
+// @Description Change the URL, event types or description of a webhook, pause it with enabled false, or rotate the signing secret by setting a new one
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param webhook body presentation.UpdateWebhookRequest true "Webhook data"
+// @Success 200 {object} map[string]presentation.WebhookResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	var req presentation.UpdateWebhookRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	command := webhook.UpdateSubscriptionCommand{
+		ID:          c.Params("id"),
+		UserID:      userIDValue.String(),
+		URL:         req.URL,
+		EventTypes:  req.EventTypes,
+		Secret:      req.Secret,
+		Description: req.Description,
+		Enabled:     req.Enabled,
+	}
+
+	subscription, err := h.webhookService.HandleUpdateSubscriptionCommand(c.Context(), command)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"webhook": presentation.ToWebhookResponse(subscription),
+	})
+}
+
+// DeleteWebhook godoc
+// @Summary Delete a webhook
+// @Description Delete a webhook with its delivery log; pending deliveries are dropped
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Success 204 "No Content"
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	err := h.webhookService.HandleDeleteSubscriptionCommand(c.Context(), webhook.DeleteSubscriptionCommand{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusNoContent).Send(nil)
+}
+
+// GetDeliveries godoc
+// @Summary List the deliveries of a webhook
+// @Description List the deliveries of a webhook, newest first, with the response of the last attempt and when the next one is due
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param status query string false "PENDING, SUCCEEDED or FAILED"
+// @Param limit query int false "Limit number of results"
+// @Param offset query int false "Offset for pagination"
+// @Success 200 {object} presentation.WebhookDeliveriesListResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id}/deliveries [get]
+func (h *WebhookHandler) GetDeliveries(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	query := webhook.GetDeliveriesQuery{
+		ID:     c.Params("id"),
+		UserID: userIDValue.String(),
+	}
+	if status := c.Query("status"); status != "" {
+		s := webhook.Status(strings.ToUpper(status))
+		query.Status = &s
+	}
+	if limit := c.Query("limit"); limit != "" {
+		if val, err := strconv.Atoi(limit); err == nil {
+			query.Limit = val
+		}
+	}
+	if offset := c.Query("offset"); offset != "" {
+		if val, err := strconv.Atoi(offset); err == nil {
+			query.Offset = val
+		}
+	}
+
+	deliveries, err := h.webhookService.HandleGetDeliveriesQuery(c.Context(), query)
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	var deliveryResponses []presentation.WebhookDeliveryResponse
+	for _, d := range deliveries {
+		deliveryResponses = append(deliveryResponses, presentation.ToWebhookDeliveryResponse(d))
+	}
+
+	return c.Status(fiber.StatusOK).JSON(presentation.WebhookDeliveriesListResponse{
+		Deliveries: deliveryResponses,
+		Total:      len(deliveryResponses),
+	})
+}
+
+// GetDeliveryByID godoc
+// @Summary Get a webhook delivery
+// @Description Get a delivery of a webhook with the payload that was posted
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param deliveryId path string true "Delivery ID"
+// @Success 200 {object} map[string]presentation.WebhookDeliveryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id}/deliveries/{deliveryId} [get]
+func (h *WebhookHandler) GetDeliveryByID(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	delivery, err := h.webhookService.HandleGetDeliveryByIDQuery(c.Context(), webhook.GetDeliveryByIDQuery{
+		ID:         c.Params("id"),
+		DeliveryID: c.Params("deliveryId"),
+		UserID:     userIDValue.String(),
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"delivery": presentation.ToWebhookDeliveryDetailResponse(delivery),
+	})
+}
+
+// Redeliver godoc
+// @Summary Redeliver a webhook delivery
+// @Description Queue the payload of a delivery again as a new delivery, whatever the outcome of the original. The new delivery is sent shortly and retried like any other
+// @Tags webhooks
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "Webhook ID"
+// @Param deliveryId path string true "Delivery ID"
+// @Success 202 {object} map[string]presentation.WebhookDeliveryResponse
+// @Failure 400 {object} map[string]string
+// @Failure 401 {object} map[string]string
+// @Failure 404 {object} map[string]string
+// @Router /webhooks/{id}/deliveries/{deliveryId}/redeliver [post]
+func (h *WebhookHandler) Redeliver(c *fiber.Ctx) error {
+	userIDValue, ok := c.Locals("userID").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "Unauthorized",
+		})
+	}
+
+	delivery, err := h.webhookService.HandleRedeliverCommand(c.Context(), webhook.RedeliverCommand{
+		ID:         c.Params("id"),
+		DeliveryID: c.Params("deliveryId"),
+		UserID:     userIDValue.String(),
+	})
+	if err != nil {
+		return webhookError(c, err)
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"delivery": presentation.ToWebhookDeliveryResponse(delivery),
+	})
+}
+
+func webhookError(c *fiber.Ctx, err error) error {
+	switch err.Error() {
+	case "webhook not found", "unauthorized: webhook does not belong to user":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Webhook not found",
+		})
+	case "delivery not found":
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "Delivery not found",
+		})
+	}
+	return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -20,16 +20,58 @@ import (
 
 	"siyahsensei/wallet-service/configs"
 	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/alert"
+	"siyahsensei/wallet-service/domain/archive"
 	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/bond"
+	"siyahsensei/wallet-service/domain/capitalgains"
 	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/fx"
+	"siyahsensei/wallet-service/domain/importer"
+	"siyahsensei/wallet-service/domain/income"
+	"siyahsensei/wallet-service/domain/loan"
+	"siyahsensei/wallet-service/domain/lot"
+	"siyahsensei/wallet-service/domain/portfolio"
+	"siyahsensei/wallet-service/domain/price"
+	"siyahsensei/wallet-service/domain/realestate"
+	"siyahsensei/wallet-service/domain/receivable"
+	"siyahsensei/wallet-service/domain/recurring"
+	"siyahsensei/wallet-service/domain/termdeposit"
+	"siyahsensei/wallet-service/domain/transaction"
 	"siyahsensei/wallet-service/domain/user"
+	"siyahsensei/wallet-service/domain/valuation"
+	"siyahsensei/wallet-service/domain/webhook"
 	"siyahsensei/wallet-service/infrastructure/configuration/auth"
 	"siyahsensei/wallet-service/infrastructure/configuration/database"
 	customLogger "siyahsensei/wallet-service/infrastructure/configuration/logger"
+	"siyahsensei/wallet-service/infrastructure/notify"
 	"siyahsensei/wallet-service/infrastructure/persistence/accountrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/alertrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/archiverepo"
 	"siyahsensei/wallet-service/infrastructure/persistence/assetrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/bondrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/capitalgainsrepo"
 	"siyahsensei/wallet-service/infrastructure/persistence/definitionrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/eventrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/fxrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/importerrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/incomerepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/loanrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/lotrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/portfoliorepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/pricerepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/realestaterepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/receivablerepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/recurringrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/termdepositrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
 	"siyahsensei/wallet-service/infrastructure/persistence/userrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/valuationrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/webhookrepo"
+	"siyahsensei/wallet-service/infrastructure/pricing"
+	"siyahsensei/wallet-service/infrastructure/storage"
+	"siyahsensei/wallet-service/infrastructure/worker"
 )
 
 // @title Wallet Service API
@@ -53,6 +95,11 @@ import (
 // @name Authorization
 // @description Type "Bearer" followed by a space and JWT token.
 
+// @securityDefinitions.apikey APIKeyAuth
+// @in header
+// @name X-API-Key
+// @description Key of a trusted service, for endpoints that write shared data such as prices.
+
 func main() {
 	config, err := configs.LoadConfig()
 	if err != nil {
@@ -88,16 +135,179 @@ func main() {
 	definitionRepo := definitionrepo.NewPostgresRepository(db)
 	definitionService := definition.NewHandler(definitionRepo)
 
+	priceRepo := pricerepo.NewPostgresRepository(db)
+	priceService := price.NewHandler(priceRepo, definitionRepo)
+
+	fxRepo := fxrepo.NewPostgresRepository(db)
+	fxService := fx.NewHandler(fxRepo, config.FXPivotCurrency)
+
+	valuationRepo := valuationrepo.NewPostgresRepository(db)
+	valuationService := valuation.NewHandler(valuationRepo, priceRepo, fxService, config.BaseCurrency)
+
 	accountRepo := accountrepo.NewPostgresRepository(db)
-	accountService := account.NewHandler(accountRepo)
+	accountService := account.NewHandler(accountRepo, valuationService)
 
 	assetRepo := assetrepo.NewPostgresRepository(db)
-	assetService := asset.NewHandler(assetRepo)
+	assetService := asset.NewHandler(assetRepo, accountRepo, fxService)
+
+	transactionRepo := transactionrepo.NewPostgresRepository(db)
+	transactionService := transaction.NewHandler(transactionRepo, assetRepo)
+
+	lotRepo := lotrepo.NewPostgresRepository(db)
+	lotService := lot.NewHandler(lotRepo, assetRepo)
+
+	portfolioRepo := portfoliorepo.NewPostgresRepository(db)
+	portfolioService := portfolio.NewHandler(portfolioRepo, valuationService, definitionRepo)
+
+	recurringRepo := recurringrepo.NewPostgresRepository(db)
+	recurringService := recurring.NewHandler(recurringRepo, accountRepo, definitionRepo)
+
+	termDepositRepo := termdepositrepo.NewPostgresRepository(db)
+	termDepositService := termdeposit.NewHandler(termDepositRepo, assetRepo, accountRepo, definitionRepo)
+
+	bondRepo := bondrepo.NewPostgresRepository(db)
+	bondService := bond.NewHandler(bondRepo, assetRepo, priceRepo)
+
+	incomeRepo := incomerepo.NewPostgresRepository(db)
+	incomeService := income.NewHandler(incomeRepo, assetRepo, lotRepo, fxService, config.BaseCurrency)
+
+	loanRepo := loanrepo.NewPostgresRepository(db)
+	loanService := loan.NewHandler(loanRepo, assetRepo, definitionRepo)
+
+	receivableRepo := receivablerepo.NewPostgresRepository(db)
+	receivableService := receivable.NewHandler(receivableRepo, assetRepo, definitionRepo, fxService, config.BaseCurrency)
+
+	propertyRepo := realestaterepo.NewPostgresRepository(db)
+	propertyService := realestate.NewHandler(propertyRepo, assetRepo, accountRepo, loanRepo, incomeRepo, fxService, config.BaseCurrency)
+
+	alertRepo := alertrepo.NewPostgresRepository(db)
+	alertService := alert.NewHandler(alertRepo, definitionRepo, accountRepo, userRepo, priceRepo, portfolioService,
+		notify.NewNotifiers(config), config.BaseCurrency)
+	priceService.OnRecorded(alertService.PricesRecorded)
+
+	capitalGainsRepo := capitalgainsrepo.NewPostgresRepository(db)
+	capitalGainsService := capitalgains.NewHandler(capitalGainsRepo, fxService, config.BaseCurrency, config.TaxJurisdiction)
+
+	importRepo := importerrepo.NewPostgresRepository(db)
+	importService := importer.NewHandler(importRepo, accountRepo, definitionRepo, config.BaseCurrency)
+
+	exportStorage, err := storage.NewFileStorage(config.ExportDirectory)
+	if err != nil {
+		customLogger.Fatal("Failed to prepare export directory", err)
+	}
+	archiveRepo := archiverepo.NewPostgresRepository(db)
+	archiveService := archive.NewHandler(archiveRepo, userRepo, exportStorage, config.ExportInlineLimit, config.ExportTTL, int64(config.RestoreLimit))
+
+	webhookRepo := webhookrepo.NewPostgresRepository(db)
+	eventRepo := eventrepo.NewPostgresRepository(db)
+	webhookService := webhook.NewHandler(webhookRepo, eventRepo, notify.NewHTTPSender(), config.WebhookMaxAttempts)
+	publishWebhooks := func(ctx context.Context, e *event.Event) {
+		if _, err := webhookService.HandlePublishEventCommand(ctx, webhook.PublishEventCommand{Event: e}); err != nil {
+			customLogger.Error("Failed to queue webhook deliveries", err, map[string]interface{}{
+				"event": e.Type,
+			})
+		}
+	}
+	accountService.OnEvent(publishWebhooks)
+	assetService.OnEvent(publishWebhooks)
+
+	priceProvider, err := pricing.NewProvider(config)
+	if err != nil {
+		customLogger.Fatal("Failed to configure price provider", err)
+	}
+
+	jobs := []worker.Job{
+		{
+			Name:     "portfolio-snapshots",
+			Interval: config.SnapshotInterval,
+			Run: func(ctx context.Context) error {
+				taken, err := portfolioService.HandleTakeSnapshotsCommand(ctx, portfolio.TakeSnapshotsCommand{})
+				customLogger.Debug("Portfolio snapshots taken", map[string]interface{}{
+					"count": taken,
+				})
+				return err
+			},
+		},
+		{
+			Name:     "recurring-transactions",
+			Interval: config.RecurringInterval,
+			Run: func(ctx context.Context) error {
+				posted, err := recurringService.HandleMaterialiseDueCommand(ctx, recurring.MaterialiseDueCommand{})
+				customLogger.Debug("Recurring occurrences materialised", map[string]interface{}{
+					"count": posted,
+				})
+				return err
+			},
+		},
+		{
+			Name:     "term-deposit-maturities",
+			Interval: config.TermDepositInterval,
+			Run: func(ctx context.Context) error {
+				settlements, err := termDepositService.HandleProcessMaturitiesCommand(ctx, termdeposit.ProcessMaturitiesCommand{})
+				customLogger.Debug("Term deposit maturities settled", map[string]interface{}{
+					"count": len(settlements),
+				})
+				return err
+			},
+		},
+		{
+			Name:     "alert-evaluation",
+			Interval: config.AlertInterval,
+			Trigger:  alertService.Wake(),
+			Run: func(ctx context.Context) error {
+				fired, err := alertService.HandleEvaluateAlertsCommand(ctx, alert.EvaluateAlertsCommand{})
+				customLogger.Debug("Alerts evaluated", map[string]interface{}{
+					"fired": fired,
+				})
+				return err
+			},
+		},
+		{
+			Name:     "webhook-deliveries",
+			Interval: config.WebhookInterval,
+			Trigger:  webhookService.Wake(),
+			Run: func(ctx context.Context) error {
+				sent, err := webhookService.HandleDispatchDeliveriesCommand(ctx, webhook.DispatchDeliveriesCommand{})
+				customLogger.Debug("Webhook deliveries sent", map[string]interface{}{
+					"count": sent,
+				})
+				return err
+			},
+		},
+		{
+			Name:     "data-exports",
+			Interval: config.ExportInterval,
+			Run: func(ctx context.Context) error {
+				generated, err := archiveService.HandleProcessExportsCommand(ctx, archive.ProcessExportsCommand{})
+				customLogger.Debug("Data exports generated", map[string]interface{}{
+					"count": generated,
+				})
+				return err
+			},
+		},
+	}
+	if priceProvider != nil {
+		priceRefresher := price.NewRefresher(priceRepo, definitionRepo, priceProvider)
+		priceRefresher.OnRecorded(alertService.PricesRecorded)
+		jobs = append(jobs, worker.Job{
+			Name:     "price-refresh",
+			Interval: config.PriceRefreshInterval,
+			Run: func(ctx context.Context) error {
+				prices, err := priceRefresher.Refresh(ctx)
+				customLogger.Debug("Prices refreshed", map[string]interface{}{
+					"provider": priceProvider.Name(),
+					"count":    len(prices),
+				})
+				return err
+			},
+		})
+	}
 
 	jwtMiddleware := auth.NewJWTMiddleware(config.JWTSecret)
 	app := fiber.New(fiber.Config{
 		AppName:               "Wallet API",
 		DisableStartupMessage: true,
+		BodyLimit:             config.UploadLimit,
 	})
 
 	app.Use(recover.New())
@@ -116,12 +326,46 @@ func main() {
 	definitionHandler := routes.NewDefinitionRoute(definitionService)
 	accountHandler := routes.NewAccountHandler(accountService)
 	assetHandler := routes.NewAssetHandler(assetService)
+	transactionHandler := routes.NewTransactionHandler(transactionService)
+	lotHandler := routes.NewLotHandler(lotService)
+	priceHandler := routes.NewPriceHandler(priceService)
+	fxHandler := routes.NewFXHandler(fxService)
+	portfolioHandler := routes.NewPortfolioHandler(portfolioService)
+	recurringHandler := routes.NewRecurringHandler(recurringService)
+	termDepositHandler := routes.NewTermDepositHandler(termDepositService)
+	bondHandler := routes.NewBondHandler(bondService)
+	incomeHandler := routes.NewIncomeHandler(incomeService)
+	loanHandler := routes.NewLoanHandler(loanService)
+	receivableHandler := routes.NewReceivableHandler(receivableService)
+	propertyHandler := routes.NewPropertyHandler(propertyService)
+	reportHandler := routes.NewReportHandler(capitalGainsService)
+	alertHandler := routes.NewAlertHandler(alertService)
+	importHandler := routes.NewImportHandler(importService)
+	exportHandler := routes.NewExportHandler(archiveService)
+	webhookHandler := routes.NewWebhookHandler(webhookService)
 
 	api := app.Group("/api")
 	authRoute.RegisterRoutes(api, jwtMiddleware.Middleware())
 	definitionHandler.RegisterRoutes(api)
 	accountHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
 	assetHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	transactionHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	lotHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	priceHandler.RegisterRoutes(api, jwtMiddleware.Middleware(), auth.APIKeyMiddleware(config.PriceIngestAPIKey))
+	fxHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	portfolioHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	recurringHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	termDepositHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	bondHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	incomeHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	loanHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	receivableHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	propertyHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	reportHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	alertHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	importHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	exportHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
+	webhookHandler.RegisterRoutes(api, jwtMiddleware.Middleware())
 	app.Get("/health", func(c *fiber.Ctx) error {
 		return c.Status(fiber.StatusOK).JSON(fiber.Map{
 			"status": "ok",
@@ -129,6 +373,10 @@ func main() {
 		})
 	})
 
+	workerCtx, stopWorkers := context.WithCancel(context.Background())
+	defer stopWorkers()
+	worker.Start(workerCtx, jobs...)
+
 	go func() {
 		if err := app.Listen(":" + config.ServerPort); err != nil {
 			customLogger.Fatal("Failed to start server", err)
@@ -141,6 +389,7 @@ func main() {
 	<-quit
 
 	customLogger.Info("Shutting down server...")
+	stopWorkers()
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
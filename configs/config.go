@@ -2,6 +2,7 @@ package configs
 
 import (
 	"os"
+	"path/filepath"
 	"strconv"
 	"time"
 
@@ -20,6 +21,40 @@ type Config struct {
 	JWTSecret    string        `mapstructure:"JWT_SECRET"`
 	TokenExpiry  time.Duration `mapstructure:"TOKEN_EXPIRY"`
 	AllowOrigins string        `mapstructure:"ALLOW_ORIGINS"`
+	UploadLimit  int           `mapstructure:"UPLOAD_LIMIT"`
+
+	PriceProvider        string        `mapstructure:"PRICE_PROVIDER"`
+	PriceProviderSource  string        `mapstructure:"PRICE_PROVIDER_SOURCE"`
+	PriceProviderAPIKey  string        `mapstructure:"PRICE_PROVIDER_API_KEY"`
+	PriceQuoteCurrency   string        `mapstructure:"PRICE_QUOTE_CURRENCY"`
+	PriceRefreshInterval time.Duration `mapstructure:"PRICE_REFRESH_INTERVAL"` // minutes
+	PriceIngestAPIKey    string        `mapstructure:"PRICE_INGEST_API_KEY"`
+
+	BaseCurrency    string `mapstructure:"BASE_CURRENCY"`
+	FXPivotCurrency string `mapstructure:"FX_PIVOT_CURRENCY"`
+	TaxJurisdiction string `mapstructure:"TAX_JURISDICTION"`
+
+	// Each interval is read in the unit that suits how often the job has work: hours for
+	// snapshots, minutes for postings, alerts and prices, seconds for queues
+	SnapshotInterval    time.Duration `mapstructure:"SNAPSHOT_INTERVAL"`     // hours
+	RecurringInterval   time.Duration `mapstructure:"RECURRING_INTERVAL"`    // minutes
+	TermDepositInterval time.Duration `mapstructure:"TERM_DEPOSIT_INTERVAL"` // minutes
+	AlertInterval       time.Duration `mapstructure:"ALERT_INTERVAL"`        // minutes
+
+	WebhookInterval    time.Duration `mapstructure:"WEBHOOK_INTERVAL"` // seconds
+	WebhookMaxAttempts int           `mapstructure:"WEBHOOK_MAX_ATTEMPTS"`
+
+	ExportDirectory   string        `mapstructure:"EXPORT_DIRECTORY"`
+	ExportInlineLimit int           `mapstructure:"EXPORT_INLINE_LIMIT"`
+	ExportTTL         time.Duration `mapstructure:"EXPORT_TTL"`      // hours
+	ExportInterval    time.Duration `mapstructure:"EXPORT_INTERVAL"` // seconds
+	RestoreLimit      int           `mapstructure:"RESTORE_LIMIT"`
+
+	SMTPHost     string `mapstructure:"SMTP_HOST"`
+	SMTPPort     int    `mapstructure:"SMTP_PORT"`
+	SMTPUsername string `mapstructure:"SMTP_USERNAME"`
+	SMTPPassword string `mapstructure:"SMTP_PASSWORD"`
+	SMTPFrom     string `mapstructure:"SMTP_FROM"`
 }
 
 func LoadConfig() (*Config, error) {
@@ -38,6 +73,38 @@ func LoadConfig() (*Config, error) {
 		JWTSecret:    getEnv("JWT_SECRET", "your-secret-key"),
 		TokenExpiry:  time.Duration(getEnvAsInt("TOKEN_EXPIRY", 24)) * time.Hour,
 		AllowOrigins: getEnv("ALLOW_ORIGINS", "*"),
+		UploadLimit:  getEnvAsInt("UPLOAD_LIMIT", 32) * 1024 * 1024,
+
+		PriceProvider:        getEnv("PRICE_PROVIDER", ""),
+		PriceProviderSource:  getEnv("PRICE_PROVIDER_SOURCE", ""),
+		PriceProviderAPIKey:  getEnv("PRICE_PROVIDER_API_KEY", ""),
+		PriceQuoteCurrency:   getEnv("PRICE_QUOTE_CURRENCY", "USD"),
+		PriceRefreshInterval: getEnvAsDuration("PRICE_REFRESH_INTERVAL", 15, time.Minute),
+		PriceIngestAPIKey:    getEnv("PRICE_INGEST_API_KEY", ""),
+
+		BaseCurrency:    getEnv("BASE_CURRENCY", "USD"),
+		FXPivotCurrency: getEnv("FX_PIVOT_CURRENCY", "USD"),
+		TaxJurisdiction: getEnv("TAX_JURISDICTION", "GENERIC"),
+
+		SnapshotInterval:    getEnvAsDuration("SNAPSHOT_INTERVAL", 24, time.Hour),
+		RecurringInterval:   getEnvAsDuration("RECURRING_INTERVAL", 60, time.Minute),
+		TermDepositInterval: getEnvAsDuration("TERM_DEPOSIT_INTERVAL", 60, time.Minute),
+		AlertInterval:       getEnvAsDuration("ALERT_INTERVAL", 15, time.Minute),
+
+		WebhookInterval:    getEnvAsDuration("WEBHOOK_INTERVAL", 10, time.Second),
+		WebhookMaxAttempts: getEnvAsInt("WEBHOOK_MAX_ATTEMPTS", 8),
+
+		ExportDirectory:   getEnv("EXPORT_DIRECTORY", filepath.Join(os.TempDir(), "wallet-exports")),
+		ExportInlineLimit: getEnvAsInt("EXPORT_INLINE_LIMIT", 5000),
+		ExportTTL:         getEnvAsDuration("EXPORT_TTL", 24, time.Hour),
+		ExportInterval:    getEnvAsDuration("EXPORT_INTERVAL", 30, time.Second),
+		RestoreLimit:      getEnvAsInt("RESTORE_LIMIT", 256) * 1024 * 1024,
+
+		SMTPHost:     getEnv("SMTP_HOST", ""),
+		SMTPPort:     getEnvAsInt("SMTP_PORT", 587),
+		SMTPUsername: getEnv("SMTP_USERNAME", ""),
+		SMTPPassword: getEnv("SMTP_PASSWORD", ""),
+		SMTPFrom:     getEnv("SMTP_FROM", "wallet@localhost"),
 	}
 	return config, nil
 }
@@ -50,6 +117,17 @@ func getEnv(key, defaultValue string) string {
 	return value
 }
 
+// getEnvAsDuration reads a whole number of units. A duration that is not positive cannot
+// schedule a job or expire an export, so such values fall back to the default.
+func getEnvAsDuration(key string, defaultValue int, unit time.Duration) time.Duration {
+	value := getEnvAsInt(key, defaultValue)
+	if value <= 0 {
+		log.Warn().Str("key", key).Int("value", value).Int("default", defaultValue).Msg("Duration must be positive, using the default")
+		value = defaultValue
+	}
+	return time.Duration(value) * unit
+}
+
 func getEnvAsInt(key string, defaultValue int) int {
 	valueStr := getEnv(key, "")
 	if value, err := strconv.Atoi(valueStr); err == nil {
@@ -53,7 +53,9 @@ const docTemplate = `{
                 "responses": {
                     "200": {
                         "description": "OK",
-                        "schema": {}
+                        "schema": {
+                            "type": "object"
+                        }
                     },
                     "401": {
                         "description": "Unauthorized",
@@ -189,7 +191,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get summary statistics for all user accounts",
+                "description": "Get summary statistics for all user accounts with every account and asset valued in a base currency",
                 "consumes": [
                     "application/json"
                 ],
@@ -200,6 +202,14 @@ const docTemplate = `{
                     "accounts"
                 ],
                 "summary": "Get account summary",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
                 "responses": {
                     "200": {
                         "description": "OK",
@@ -405,14 +415,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets": {
+        "/alerts": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get all assets for the authenticated user",
+                "description": "List the alerts of the authenticated user with the value each was last checked against",
                 "consumes": [
                     "application/json"
                 ],
@@ -420,14 +430,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Get all user assets",
+                "summary": "List alerts",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.AssetsListResponse"
+                            "$ref": "#/definitions/presentation.AlertsListResponse"
                         }
                     },
                     "401": {
@@ -447,7 +457,7 @@ const docTemplate = `{
                         "BearerAuth": []
                     }
                 ],
-                "description": "Create a new asset for the authenticated user",
+                "description": "Watch the price of a definition (PRICE_ABOVE, PRICE_BELOW, or PRICE_CHANGE for a move of at least the threshold percent in 24 hours) or the portfolio (NET_WORTH_BELOW, or ACCOUNT_SHARE_ABOVE for an account holding more than the threshold percent of the gross assets). Alerts are checked whenever new prices are recorded and fire once each time their condition becomes true, over the INBOX (default), WEBHOOK and EMAIL channels. Webhook URLs must resolve to public addresses and redirects are not followed. Webhook deliveries are signed like event webhooks, with X-Wallet-Timestamp and X-Wallet-Signature keyed with the webhookSecret returned here. Email alerts are only sent to the account email address",
                 "consumes": [
                     "application/json"
                 ],
@@ -455,17 +465,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Create a new asset",
+                "summary": "Create an alert",
                 "parameters": [
                     {
-                        "description": "Asset creation data",
-                        "name": "asset",
+                        "description": "Alert data",
+                        "name": "alert",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/presentation.CreateAssetRequest"
+                            "$ref": "#/definitions/presentation.CreateAlertRequest"
                         }
                     }
                 ],
@@ -475,7 +485,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.AssetResponse"
+                                "$ref": "#/definitions/presentation.AlertResponse"
                             }
                         }
                     },
@@ -496,18 +506,27 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/assets/filter": {
+        "/alerts/inbox": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Filter assets with optional parameters for the authenticated user",
+                "description": "List the triggered alerts delivered to the in-app inbox, newest first, with the number still unread",
                 "consumes": [
                     "application/json"
                 ],
@@ -515,44 +534,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Filter assets",
+                "summary": "Get the alert inbox",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "Account ID",
-                        "name": "accountId",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Asset Type",
-                        "name": "assetType",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Minimum Quantity",
-                        "name": "minQuantity",
-                        "in": "query"
-                    },
-                    {
-                        "type": "number",
-                        "description": "Maximum Quantity",
-                        "name": "maxQuantity",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Created From Date (RFC3339)",
-                        "name": "createdFrom",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Created To Date (RFC3339)",
-                        "name": "createdTo",
+                        "type": "boolean",
+                        "description": "Only unread notifications",
+                        "name": "unread",
                         "in": "query"
                     },
                     {
@@ -572,7 +561,16 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.AssetsListResponse"
+                            "$ref": "#/definitions/presentation.InboxResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -587,14 +585,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/assets/{id}": {
-            "get": {
+        "/alerts/inbox/read": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get a specific asset by ID for the authenticated user",
+                "description": "Mark every unread notification in the alert inbox as read",
                 "consumes": [
                     "application/json"
                 ],
@@ -602,13 +600,53 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Get asset by ID",
+                "summary": "Mark the whole inbox as read",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "integer"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/alerts/inbox/{id}/read": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Mark one notification of the alert inbox as read",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "alerts"
+                ],
+                "summary": "Mark a notification as read",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "Notification ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -620,7 +658,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.AssetResponse"
+                                "type": "integer"
                             }
                         }
                     },
@@ -652,14 +690,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/alerts/{id}": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update an existing asset for the authenticated user",
+                "description": "Get a specific alert by ID for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -667,25 +707,16 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Update an asset",
+                "summary": "Get alert by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "Alert ID",
                         "name": "id",
                         "in": "path",
                         "required": true
-                    },
-                    {
-                        "description": "Asset update data",
-                        "name": "asset",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presentation.UpdateAssetRequest"
-                        }
                     }
                 ],
                 "responses": {
@@ -694,7 +725,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.AssetResponse"
+                                "$ref": "#/definitions/presentation.AlertResponse"
                             }
                         }
                     },
@@ -727,13 +758,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete an existing asset for the authenticated user",
+                "description": "Change the threshold, currency, channels or enabled state of an alert. Changing the condition arms the alert again. The response carries the webhook signing secret, generated the first time the alert uses the WEBHOOK channel",
                 "consumes": [
                     "application/json"
                 ],
@@ -741,21 +772,36 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "assets"
+                    "alerts"
                 ],
-                "summary": "Delete an asset",
+                "summary": "Update an alert",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Asset ID",
+                        "description": "Alert ID",
                         "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "Alert data",
+                        "name": "alert",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateAlertRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.AlertResponse"
+                            }
+                        }
                     },
                     "400": {
                         "description": "Bad Request",
@@ -785,16 +831,14 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/auth/change-password": {
-            "put": {
+            },
+            "delete": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Change current authenticated user password",
+                "description": "Delete an alert together with its trigger history and inbox notifications",
                 "consumes": [
                     "application/json"
                 ],
@@ -802,23 +846,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "alerts"
                 ],
-                "summary": "Change user password",
+                "summary": "Delete an alert",
                 "parameters": [
                     {
-                        "description": "Password change data",
-                        "name": "passwords",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presentation.ChangePasswordRequest"
-                        }
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -826,8 +871,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -835,8 +880,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "401": {
-                        "description": "Unauthorized",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -847,9 +892,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/login": {
-            "post": {
-                "description": "Login user with email and password",
+        "/alerts/{id}/triggers": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List every time the alert fired, newest first, with the outcome of each channel delivery",
                 "consumes": [
                     "application/json"
                 ],
@@ -857,25 +907,44 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "alerts"
                 ],
-                "summary": "Login user",
+                "summary": "List the triggers of an alert",
                 "parameters": [
                     {
-                        "description": "User login credentials",
-                        "name": "credentials",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.LoginUserCommand"
-                        }
+                        "type": "string",
+                        "description": "Alert ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.TokenResponse"
+                            "$ref": "#/definitions/presentation.TriggersListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
                     "401": {
@@ -886,18 +955,27 @@ const docTemplate = `{
                                 "type": "string"
                             }
                         }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
                 }
             }
         },
-        "/auth/me": {
+        "/assets": {
             "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Get current authenticated user information",
+                "description": "Get all assets for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -905,17 +983,14 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "assets"
                 ],
-                "summary": "Get current user information",
+                "summary": "Get all user assets",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "$ref": "#/definitions/presentation.UserPublic"
-                            }
+                            "$ref": "#/definitions/presentation.AssetsListResponse"
                         }
                     },
                     "401": {
@@ -929,13 +1004,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "put": {
+            "post": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Update current authenticated user information",
+                "description": "Create a new asset for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -943,27 +1018,27 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "assets"
                 ],
-                "summary": "Update user information",
+                "summary": "Create a new asset",
                 "parameters": [
                     {
-                        "description": "User update data",
-                        "name": "user",
+                        "description": "Asset creation data",
+                        "name": "asset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/presentation.UpdateUserRequest"
+                            "$ref": "#/definitions/presentation.CreateAssetRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Created",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.UserPublic"
+                                "$ref": "#/definitions/presentation.AssetResponse"
                             }
                         }
                     },
@@ -986,14 +1061,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/assets/filter": {
+            "get": {
                 "security": [
                     {
                         "BearerAuth": []
                     }
                 ],
-                "description": "Delete current authenticated user account",
+                "description": "Filter assets with optional parameters for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1001,28 +1078,64 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "assets"
                 ],
-                "summary": "Delete user account",
+                "summary": "Filter assets",
                 "parameters": [
                     {
-                        "description": "Password confirmation",
-                        "name": "password",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/presentation.DeleteUserRequest"
-                        }
+                        "type": "string",
+                        "description": "Account ID",
+                        "name": "accountId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Asset Type",
+                        "name": "assetType",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Minimum Quantity",
+                        "name": "minQuantity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "description": "Maximum Quantity",
+                        "name": "maxQuantity",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created From Date (RFC3339)",
+                        "name": "createdFrom",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Created To Date (RFC3339)",
+                        "name": "createdTo",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/presentation.AssetsListResponse"
                         }
                     },
                     "401": {
@@ -1037,52 +1150,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/register": {
-            "post": {
-                "description": "Register a new user with email and password",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "auth"
-                ],
-                "summary": "Register a new user",
-                "parameters": [
+        "/assets/performance": {
+            "get": {
+                "security": [
                     {
-                        "description": "User registration data",
-                        "name": "user",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/user.RegisterUserCommand"
-                        }
+                        "BearerAuth": []
                     }
                 ],
-                "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/presentation.TokenResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/definitions": {
-            "get": {
-                "description": "Get all asset definitions with optional pagination",
+                "description": "Get initial value, current value, realized and unrealized profit/loss per asset of the authenticated user over a date range. Holdings, open cost and prices are taken as of the end date; costs booked in other currencies are converted with the exchange rates known then",
                 "consumes": [
                     "application/json"
                 ],
@@ -1090,26 +1165,20 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "definitions"
+                    "assets"
                 ],
-                "summary": "Get all definitions",
+                "summary": "Get asset performance",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "Limit number of results",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Offset for pagination",
-                        "name": "offset",
+                        "type": "string",
+                        "description": "Start Date (RFC3339), defaults to the beginning of time",
+                        "name": "startDate",
                         "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Definition type",
-                        "name": "type",
+                        "description": "End Date (RFC3339), defaults to now",
+                        "name": "endDate",
                         "in": "query"
                     }
                 ],
@@ -1117,11 +1186,20 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/presentation.DefinitionsListResponse"
+                            "$ref": "#/definitions/presentation.AssetPerformanceListResponse"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1130,9 +1208,16 @@ const docTemplate = `{
                         }
                     }
                 }
-            },
+            }
+        },
+        "/assets/transfer": {
             "post": {
-                "description": "Create a new asset definition",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Atomically move a quantity of an asset into the same definition in another account of the authenticated user, with an optional fee charged on the source",
                 "consumes": [
                     "application/json"
                 ],
@@ -1140,17 +1225,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "definitions"
+                    "assets"
                 ],
-                "summary": "Create a new definition",
+                "summary": "Transfer an asset between accounts",
                 "parameters": [
                     {
-                        "description": "Definition creation data",
-                        "name": "definition",
+                        "description": "Transfer data",
+                        "name": "transfer",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/presentation.CreateDefinitionRequest"
+                            "$ref": "#/definitions/presentation.TransferAssetRequest"
                         }
                     }
                 ],
@@ -1160,7 +1245,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                                "$ref": "#/definitions/presentation.TransferResponse"
                             }
                         }
                     },
@@ -1173,61 +1258,26 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
                                 "type": "string"
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/definitions/search": {
-            "get": {
-                "description": "Search asset definitions by name or abbreviation",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "definitions"
-                ],
-                "summary": "Search definitions",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Search term",
-                        "name": "q",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "integer",
-                        "description": "Limit number of results",
-                        "name": "limit",
-                        "in": "query"
                     },
-                    {
-                        "type": "integer",
-                        "description": "Offset for pagination",
-                        "name": "offset",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
+                    "403": {
+                        "description": "Forbidden",
                         "schema": {
-                            "$ref": "#/definitions/presentation.DefinitionsListResponse"
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
                         }
                     },
-                    "400": {
-                        "description": "Bad Request",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1238,9 +1288,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/definitions/{id}": {
+        "/assets/{id}": {
             "get": {
-                "description": "Get a specific asset definition by ID",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific asset by ID for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1248,13 +1303,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "definitions"
+                    "assets"
                 ],
-                "summary": "Get definition by ID",
+                "summary": "Get asset by ID",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Definition ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1266,7 +1321,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                                "$ref": "#/definitions/presentation.AssetResponse"
                             }
                         }
                     },
@@ -1279,8 +1334,17 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1291,7 +1355,12 @@ const docTemplate = `{
                 }
             },
             "put": {
-                "description": "Update an existing asset definition",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an existing asset for the authenticated user. Quantity changes go through /transactions",
                 "consumes": [
                     "application/json"
                 ],
@@ -1299,24 +1368,24 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "definitions"
+                    "assets"
                 ],
-                "summary": "Update a definition",
+                "summary": "Update an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Definition ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "Definition update data",
-                        "name": "definition",
+                        "description": "Asset update data",
+                        "name": "asset",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/presentation.UpdateDefinitionRequest"
+                            "$ref": "#/definitions/presentation.UpdateAssetRequest"
                         }
                     }
                 ],
@@ -1326,7 +1395,7 @@ const docTemplate = `{
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
-                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                                "$ref": "#/definitions/presentation.AssetResponse"
                             }
                         }
                     },
@@ -1339,8 +1408,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "Unauthorized",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1348,8 +1417,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "409": {
-                        "description": "Conflict",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
                             "type": "object",
                             "additionalProperties": {
@@ -1360,7 +1429,12 @@ const docTemplate = `{
                 }
             },
             "delete": {
-                "description": "Delete an existing asset definition",
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an existing asset for the authenticated user",
                 "consumes": [
                     "application/json"
                 ],
@@ -1368,13 +1442,13 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "definitions"
+                    "assets"
                 ],
-                "summary": "Delete a definition",
+                "summary": "Delete an asset",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Definition ID",
+                        "description": "Asset ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1393,6 +1467,15 @@ const docTemplate = `{
                             }
                         }
                     },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
                     "404": {
                         "description": "Not Found",
                         "schema": {
@@ -1404,299 +1487,10818 @@ const docTemplate = `{
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "account.AccountType": {
-            "type": "string",
-            "enum": [
-                "BANK_ACCOUNT",
-                "SAVINGS_ACCOUNT",
-                "CHECKING_ACCOUNT",
-                "CREDIT_CARD",
-                "INVESTMENT_ACCOUNT",
-                "CRYPTO_WALLET",
-                "CRYPTO_EXCHANGE",
-                "BROKER",
-                "PENSION",
-                "INSURANCE",
-                "HOME",
-                "SAFE",
-                "OTHER"
-            ],
-            "x-enum-varnames": [
-                "BankAccount",
-                "SavingsAccount",
-                "CheckingAccount",
-                "CreditCard",
-                "InvestmentAccount",
-                "CryptoWallet",
-                "CryptoExchange",
-                "Broker",
-                "Pension",
-                "Insurance",
-                "Home",
-                "Safe",
-                "Other"
-            ]
-        },
-        "asset.AssetType": {
-            "type": "string",
-            "enum": [
-                "CASH",
-                "TERM_DEPOSIT",
-                "STOCK",
-                "ETF",
-                "FUND",
-                "BOND",
-                "CRYPTOCURRENCY",
-                "NFT",
-                "DEFI_TOKEN",
-                "PRECIOUS_METAL",
-                "REAL_ESTATE",
-                "DEBT",
-                "RECEIVABLE",
-                "SALARY",
-                "OTHER"
-            ],
-            "x-enum-varnames": [
-                "Cash",
-                "TermDeposit",
-                "Stock",
-                "ETF",
-                "Fund",
-                "Bond",
-                "Cryptocurrency",
-                "NFT",
-                "DeFiToken",
-                "PreciousMetal",
-                "RealEstate",
-                "Debt",
-                "Receivable",
-                "Salary",
-                "Other"
-            ]
-        },
-        "presentation.AccountResponse": {
-            "type": "object",
-            "properties": {
-                "accountType": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "updatedAt": {
-                    "type": "string"
-                },
-                "userId": {
-                    "type": "string"
-                }
-            }
         },
-        "presentation.AccountSummaryResponse": {
-            "type": "object",
-            "properties": {
-                "byCurrency": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "number"
+        "/auth/change-password": {
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
                     }
-                },
-                "byType": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "integer"
+                ],
+                "description": "Change current authenticated user password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Change user password",
+                "parameters": [
+                    {
+                        "description": "Password change data",
+                        "name": "passwords",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ChangePasswordRequest"
+                        }
                     }
-                },
-                "totalAccounts": {
-                    "type": "integer"
-                }
-            }
-        },
-        "presentation.AccountsListResponse": {
-            "type": "object",
-            "properties": {
-                "accounts": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/presentation.AccountResponse"
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
                     }
-                },
-                "total": {
-                    "type": "integer"
                 }
             }
         },
-        "presentation.AssetResponse": {
-            "type": "object",
-            "properties": {
-                "accountId": {
-                    "type": "string"
-                },
-                "createdAt": {
-                    "type": "string"
-                },
-                "definitionId": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "notes": {
-                    "type": "string"
-                },
+        "/auth/login": {
+            "post": {
+                "description": "Login user with email and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Login user",
+                "parameters": [
+                    {
+                        "description": "User login credentials",
+                        "name": "credentials",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.LoginUserCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TokenResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/me": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get current authenticated user information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Get current user information",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.UserPublic"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update current authenticated user information",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Update user information",
+                "parameters": [
+                    {
+                        "description": "User update data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.UserPublic"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete current authenticated user account and all of its data. GET /me/export downloads the data beforehand",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Delete user account",
+                "parameters": [
+                    {
+                        "description": "Password confirmation",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.DeleteUserRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/register": {
+            "post": {
+                "description": "Register a new user with email and password",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Register a new user",
+                "parameters": [
+                    {
+                        "description": "User registration data",
+                        "name": "user",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/user.RegisterUserCommand"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TokenResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bonds": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the bonds of the authenticated user, soonest maturity first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "List bonds",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.BondsListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach face value, coupon, issue and maturity dates and day count convention to a BOND asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Set the terms of a bond",
+                "parameters": [
+                    {
+                        "description": "Bond data",
+                        "name": "bond",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateBondRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.BondResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bonds/coupons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the coupon and redemption payments of all bonds of the authenticated user, sized to the quantity held",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Get the coupon calendar",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CouponsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bonds/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific bond by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Get bond by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bond ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.BondResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the terms of a bond",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Update a bond",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bond ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Bond data",
+                        "name": "bond",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateBondRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.BondResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the terms of a bond. The asset and its ledger are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Delete a bond",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bond ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bonds/{id}/coupons": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the coupon and redemption payments of one bond, sized to the quantity held",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Get the coupon calendar of a bond",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bond ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CouponsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/bonds/{id}/pricing": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get accrued interest, dirty price, current yield and yield to maturity of a bond at a clean price quoted as a percentage of face value. Without a price the latest stored price of one bond in its currency is used",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "bonds"
+                ],
+                "summary": "Price a bond",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Bond ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "description": "Clean Price (percentage of face value)",
+                        "name": "price",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Settlement Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PricingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/definitions": {
+            "get": {
+                "description": "Get all asset definitions with optional pagination",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Get all definitions",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Definition type",
+                        "name": "type",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.DefinitionsListResponse"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Create a new asset definition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Create a new definition",
+                "parameters": [
+                    {
+                        "description": "Definition creation data",
+                        "name": "definition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/definitions/search": {
+            "get": {
+                "description": "Search asset definitions by name or abbreviation",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Search definitions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Search term",
+                        "name": "q",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.DefinitionsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/definitions/{id}": {
+            "get": {
+                "description": "Get a specific asset definition by ID",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Get definition by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "description": "Update an existing asset definition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Update a definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Definition update data",
+                        "name": "definition",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateDefinitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.DefinitionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "description": "Delete an existing asset definition",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "definitions"
+                ],
+                "summary": "Delete a definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/fx/convert": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Convert an amount with the rates known at a date, triangulating through the pivot currency when no direct rate exists",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx"
+                ],
+                "summary": "Convert an amount between currencies",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From Currency",
+                        "name": "from",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Currency",
+                        "name": "to",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "number",
+                        "default": 1,
+                        "description": "Amount",
+                        "name": "amount",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ConversionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/fx/rates/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Store a batch of exchange rates; a rate for the same pair, time and source is overwritten",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "fx"
+                ],
+                "summary": "Record exchange rates in bulk",
+                "parameters": [
+                    {
+                        "description": "Rates to record",
+                        "name": "rates",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RecordRatesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RatesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/import/csv": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload a CSV file with a column mapping. Symbols are resolved against definition abbreviations and accounts by ID or name. With dryRun (the default) nothing is saved and every row is reported with its errors; otherwise all rows are saved in one transaction, or none when any row fails",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import"
+                ],
+                "summary": "Import holdings and transactions from CSV",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "CSV file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Column mapping as JSON, e.g. {\\",
+                        "name": "mapping",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only preview the import (default true)",
+                        "name": "dryRun",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "The first line is a header (default true)",
+                        "name": "header",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Field delimiter (default comma, \\",
+                        "name": "delimiter",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Go time layout of the date column, e.g. 02/01/2006",
+                        "name": "dateFormat",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Numbers use a decimal comma",
+                        "name": "decimalComma",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account for rows without one",
+                        "name": "defaultAccountId",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction type for rows without one (default BUY)",
+                        "name": "defaultType",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Type of the assets created (default OTHER)",
+                        "name": "defaultAssetType",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency for rows without one (default base currency)",
+                        "name": "currency",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/import/statement": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Upload an OFX, QFX or QIF statement. Each statement account goes to the given account, else to the account it was imported into before, else to an account of the same name, else to a new account. Lines are posted on the cash holding of the statement currency; lines whose FITID was imported into the account before are skipped, and an opening balance is derived from the closing balance for a holding without history. With dryRun (the default) nothing is saved",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "import"
+                ],
+                "summary": "Import a bank statement",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "OFX, QFX or QIF file",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "OFX or QIF, detected from the file when empty",
+                        "name": "format",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only preview the import (default true)",
+                        "name": "dryRun",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Account to import a single statement into",
+                        "name": "accountId",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Currency of statements that do not state one (default base currency)",
+                        "name": "currency",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Go time layout of QIF dates, month first when empty",
+                        "name": "dateFormat",
+                        "in": "formData"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "QIF amounts use a decimal comma",
+                        "name": "decimalComma",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    },
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ImportResultResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/income": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Aggregate dividend, interest, coupon, staking and rental income of a year by month, type and asset in a base currency, with yield on cost per asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "Get the income report of a year",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Year (defaults to the current year)",
+                        "name": "year",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ReportResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a dividend, interest, coupon, staking reward or rent payment received on an asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "Record income",
+                "parameters": [
+                    {
+                        "description": "Income data",
+                        "name": "income",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateIncomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.IncomeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/income/records": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the income records of the authenticated user with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "List income records",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "DIVIDEND",
+                            "INTEREST",
+                            "COUPON",
+                            "STAKING_REWARD",
+                            "RENT"
+                        ],
+                        "type": "string",
+                        "description": "Income Type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.IncomeListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/income/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific income record by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "Get income by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Income ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.IncomeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update an income record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "Update income",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Income ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Income data",
+                        "name": "income",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateIncomeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.IncomeResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an income record",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "income"
+                ],
+                "summary": "Delete income",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Income ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loans": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the loans of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "List loans",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.LoansListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach principal, rate, term, payment frequency and amortization method to a DEBT asset. The principal defaults to the asset quantity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Set the terms of a loan",
+                "parameters": [
+                    {
+                        "description": "Loan data",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateLoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.LoanResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific loan by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Get loan by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.LoanResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the principal, rate, term, frequency or method of a loan",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Update a loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Loan data",
+                        "name": "loan",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateLoanRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.LoanResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the terms and payment history of a loan. The asset and its ledger are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Delete a loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/{id}/payments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the payments recorded on a loan, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "List loan payments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PaymentsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a payment on a loan. The principal part reduces the debt; with fromAssetId the whole amount is also withdrawn from that asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Record a loan payment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Payment data",
+                        "name": "payment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RecordPaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PaymentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/{id}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get every installment of a loan with its due date and the split between principal and interest",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Get the amortization schedule of a loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ScheduleResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/loans/{id}/status": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the payments made with the schedule as of a date: remaining principal, interest paid, arrears and the next installment",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "loans"
+                ],
+                "summary": "Get the status of a loan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Loan ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.StatusResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/lots": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the tax lots of an asset with its current cost basis",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lots"
+                ],
+                "summary": "Get the lots of an asset",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only return lots that still hold a quantity",
+                        "name": "open",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.AssetLotsResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/lots/disposals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the lot disposals of the authenticated user, optionally for one asset and date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "lots"
+                ],
+                "summary": "Get lot disposals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.DisposalsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/export": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download a ZIP with the profile, accounts, assets, the definitions they refer to and all history, as export.json and a CSV file per table. Webhook signing secrets are left out. Large exports, or any with async=true, are generated in the background instead: the response is 202 with the export, whose downloadUrl is set once it is ready",
+                "produces": [
+                    "application/zip",
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Export all of the user's data",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Always generate the export in the background",
+                        "name": "async",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ZIP archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ExportResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/exports": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the exports generated in the background for the authenticated user, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "List data exports",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ExportsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/exports/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the status of an export; downloadUrl is set once it is ready",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Get a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ExportResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/exports/{id}/download": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Download the ZIP of an export that is ready",
+                "produces": [
+                    "application/zip"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Download a data export",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Export ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ZIP archive",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "410": {
+                        "description": "Gone",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/me/import": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Restore the export.json of an export, or the whole export ZIP, into the authenticated user's account in one transaction. Every row gets a new ID, definitions are matched by abbreviation and created for the user when missing. MERGE adds the archive next to the existing data, skipping rows that clash such as a snapshot of the same day along with the rows that belong to them; REPLACE deletes the user's data first. Restored webhooks and alerts get new signing secrets, returned once in the response",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "me"
+                ],
+                "summary": "Restore an exported archive",
+                "parameters": [
+                    {
+                        "type": "file",
+                        "description": "export.json or export ZIP",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "MERGE (default) or REPLACE",
+                        "name": "mode",
+                        "in": "formData"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RestoreResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/allocation": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the value and share of total of every group of the authenticated user's holdings at current prices. Liabilities are excluded.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get portfolio allocation",
+                "parameters": [
+                    {
+                        "enum": [
+                            "assetType",
+                            "accountType",
+                            "account",
+                            "definition"
+                        ],
+                        "type": "string",
+                        "default": "assetType",
+                        "description": "Group By",
+                        "name": "groupBy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.AllocationResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/history": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the daily portfolio snapshots of the authenticated user, sampled to the last snapshot of every interval",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get portfolio value history",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339, defaults to one year before to)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339, defaults to now)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "day",
+                            "week",
+                            "month"
+                        ],
+                        "type": "string",
+                        "default": "day",
+                        "description": "Interval",
+                        "name": "interval",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.HistoryResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/net-worth": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get gross assets, gross liabilities and net worth of the authenticated user in a base currency, broken down by account. Debts and credit card balances count as liabilities.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get net worth",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.NetWorthResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/rebalance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Compare the current allocation with the targets and suggest buys and sells, in base currency and quantity, that bring groups drifting beyond the tolerance back to target",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get rebalancing suggestions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 5,
+                        "description": "Tolerance band in percentage points",
+                        "name": "tolerance",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only suggest buys",
+                        "name": "noSell",
+                        "in": "query"
+                    },
+                    {
+                        "type": "number",
+                        "default": 0,
+                        "description": "New cash to invest",
+                        "name": "cash",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RebalancePlanResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/snapshots": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Value the authenticated user's portfolio now and store it as today's snapshot",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Snapshot the portfolio now",
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.SnapshotResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/portfolio/targets": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the target weights of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Get target allocation",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TargetsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Replace the target weights of the authenticated user. Targets are grouped by asset type or by definition and their weights must add up to 100; an empty list clears them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "portfolio"
+                ],
+                "summary": "Set target allocation",
+                "parameters": [
+                    {
+                        "description": "Target weights",
+                        "name": "targets",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.SetTargetsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TargetsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/bulk": {
+            "post": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Store a batch of definition prices; a price for the same definition, currency, time and source is overwritten. Prices are shared by all users, so only trusted services holding the ingestion API key may record them",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prices"
+                ],
+                "summary": "Record prices in bulk",
+                "parameters": [
+                    {
+                        "description": "Prices to record",
+                        "name": "prices",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RecordPricesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PriceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/{definitionId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get recorded prices of a definition in a quote currency, newest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prices"
+                ],
+                "summary": "Get the price history of a definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "definitionId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quote Currency",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 0,
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PriceListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/{definitionId}/at": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the last recorded price of a definition at or before the given date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prices"
+                ],
+                "summary": "Get the price of a definition at a date",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "definitionId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quote Currency",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Date (RFC3339)",
+                        "name": "date",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PriceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/prices/{definitionId}/latest": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the most recent recorded price of a definition in a quote currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "prices"
+                ],
+                "summary": "Get the latest price of a definition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Definition ID",
+                        "name": "definitionId",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Quote Currency",
+                        "name": "currency",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PriceResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the properties of the authenticated user, oldest purchase first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "List properties",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PropertiesListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach purchase price, purchase costs and an optional mortgage loan to a REAL_ESTATE asset or an asset in a HOME account",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Set the purchase details of a property",
+                "parameters": [
+                    {
+                        "description": "Property data",
+                        "name": "property",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreatePropertyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PropertyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific property by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Get property by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PropertyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the currency, purchase details or mortgage loan of a property",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Update a property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Property data",
+                        "name": "property",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdatePropertyRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.PropertyResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the purchase details, appraisals and expenses of a property. The asset and its ledger are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Delete a property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}/appraisals": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the appraisals of a property, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "List appraisals",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.AppraisalsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record the estimated market value of a property on a date. The latest appraisal also values the property in the portfolio",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Record an appraisal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Appraisal data",
+                        "name": "appraisal",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.AddAppraisalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.AppraisalResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}/appraisals/{appraisalId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an appraisal of a property",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Delete an appraisal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Appraisal ID",
+                        "name": "appraisalId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}/expenses": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the expenses of a property, oldest first, optionally within a date range",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "List property expenses",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ExpensesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a running cost of a property such as maintenance, property tax or insurance, in the property currency",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Record a property expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Expense data",
+                        "name": "expense",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.AddExpenseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ExpenseResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}/expenses/{expenseId}": {
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete an expense of a property",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Delete a property expense",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Expense ID",
+                        "name": "expenseId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/properties/{id}/performance": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the value, appreciation, equity after the mortgage and gross and net rental yield of a property as of a date. Rent is the RENT income recorded on the asset over the trailing year",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "properties"
+                ],
+                "summary": "Get the performance of a property",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Property ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PerformanceResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the receivables of the authenticated user, soonest due first, optionally only those with a status as of a date",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "List receivables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Status (OPEN, PARTIALLY_PAID, OVERDUE, PAID, WRITTEN_OFF)",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ReceivablesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach a counterparty, amount and due date to a RECEIVABLE asset. The amount defaults to the asset quantity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Set the terms of a receivable",
+                "parameters": [
+                    {
+                        "description": "Receivable data",
+                        "name": "receivable",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateReceivableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ReceivableResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables/aging": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Group what is still owed by days past due (0-30, 31-60, 61-90 and 90+) in the base currency, with amounts not yet due kept apart",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Get the receivables aging report",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Base Currency (defaults to the configured base currency)",
+                        "name": "base",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.AgingResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables/overdue": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the receivables past their due date with an amount still outstanding, longest overdue first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "List overdue receivables",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.ReceivablesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific receivable by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Get receivable by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ReceivableResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the counterparty, amount or dates of a receivable",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Update a receivable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Receivable data",
+                        "name": "receivable",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateReceivableRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ReceivableResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the terms and repayment history of a receivable. The asset and its ledger are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Delete a receivable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables/{id}/repayments": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the repayments recorded on a receivable, oldest first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "List repayments",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RepaymentsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record money received back on a receivable. The amount reduces the receivable; with toAssetId it is also deposited into that asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Record a repayment",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Repayment data",
+                        "name": "repayment",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RecordRepaymentRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RepaymentResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/receivables/{id}/write-off": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Give up on what is still outstanding. The remaining amount is withdrawn from the receivable asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "receivables"
+                ],
+                "summary": "Write off a receivable",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Receivable ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Write-off data",
+                        "name": "writeOff",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.WriteOffRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.ReceivableResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the recurring rules of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "List recurring rules",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.RulesListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Create a rule that posts a transaction into an account on a weekly, monthly or yearly schedule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Create a recurring rule",
+                "parameters": [
+                    {
+                        "description": "Rule data",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RuleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring/upcoming": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the upcoming occurrences of all active recurring rules of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Preview upcoming occurrences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Until Date (RFC3339, defaults to three months from now)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of occurrences",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific recurring rule by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Get recurring rule by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RuleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the amount, schedule or state of a recurring rule. A paused rule that is resumed does not post the occurrences it missed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Update a recurring rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Rule data",
+                        "name": "rule",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateRuleRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.RuleResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a recurring rule. Transactions it already posted are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Delete a recurring rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring/{id}/occurrences": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the occurrences a recurring rule has posted or skipped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "List materialised occurrences",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.OccurrencesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/recurring/{id}/preview": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the upcoming occurrences of one recurring rule",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "recurring"
+                ],
+                "summary": "Preview upcoming occurrences of a rule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Rule ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Until Date (RFC3339, defaults to three months from now)",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 100,
+                        "description": "Maximum number of occurrences",
+                        "name": "count",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.PreviewResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/reports/capital-gains": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the gain of every lot disposed by a sale in the fiscal year with its acquisition and disposal dates, proceeds, cost and short or long term holding period, under the rules of a jurisdiction (GENERIC, US, UK or DE). Amounts are converted into the report currency at the rates of the dates the jurisdiction prescribes. format=csv, or an Accept header of text/csv, downloads the lines as CSV",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json",
+                    "text/csv"
+                ],
+                "tags": [
+                    "reports"
+                ],
+                "summary": "Get the capital gains report of a fiscal year",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "Fiscal Year",
+                        "name": "year",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Jurisdiction (defaults to the configured jurisdiction)",
+                        "name": "jurisdiction",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Report Currency (defaults to the jurisdiction's currency, then the configured base currency)",
+                        "name": "currency",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "json (default) or csv",
+                        "name": "format",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CapitalGainsResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/term-deposits": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the term deposits of the authenticated user, soonest maturity first",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "List term deposits",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TermDepositsListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Attach principal, rate, compounding, term, withholding tax and maturity action to a TERM_DEPOSIT asset. The principal defaults to the asset quantity",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "Set the terms of a term deposit",
+                "parameters": [
+                    {
+                        "description": "Term deposit data",
+                        "name": "termDeposit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateTermDepositRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.TermDepositResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/term-deposits/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific term deposit by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "Get term deposit by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Term Deposit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.TermDepositResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Update the rate, maturity, withholding tax or maturity action of an active term deposit",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "Update a term deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Term Deposit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Term deposit data",
+                        "name": "termDeposit",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateTermDepositRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.TermDepositResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Remove the terms of a term deposit. The asset and its ledger are kept",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "Delete a term deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Term Deposit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/term-deposits/{id}/accrual": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get the interest accrued up to a date and the expected payout at maturity, both before and after withholding tax",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "term-deposits"
+                ],
+                "summary": "Get accrued interest of a term deposit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Term Deposit ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "As Of Date (RFC3339, defaults to now)",
+                        "name": "date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.AccrualResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List transactions of the authenticated user with optional filters",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "List transactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Asset ID",
+                        "name": "assetId",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Transaction Type",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "From Date (RFC3339)",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "To Date (RFC3339)",
+                        "name": "to",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.TransactionsListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Record a buy, sell, deposit, withdraw, fee or adjustment against an asset. The asset quantity is re-derived from its transaction history",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Record a transaction",
+                "parameters": [
+                    {
+                        "description": "Transaction data",
+                        "name": "transaction",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateTransactionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.TransactionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/transactions/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a specific transaction by ID for the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Get transaction by ID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.TransactionResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a transaction and re-derive the quantity of its asset",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "transactions"
+                ],
+                "summary": "Delete a transaction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Transaction ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the webhooks of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhooks",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.WebhooksListResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Post the given event types of the authenticated user, or every event with \"*\", to a URL, which must resolve to public addresses only. Each request carries the X-Wallet-Event, X-Wallet-Delivery and X-Wallet-Timestamp headers and X-Wallet-Signature: sha256= followed by the hex HMAC-SHA256 of the timestamp, a dot and the body, keyed with the secret. A secret is generated when none is given and is only returned here. Deliveries answered with anything but a 2xx status are retried with exponential backoff, and redirects are not followed",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Create a webhook",
+                "parameters": [
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.WebhookResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/event-types": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the event types a webhook can subscribe to. Transaction events cover every ledger entry, including those made by transfers, imports, recurring rules, term deposit settlements and loan and receivable payments; restoring an archive emits no events",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List webhook event types",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.EventTypesResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a webhook of the authenticated user",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.WebhookResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Change the URL, event types or description of a webhook, pause it with enabled false, or rotate the signing secret by setting a new one",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Update a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Webhook data",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/presentation.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.WebhookResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Delete a webhook with its delivery log; pending deliveries are dropped",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Delete a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "List the deliveries of a webhook, newest first, with the response of the last attempt and when the next one is due",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "List the deliveries of a webhook",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "PENDING, SUCCEEDED or FAILED",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit number of results",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset for pagination",
+                        "name": "offset",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/presentation.WebhookDeliveriesListResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}": {
+            "get": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Get a delivery of a webhook with the payload that was posted",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Get a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/webhooks/{id}/deliveries/{deliveryId}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "BearerAuth": []
+                    }
+                ],
+                "description": "Queue the payload of a delivery again as a new delivery, whatever the outcome of the original. The new delivery is sent shortly and retried like any other",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhooks"
+                ],
+                "summary": "Redeliver a webhook delivery",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Webhook ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Delivery ID",
+                        "name": "deliveryId",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Accepted",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "$ref": "#/definitions/presentation.WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "account.AccountType": {
+            "type": "string",
+            "enum": [
+                "BANK_ACCOUNT",
+                "SAVINGS_ACCOUNT",
+                "CHECKING_ACCOUNT",
+                "CREDIT_CARD",
+                "INVESTMENT_ACCOUNT",
+                "CRYPTO_WALLET",
+                "CRYPTO_EXCHANGE",
+                "BROKER",
+                "PENSION",
+                "INSURANCE",
+                "HOME",
+                "SAFE",
+                "OTHER"
+            ],
+            "x-enum-varnames": [
+                "BankAccount",
+                "SavingsAccount",
+                "CheckingAccount",
+                "CreditCard",
+                "InvestmentAccount",
+                "CryptoWallet",
+                "CryptoExchange",
+                "Broker",
+                "Pension",
+                "Insurance",
+                "Home",
+                "Safe",
+                "Other"
+            ]
+        },
+        "asset.AssetType": {
+            "type": "string",
+            "enum": [
+                "CASH",
+                "TERM_DEPOSIT",
+                "STOCK",
+                "ETF",
+                "FUND",
+                "BOND",
+                "CRYPTOCURRENCY",
+                "NFT",
+                "DEFI_TOKEN",
+                "PRECIOUS_METAL",
+                "REAL_ESTATE",
+                "DEBT",
+                "RECEIVABLE",
+                "SALARY",
+                "OTHER"
+            ],
+            "x-enum-varnames": [
+                "Cash",
+                "TermDeposit",
+                "Stock",
+                "ETF",
+                "Fund",
+                "Bond",
+                "Cryptocurrency",
+                "NFT",
+                "DeFiToken",
+                "PreciousMetal",
+                "RealEstate",
+                "Debt",
+                "Receivable",
+                "Salary",
+                "Other"
+            ]
+        },
+        "asset.CostBasisMethod": {
+            "type": "string",
+            "enum": [
+                "FIFO",
+                "LIFO",
+                "HIGHEST_COST",
+                "AVERAGE"
+            ],
+            "x-enum-varnames": [
+                "FIFO",
+                "LIFO",
+                "HighestCost",
+                "AverageCost"
+            ]
+        },
+        "bond.DayCount": {
+            "type": "string",
+            "enum": [
+                "30_360",
+                "ACT_360",
+                "ACT_365",
+                "ACT_ACT"
+            ],
+            "x-enum-varnames": [
+                "Thirty360",
+                "Actual360",
+                "Actual365",
+                "ActualActual"
+            ]
+        },
+        "income.IncomeType": {
+            "type": "string",
+            "enum": [
+                "DIVIDEND",
+                "INTEREST",
+                "COUPON",
+                "STAKING_REWARD",
+                "RENT"
+            ],
+            "x-enum-varnames": [
+                "Dividend",
+                "Interest",
+                "Coupon",
+                "StakingReward",
+                "Rent"
+            ]
+        },
+        "loan.Method": {
+            "type": "string",
+            "enum": [
+                "ANNUITY",
+                "EQUAL_PRINCIPAL"
+            ],
+            "x-enum-varnames": [
+                "Annuity",
+                "EqualPrincipal"
+            ]
+        },
+        "presentation.AccountNetWorthResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accountType": {
+                    "type": "string"
+                },
+                "assets": {
+                    "type": "number"
+                },
+                "liabilities": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "netWorth": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AccountResponse": {
+            "type": "object",
+            "properties": {
+                "accountType": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.AccountSummaryResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AccountValueResponse"
+                    }
+                },
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "byCurrency": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "byType": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "grossAssets": {
+                    "type": "number"
+                },
+                "grossLiabilities": {
+                    "type": "number"
+                },
+                "totalAccounts": {
+                    "type": "integer"
+                },
+                "totalValue": {
+                    "type": "number"
+                },
+                "unpriced": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AccountTotalResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accountType": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AccountValueResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "accountType": {
+                    "type": "string"
+                },
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AssetValueResponse"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AccountsListResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AccountResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AccrualResponse": {
+            "type": "object",
+            "properties": {
+                "accruedInterest": {
+                    "type": "number"
+                },
+                "accruedNetInterest": {
+                    "type": "number"
+                },
+                "accruedWithholdingTax": {
+                    "type": "number"
+                },
+                "asOf": {
+                    "type": "string"
+                },
+                "currentValue": {
+                    "type": "number"
+                },
+                "daysToMaturity": {
+                    "type": "integer"
+                },
+                "effectiveAnnualRate": {
+                    "type": "number"
+                },
+                "elapsedDays": {
+                    "type": "integer"
+                },
+                "expectedInterest": {
+                    "type": "number"
+                },
+                "expectedNetInterest": {
+                    "type": "number"
+                },
+                "expectedPayout": {
+                    "type": "number"
+                },
+                "expectedWithholdingTax": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "termDays": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AddAppraisalRequest": {
+            "type": "object",
+            "required": [
+                "appraisedAt",
+                "value"
+            ],
+            "properties": {
+                "appraisedAt": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AddExpenseRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "category",
+                "paidAt"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "$ref": "#/definitions/realestate.ExpenseCategory"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AgingBucketResponse": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "maxDays": {
+                    "type": "integer"
+                },
+                "minDays": {
+                    "type": "integer"
+                },
+                "outstanding": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AgingResponse": {
+            "type": "object",
+            "properties": {
+                "asOf": {
+                    "type": "string"
+                },
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "buckets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AgingBucketResponse"
+                    }
+                },
+                "current": {
+                    "$ref": "#/definitions/presentation.AgingBucketResponse"
+                },
+                "totalOutstanding": {
+                    "type": "number"
+                },
+                "totalOverdue": {
+                    "type": "number"
+                },
+                "unconverted": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AlertDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attemptedAt": {
+                    "type": "string"
+                },
+                "channel": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.AlertResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lastEvaluatedAt": {
+                    "type": "string"
+                },
+                "lastTriggeredAt": {
+                    "type": "string"
+                },
+                "lastValue": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "triggered": {
+                    "type": "boolean"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "webhookSecret": {
+                    "description": "WebhookSecret is only returned when the alert is created or updated",
+                    "type": "string"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.AlertsListResponse": {
+            "type": "object",
+            "properties": {
+                "alerts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AlertResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AllocationGroupResponse": {
+            "type": "object",
+            "properties": {
+                "holdings": {
+                    "type": "integer"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "percentage": {
+                    "type": "number"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AllocationResponse": {
+            "type": "object",
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "groupBy": {
+                    "type": "string"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AllocationGroupResponse"
+                    }
+                },
+                "total": {
+                    "type": "number"
+                },
+                "unpriced": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AppraisalResponse": {
+            "type": "object",
+            "properties": {
+                "appraisedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "propertyId": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AppraisalsListResponse": {
+            "type": "object",
+            "properties": {
+                "appraisals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AppraisalResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AssetLotsResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "costBasis": {
+                    "$ref": "#/definitions/presentation.CostBasisResponse"
+                },
+                "costBasisMethod": {
+                    "type": "string"
+                },
+                "lots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.LotResponse"
+                    }
+                }
+            }
+        },
+        "presentation.AssetPerformanceListResponse": {
+            "type": "object",
+            "properties": {
+                "performance": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AssetPerformanceResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.AssetPerformanceResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "costBasis": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "currentPrice": {
+                    "type": "number"
+                },
+                "currentValue": {
+                    "type": "number"
+                },
+                "initialValue": {
+                    "type": "number"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "profitLoss": {
+                    "type": "number"
+                },
+                "profitLossPercentage": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "realizedProfitLoss": {
+                    "type": "number"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "unconverted": {
+                    "description": "Unconverted lists the currencies of costs left out for lack of an exchange rate",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "unrealizedProfitLoss": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AssetResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "costBasisMethod": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "purchaseCurrency": {
+                    "type": "string"
+                },
+                "purchaseDate": {
+                    "type": "string"
+                },
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.AssetTotalResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "assetType": {
+                    "type": "string"
+                },
+                "costBasis": {
+                    "type": "number"
+                },
+                "gross": {
+                    "type": "number"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                },
+                "yieldOnCost": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AssetValueResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "liability": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "priceCurrency": {
+                    "type": "string"
+                },
+                "priceSource": {
+                    "type": "string"
+                },
+                "priced": {
+                    "type": "boolean"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.AssetsListResponse": {
+            "type": "object",
+            "properties": {
+                "assets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AssetResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.BondResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "couponFrequency": {
+                    "type": "integer"
+                },
+                "couponRate": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayCount": {
+                    "type": "string"
+                },
+                "faceValue": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "maturityDate": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.BondsListResponse": {
+            "type": "object",
+            "properties": {
+                "bonds": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.BondResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.CapitalGainsResponse": {
+            "type": "object",
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "exemptGain": {
+                    "type": "number"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "jurisdiction": {
+                    "type": "string"
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.GainLineResponse"
+                    }
+                },
+                "longTerm": {
+                    "$ref": "#/definitions/presentation.TermTotalsResponse"
+                },
+                "netGain": {
+                    "type": "number"
+                },
+                "shortTerm": {
+                    "$ref": "#/definitions/presentation.TermTotalsResponse"
+                },
+                "taxableGain": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unconverted": {
+                    "type": "integer"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "confirmPassword",
+                "newPassword",
+                "oldPassword"
+            ],
+            "properties": {
+                "confirmPassword": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "newPassword": {
+                    "type": "string",
+                    "minLength": 8
+                },
+                "oldPassword": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.ConversionResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "at": {
+                    "type": "string"
+                },
+                "converted": {
+                    "type": "number"
+                },
+                "from": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "to": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.CostBasisResponse": {
+            "type": "object",
+            "properties": {
+                "averageCost": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "totalCost": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.CouponResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "bondId": {
+                    "type": "string"
+                },
+                "couponAmount": {
+                    "type": "number"
+                },
+                "couponPerBond": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "periodStart": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "redemptionAmount": {
+                    "type": "number"
+                },
+                "redemptionPerBond": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.CouponsListResponse": {
+            "type": "object",
+            "properties": {
+                "coupons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.CouponResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.CreateAccountRequest": {
+            "type": "object",
+            "required": [
+                "accountType",
+                "name"
+            ],
+            "properties": {
+                "accountType": {
+                    "$ref": "#/definitions/account.AccountType"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.CreateAlertRequest": {
+            "type": "object",
+            "required": [
+                "kind",
+                "threshold"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.CreateAssetRequest": {
+            "type": "object",
+            "required": [
+                "accountId",
+                "definitionId",
+                "purchaseDate",
+                "quantity",
+                "type"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "costBasisMethod": {
+                    "$ref": "#/definitions/asset.CostBasisMethod"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "purchaseCurrency": {
+                    "type": "string"
+                },
+                "purchaseDate": {
+                    "type": "integer"
+                },
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "type": {
+                    "$ref": "#/definitions/asset.AssetType"
+                }
+            }
+        },
+        "presentation.CreateBondRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "currency",
+                "faceValue",
+                "issueDate",
+                "maturityDate"
+            ],
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "couponFrequency": {
+                    "type": "integer"
+                },
+                "couponRate": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayCount": {
+                    "$ref": "#/definitions/bond.DayCount"
+                },
+                "faceValue": {
+                    "type": "number"
+                },
+                "issueDate": {
+                    "type": "integer"
+                },
+                "maturityDate": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.CreateDefinitionRequest": {
+            "type": "object",
+            "required": [
+                "abbreviation",
+                "name"
+            ],
+            "properties": {
+                "abbreviation": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "suffix": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.CreateIncomeRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "currency",
+                "grossAmount",
+                "payDate",
+                "type"
+            ],
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "grossAmount": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payDate": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/income.IncomeType"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.CreateLoanRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "startDate",
+                "termPeriods"
+            ],
+            "properties": {
+                "annualRate": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "firstPaymentDate": {
+                    "type": "integer"
+                },
+                "method": {
+                    "$ref": "#/definitions/loan.Method"
+                },
+                "paymentFrequency": {
+                    "type": "integer"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "integer"
+                },
+                "termPeriods": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.CreatePropertyRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "purchaseDate",
+                "purchasePrice"
+            ],
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "mortgageLoanId": {
+                    "type": "string"
+                },
+                "purchaseCosts": {
+                    "type": "number"
+                },
+                "purchaseDate": {
+                    "type": "integer"
+                },
+                "purchasePrice": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.CreateReceivableRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "counterpartyName",
+                "dueDate"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "counterpartyContact": {
+                    "type": "string"
+                },
+                "counterpartyName": {
+                    "type": "string"
+                },
+                "dueDate": {
+                    "type": "integer"
+                },
+                "issueDate": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.CreateRuleRequest": {
+            "type": "object",
+            "required": [
+                "accountId",
+                "amount",
+                "definitionId",
+                "frequency",
+                "name",
+                "startDate",
+                "transactionType"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "assetType": {
+                    "$ref": "#/definitions/asset.AssetType"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer"
+                },
+                "dayOfWeek": {
+                    "type": "integer"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "integer"
+                },
+                "frequency": {
+                    "$ref": "#/definitions/recurring.Frequency"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "monthOfYear": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "integer"
+                },
+                "transactionType": {
+                    "$ref": "#/definitions/transaction.TransactionType"
+                }
+            }
+        },
+        "presentation.CreateTermDepositRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "maturityDate",
+                "startDate"
+            ],
+            "properties": {
+                "annualRate": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "compounding": {
+                    "$ref": "#/definitions/termdeposit.Compounding"
+                },
+                "maturityAction": {
+                    "$ref": "#/definitions/termdeposit.MaturityAction"
+                },
+                "maturityDate": {
+                    "type": "integer"
+                },
+                "payoutAccountId": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "integer"
+                },
+                "withholdingTaxRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.CreateTransactionRequest": {
+            "type": "object",
+            "required": [
+                "assetId",
+                "quantity",
+                "transactionDate",
+                "type"
+            ],
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "transactionDate": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/transaction.TransactionType"
+                }
+            }
+        },
+        "presentation.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "eventTypes",
+                "url"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.DefinitionResponse": {
+            "type": "object",
+            "properties": {
+                "abbreviation": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "suffix": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.DefinitionsListResponse": {
+            "type": "object",
+            "properties": {
+                "definitions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.DefinitionResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.DeleteUserRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "password": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.DisposalResponse": {
+            "type": "object",
+            "properties": {
+                "acquiredAt": {
+                    "type": "string"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "disposedAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "lotId": {
+                    "type": "string"
+                },
+                "proceeds": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "transactionId": {
+                    "type": "string"
+                },
+                "unitCost": {
+                    "type": "number"
+                },
+                "unitProceeds": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.DisposalsListResponse": {
+            "type": "object",
+            "properties": {
+                "disposals": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.DisposalResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.EventTypesResponse": {
+            "type": "object",
+            "properties": {
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "presentation.ExpenseResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "category": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "propertyId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.ExpensesListResponse": {
+            "type": "object",
+            "properties": {
+                "expenses": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.ExpenseResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ExportResponse": {
+            "type": "object",
+            "properties": {
+                "completedAt": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "downloadUrl": {
+                    "type": "string"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "expiresAt": {
+                    "type": "string"
+                },
+                "fileName": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "records": {
+                    "type": "integer"
+                },
+                "size": {
+                    "type": "integer"
+                },
+                "startedAt": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.ExportsListResponse": {
+            "type": "object",
+            "properties": {
+                "exports": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.ExportResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.GainLineResponse": {
+            "type": "object",
+            "properties": {
+                "accountName": {
+                    "type": "string"
+                },
+                "acquiredAt": {
+                    "type": "string"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "assetName": {
+                    "type": "string"
+                },
+                "assetType": {
+                    "type": "string"
+                },
+                "converted": {
+                    "type": "boolean"
+                },
+                "cost": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "disposalId": {
+                    "type": "string"
+                },
+                "disposedAt": {
+                    "type": "string"
+                },
+                "exempt": {
+                    "type": "boolean"
+                },
+                "exemptReason": {
+                    "type": "string"
+                },
+                "gain": {
+                    "type": "number"
+                },
+                "holdingDays": {
+                    "type": "integer"
+                },
+                "lotId": {
+                    "type": "string"
+                },
+                "proceeds": {
+                    "type": "number"
+                },
+                "proceedsCurrency": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "reportCost": {
+                    "type": "number"
+                },
+                "reportGain": {
+                    "type": "number"
+                },
+                "reportProceeds": {
+                    "type": "number"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "term": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.HistoryResponse": {
+            "type": "object",
+            "properties": {
+                "interval": {
+                    "type": "string"
+                },
+                "snapshots": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.SnapshotResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ImportResultResponse": {
+            "type": "object",
+            "properties": {
+                "committed": {
+                    "type": "boolean"
+                },
+                "dryRun": {
+                    "type": "boolean"
+                },
+                "invalid": {
+                    "type": "integer"
+                },
+                "rows": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.ImportRowResponse"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "statements": {
+                    "description": "Statements lists the accounts of a bank statement import",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.ImportStatementResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "valid": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ImportRowResponse": {
+            "type": "object",
+            "properties": {
+                "account": {
+                    "type": "string"
+                },
+                "accountId": {
+                    "type": "string"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "assetType": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "fitid": {
+                    "type": "string"
+                },
+                "line": {
+                    "type": "integer"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "opening": {
+                    "type": "boolean"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "skipReason": {
+                    "type": "string"
+                },
+                "skipped": {
+                    "type": "boolean"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.ImportStatementResponse": {
+            "type": "object",
+            "properties": {
+                "accountCreated": {
+                    "type": "boolean"
+                },
+                "accountId": {
+                    "type": "string"
+                },
+                "accountKey": {
+                    "type": "string"
+                },
+                "accountName": {
+                    "type": "string"
+                },
+                "accountType": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "entries": {
+                    "type": "integer"
+                },
+                "format": {
+                    "type": "string"
+                },
+                "ledgerBalance": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.InboxResponse": {
+            "type": "object",
+            "properties": {
+                "notifications": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TriggerResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "unread": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.IncomeListResponse": {
+            "type": "object",
+            "properties": {
+                "income": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.IncomeResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.IncomeResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "assetType": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "grossAmount": {
+                    "type": "number"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "netAmount": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payDate": {
+                    "type": "string"
+                },
+                "symbol": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.InstallmentResponse": {
+            "type": "object",
+            "properties": {
+                "dueDate": {
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "number": {
+                    "type": "integer"
+                },
+                "payment": {
+                    "type": "number"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "remainingPrincipal": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.LoanResponse": {
+            "type": "object",
+            "properties": {
+                "annualRate": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "firstPaymentDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maturityDate": {
+                    "type": "string"
+                },
+                "method": {
+                    "type": "string"
+                },
+                "paymentFrequency": {
+                    "type": "integer"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "termPeriods": {
+                    "type": "integer"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.LoansListResponse": {
+            "type": "object",
+            "properties": {
+                "loans": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.LoanResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.LotResponse": {
+            "type": "object",
+            "properties": {
+                "acquiredAt": {
+                    "type": "string"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "remainingQuantity": {
+                    "type": "number"
+                },
+                "transactionId": {
+                    "type": "string"
+                },
+                "unitPrice": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.MonthTotalResponse": {
+            "type": "object",
+            "properties": {
+                "gross": {
+                    "type": "number"
+                },
+                "month": {
+                    "type": "integer"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.NetWorthResponse": {
+            "type": "object",
+            "properties": {
+                "accounts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AccountNetWorthResponse"
+                    }
+                },
+                "at": {
+                    "type": "string"
+                },
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "grossAssets": {
+                    "type": "number"
+                },
+                "grossLiabilities": {
+                    "type": "number"
+                },
+                "netWorth": {
+                    "type": "number"
+                },
+                "unpriced": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.OccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "occurrenceDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.OccurrencesListResponse": {
+            "type": "object",
+            "properties": {
+                "occurrences": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.OccurrenceResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.PaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "fromAssetId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "loanId": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.PaymentsListResponse": {
+            "type": "object",
+            "properties": {
+                "payments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.PaymentResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.PerformanceResponse": {
+            "type": "object",
+            "properties": {
+                "annualExpenses": {
+                    "type": "number"
+                },
+                "annualRent": {
+                    "type": "number"
+                },
+                "annualizedAppreciation": {
+                    "type": "number"
+                },
+                "appreciation": {
+                    "type": "number"
+                },
+                "appreciationPercent": {
+                    "type": "number"
+                },
+                "asOf": {
+                    "type": "string"
+                },
+                "costBasis": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "currentValue": {
+                    "type": "number"
+                },
+                "equity": {
+                    "type": "number"
+                },
+                "grossYield": {
+                    "type": "number"
+                },
+                "loanToValue": {
+                    "type": "number"
+                },
+                "mortgageBalance": {
+                    "type": "number"
+                },
+                "netOperatingIncome": {
+                    "type": "number"
+                },
+                "netYield": {
+                    "type": "number"
+                },
+                "netYieldOnCost": {
+                    "type": "number"
+                },
+                "purchaseCosts": {
+                    "type": "number"
+                },
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "unconvertedRent": {
+                    "type": "integer"
+                },
+                "valueSource": {
+                    "type": "string"
+                },
+                "valuedAt": {
+                    "type": "string"
+                },
+                "yearsHeld": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.PreviewResponse": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "upcoming": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.UpcomingOccurrenceResponse"
+                    }
+                }
+            }
+        },
+        "presentation.PriceItemRequest": {
+            "type": "object",
+            "required": [
+                "definitionId",
+                "price",
+                "pricedAt",
+                "quoteCurrency"
+            ],
+            "properties": {
+                "definitionId": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "pricedAt": {
+                    "type": "integer"
+                },
+                "quoteCurrency": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.PriceListResponse": {
+            "type": "object",
+            "properties": {
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.PriceResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.PriceResponse": {
+            "type": "object",
+            "properties": {
+                "definitionId": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "pricedAt": {
+                    "type": "string"
+                },
+                "quoteCurrency": {
+                    "type": "string"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.PricingResponse": {
+            "type": "object",
+            "properties": {
+                "accruedInterest": {
+                    "type": "number"
+                },
+                "cleanPrice": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "currentYield": {
+                    "type": "number"
+                },
+                "dirtyPrice": {
+                    "type": "number"
+                },
+                "marketValue": {
+                    "type": "number"
+                },
+                "priceSource": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "settlementDate": {
+                    "type": "string"
+                },
+                "yieldToMaturity": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.PropertiesListResponse": {
+            "type": "object",
+            "properties": {
+                "properties": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.PropertyResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.PropertyResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "costBasis": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "mortgageLoanId": {
+                    "type": "string"
+                },
+                "purchaseCosts": {
+                    "type": "number"
+                },
                 "purchaseDate": {
                     "type": "string"
                 },
+                "purchasePrice": {
+                    "type": "number"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RateItemRequest": {
+            "type": "object",
+            "required": [
+                "baseCurrency",
+                "observedAt",
+                "quoteCurrency",
+                "rate"
+            ],
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "observedAt": {
+                    "type": "integer"
+                },
+                "quoteCurrency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RateResponse": {
+            "type": "object",
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "observedAt": {
+                    "type": "string"
+                },
+                "quoteCurrency": {
+                    "type": "string"
+                },
+                "rate": {
+                    "type": "number"
+                },
+                "source": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RatesListResponse": {
+            "type": "object",
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RateResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.RebalancePlanResponse": {
+            "type": "object",
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "cash": {
+                    "type": "number"
+                },
+                "groupBy": {
+                    "type": "string"
+                },
+                "needsRebalance": {
+                    "type": "boolean"
+                },
+                "netCashFlow": {
+                    "type": "number"
+                },
+                "noSell": {
+                    "type": "boolean"
+                },
+                "suggestions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RebalanceSuggestionResponse"
+                    }
+                },
+                "tolerance": {
+                    "type": "number"
+                },
+                "total": {
+                    "type": "number"
+                },
+                "totalBuys": {
+                    "type": "number"
+                },
+                "totalSells": {
+                    "type": "number"
+                },
+                "unpriced": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.RebalanceSuggestionResponse": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currentValue": {
+                    "type": "number"
+                },
+                "currentWeight": {
+                    "type": "number"
+                },
+                "drift": {
+                    "type": "number"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "label": {
+                    "type": "string"
+                },
+                "legs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TradeLegResponse"
+                    }
+                },
+                "outOfBand": {
+                    "type": "boolean"
+                },
+                "targetWeight": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.ReceivableResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "counterpartyContact": {
+                    "type": "string"
+                },
+                "counterpartyName": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "daysOverdue": {
+                    "type": "integer"
+                },
+                "dueDate": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "issueDate": {
+                    "type": "string"
+                },
+                "outstanding": {
+                    "type": "number"
+                },
+                "repaid": {
+                    "type": "number"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "writtenOff": {
+                    "type": "number"
+                },
+                "writtenOffAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.ReceivablesListResponse": {
+            "type": "object",
+            "properties": {
+                "receivables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.ReceivableResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.RecordPaymentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "paidAt"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "fromAssetId": {
+                    "type": "string"
+                },
+                "interest": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "paidAt": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.RecordPricesRequest": {
+            "type": "object",
+            "required": [
+                "prices"
+            ],
+            "properties": {
+                "prices": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.PriceItemRequest"
+                    }
+                }
+            }
+        },
+        "presentation.RecordRatesRequest": {
+            "type": "object",
+            "required": [
+                "rates"
+            ],
+            "properties": {
+                "rates": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RateItemRequest"
+                    }
+                }
+            }
+        },
+        "presentation.RecordRepaymentRequest": {
+            "type": "object",
+            "required": [
+                "amount",
+                "repaidAt"
+            ],
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "repaidAt": {
+                    "type": "integer"
+                },
+                "toAssetId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RepaymentResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "receivableId": {
+                    "type": "string"
+                },
+                "repaidAt": {
+                    "type": "string"
+                },
+                "toAssetId": {
+                    "type": "string"
+                },
+                "transactionId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RepaymentsListResponse": {
+            "type": "object",
+            "properties": {
+                "repayments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RepaymentResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ReportResponse": {
+            "type": "object",
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "byAsset": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AssetTotalResponse"
+                    }
+                },
+                "byMonth": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.MonthTotalResponse"
+                    }
+                },
+                "byType": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TypeTotalResponse"
+                    }
+                },
+                "gross": {
+                    "type": "number"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "ratesAt": {
+                    "type": "string"
+                },
+                "unconverted": {
+                    "type": "integer"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                },
+                "year": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.RestoreResponse": {
+            "type": "object",
+            "properties": {
+                "definitionsCreated": {
+                    "type": "integer"
+                },
+                "definitionsMatched": {
+                    "type": "integer"
+                },
+                "mode": {
+                    "type": "string"
+                },
+                "restored": {
+                    "type": "integer"
+                },
+                "secrets": {
+                    "description": "Secrets are the new signing secrets of the restored webhooks and alerts, which\nare not shown again",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RestoredSecretResponse"
+                    }
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "tables": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TableCountResponse"
+                    }
+                }
+            }
+        },
+        "presentation.RestoredSecretResponse": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "type": "string"
+                },
+                "secret": {
+                    "type": "string"
+                },
+                "table": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RuleResponse": {
+            "type": "object",
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "assetType": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer"
+                },
+                "dayOfWeek": {
+                    "type": "integer"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "endDate": {
+                    "type": "string"
+                },
+                "frequency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "lastRunAt": {
+                    "type": "string"
+                },
+                "monthOfYear": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "nextRunAt": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "transactionType": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.RulesListResponse": {
+            "type": "object",
+            "properties": {
+                "rules": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.RuleResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.ScheduleResponse": {
+            "type": "object",
+            "properties": {
+                "installments": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.InstallmentResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                },
+                "totalInterest": {
+                    "type": "number"
+                },
+                "totalPayment": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.SetTargetsRequest": {
+            "type": "object",
+            "required": [
+                "groupBy"
+            ],
+            "properties": {
+                "groupBy": {
+                    "type": "string"
+                },
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TargetItemRequest"
+                    }
+                }
+            }
+        },
+        "presentation.SnapshotResponse": {
+            "type": "object",
+            "properties": {
+                "baseCurrency": {
+                    "type": "string"
+                },
+                "byAccount": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.AccountTotalResponse"
+                    }
+                },
+                "byAssetType": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "number"
+                    }
+                },
+                "date": {
+                    "type": "string"
+                },
+                "totalValue": {
+                    "type": "number"
+                },
+                "unpriced": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.StatusResponse": {
+            "type": "object",
+            "properties": {
+                "arrears": {
+                    "type": "number"
+                },
+                "asOf": {
+                    "type": "string"
+                },
+                "installmentsDue": {
+                    "type": "integer"
+                },
+                "interestPaid": {
+                    "type": "number"
+                },
+                "maturityDate": {
+                    "type": "string"
+                },
+                "nextInstallment": {
+                    "$ref": "#/definitions/presentation.InstallmentResponse"
+                },
+                "originalPrincipal": {
+                    "type": "number"
+                },
+                "paymentsMade": {
+                    "type": "integer"
+                },
+                "principalPaid": {
+                    "type": "number"
+                },
+                "remainingPrincipal": {
+                    "type": "number"
+                },
+                "scheduledInterestRemaining": {
+                    "type": "number"
+                },
+                "scheduledInterestToDate": {
+                    "type": "number"
+                },
+                "scheduledPrincipalToDate": {
+                    "type": "number"
+                },
+                "scheduledRemainingPrincipal": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TableCountResponse": {
+            "type": "object",
+            "properties": {
+                "restored": {
+                    "type": "integer"
+                },
+                "skipped": {
+                    "type": "integer"
+                },
+                "table": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.TargetItemRequest": {
+            "type": "object",
+            "required": [
+                "key",
+                "weight"
+            ],
+            "properties": {
+                "key": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TargetResponse": {
+            "type": "object",
+            "properties": {
+                "groupBy": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "weight": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TargetsListResponse": {
+            "type": "object",
+            "properties": {
+                "targets": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TargetResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.TermDepositResponse": {
+            "type": "object",
+            "properties": {
+                "annualRate": {
+                    "type": "number"
+                },
+                "assetId": {
+                    "type": "string"
+                },
+                "compounding": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "maturedAt": {
+                    "type": "string"
+                },
+                "maturityAction": {
+                    "type": "string"
+                },
+                "maturityDate": {
+                    "type": "string"
+                },
+                "payoutAccountId": {
+                    "type": "string"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "rollovers": {
+                    "type": "integer"
+                },
+                "startDate": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "withholdingTaxRate": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TermDepositsListResponse": {
+            "type": "object",
+            "properties": {
+                "termDeposits": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TermDepositResponse"
+                    }
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.TermTotalsResponse": {
+            "type": "object",
+            "properties": {
+                "cost": {
+                    "type": "number"
+                },
+                "count": {
+                    "type": "integer"
+                },
+                "gains": {
+                    "type": "number"
+                },
+                "losses": {
+                    "type": "number"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "proceeds": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TokenResponse": {
+            "type": "object",
+            "properties": {
+                "token": {
+                    "type": "string"
+                },
+                "user": {
+                    "$ref": "#/definitions/presentation.UserPublic"
+                }
+            }
+        },
+        "presentation.TradeLegResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "symbol": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.TransactionResponse": {
+            "type": "object",
+            "properties": {
+                "assetId": {
+                    "type": "string"
+                },
+                "createdAt": {
+                    "type": "string"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "transactionDate": {
+                    "type": "string"
+                },
+                "transferId": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "updatedAt": {
+                    "type": "string"
+                },
+                "userId": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.TransactionsListResponse": {
+            "type": "object",
+            "properties": {
+                "total": {
+                    "type": "integer"
+                },
+                "transactions": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TransactionResponse"
+                    }
+                }
+            }
+        },
+        "presentation.TransferAssetRequest": {
+            "type": "object",
+            "required": [
+                "quantity",
+                "sourceAssetId",
+                "targetAccountId",
+                "transferDate"
+            ],
+            "properties": {
+                "fee": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
                 "quantity": {
                     "type": "number"
                 },
-                "type": {
+                "sourceAssetId": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "targetAccountId": {
                     "type": "string"
                 },
-                "userId": {
+                "transferDate": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.TransferResponse": {
+            "type": "object",
+            "properties": {
+                "fee": {
+                    "type": "number"
+                },
+                "quantity": {
+                    "type": "number"
+                },
+                "sourceAsset": {
+                    "$ref": "#/definitions/presentation.AssetResponse"
+                },
+                "targetAsset": {
+                    "$ref": "#/definitions/presentation.AssetResponse"
+                },
+                "transferDate": {
+                    "type": "string"
+                },
+                "transferId": {
                     "type": "string"
                 }
             }
         },
-        "presentation.AssetsListResponse": {
+        "presentation.TriggerResponse": {
             "type": "object",
             "properties": {
-                "assets": {
+                "alertId": {
+                    "type": "string"
+                },
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presentation.AssetResponse"
+                        "$ref": "#/definitions/presentation.AlertDeliveryResponse"
                     }
                 },
+                "id": {
+                    "type": "string"
+                },
+                "inbox": {
+                    "type": "boolean"
+                },
+                "kind": {
+                    "type": "string"
+                },
+                "message": {
+                    "type": "string"
+                },
+                "readAt": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "triggeredAt": {
+                    "type": "string"
+                },
+                "value": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.TriggersListResponse": {
+            "type": "object",
+            "properties": {
                 "total": {
                     "type": "integer"
+                },
+                "triggers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.TriggerResponse"
+                    }
+                }
+            }
+        },
+        "presentation.TypeTotalResponse": {
+            "type": "object",
+            "properties": {
+                "gross": {
+                    "type": "number"
+                },
+                "net": {
+                    "type": "number"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.UpcomingOccurrenceResponse": {
+            "type": "object",
+            "properties": {
+                "amount": {
+                    "type": "number"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "ruleId": {
+                    "type": "string"
+                },
+                "transactionType": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.UpdateAccountRequest": {
+            "type": "object",
+            "required": [
+                "accountType",
+                "name"
+            ],
+            "properties": {
+                "accountType": {
+                    "$ref": "#/definitions/account.AccountType"
+                },
+                "name": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.UpdateAlertRequest": {
+            "type": "object",
+            "required": [
+                "threshold"
+            ],
+            "properties": {
+                "channels": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "threshold": {
+                    "type": "number"
+                },
+                "webhookUrl": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.UpdateAssetRequest": {
+            "type": "object",
+            "required": [
+                "accountId",
+                "definitionId",
+                "purchaseDate",
+                "type"
+            ],
+            "properties": {
+                "accountId": {
+                    "type": "string"
+                },
+                "costBasisMethod": {
+                    "$ref": "#/definitions/asset.CostBasisMethod"
+                },
+                "definitionId": {
+                    "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "purchaseDate": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/asset.AssetType"
+                }
+            }
+        },
+        "presentation.UpdateBondRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "faceValue",
+                "issueDate",
+                "maturityDate"
+            ],
+            "properties": {
+                "couponFrequency": {
+                    "type": "integer"
+                },
+                "couponRate": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayCount": {
+                    "$ref": "#/definitions/bond.DayCount"
+                },
+                "faceValue": {
+                    "type": "number"
+                },
+                "issueDate": {
+                    "type": "integer"
+                },
+                "maturityDate": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.UpdateDefinitionRequest": {
+            "type": "object",
+            "required": [
+                "abbreviation",
+                "name"
+            ],
+            "properties": {
+                "abbreviation": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "suffix": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.UpdateIncomeRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "grossAmount",
+                "payDate",
+                "type"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "grossAmount": {
+                    "type": "number"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "payDate": {
+                    "type": "integer"
+                },
+                "type": {
+                    "$ref": "#/definitions/income.IncomeType"
+                },
+                "withholdingTax": {
+                    "type": "number"
+                }
+            }
+        },
+        "presentation.UpdateLoanRequest": {
+            "type": "object",
+            "required": [
+                "principal",
+                "startDate",
+                "termPeriods"
+            ],
+            "properties": {
+                "annualRate": {
+                    "type": "number"
+                },
+                "firstPaymentDate": {
+                    "type": "integer"
+                },
+                "method": {
+                    "$ref": "#/definitions/loan.Method"
+                },
+                "paymentFrequency": {
+                    "type": "integer"
+                },
+                "principal": {
+                    "type": "number"
+                },
+                "startDate": {
+                    "type": "integer"
+                },
+                "termPeriods": {
+                    "type": "integer"
+                }
+            }
+        },
+        "presentation.UpdatePropertyRequest": {
+            "type": "object",
+            "required": [
+                "currency",
+                "purchaseDate",
+                "purchasePrice"
+            ],
+            "properties": {
+                "currency": {
+                    "type": "string"
+                },
+                "mortgageLoanId": {
+                    "type": "string"
+                },
+                "purchaseCosts": {
+                    "type": "number"
+                },
+                "purchaseDate": {
+                    "type": "integer"
+                },
+                "purchasePrice": {
+                    "type": "number"
                 }
             }
         },
-        "presentation.ChangePasswordRequest": {
+        "presentation.UpdateReceivableRequest": {
             "type": "object",
             "required": [
-                "confirmPassword",
-                "newPassword",
-                "oldPassword"
+                "amount",
+                "counterpartyName",
+                "dueDate"
             ],
             "properties": {
-                "confirmPassword": {
-                    "type": "string",
-                    "minLength": 8
+                "amount": {
+                    "type": "number"
                 },
-                "newPassword": {
-                    "type": "string",
-                    "minLength": 8
+                "counterpartyContact": {
+                    "type": "string"
                 },
-                "oldPassword": {
+                "counterpartyName": {
                     "type": "string"
+                },
+                "dueDate": {
+                    "type": "integer"
+                },
+                "issueDate": {
+                    "type": "integer"
                 }
             }
         },
-        "presentation.CreateAccountRequest": {
+        "presentation.UpdateRuleRequest": {
             "type": "object",
             "required": [
-                "accountType",
+                "amount",
+                "frequency",
                 "name"
             ],
             "properties": {
-                "accountType": {
-                    "$ref": "#/definitions/account.AccountType"
+                "active": {
+                    "type": "boolean"
+                },
+                "amount": {
+                    "type": "number"
+                },
+                "currency": {
+                    "type": "string"
+                },
+                "dayOfMonth": {
+                    "type": "integer"
+                },
+                "dayOfWeek": {
+                    "type": "integer"
+                },
+                "endDate": {
+                    "type": "integer"
+                },
+                "frequency": {
+                    "$ref": "#/definitions/recurring.Frequency"
+                },
+                "interval": {
+                    "type": "integer"
+                },
+                "monthOfYear": {
+                    "type": "integer"
                 },
                 "name": {
                     "type": "string"
+                },
+                "notes": {
+                    "type": "string"
+                },
+                "price": {
+                    "type": "number"
                 }
             }
         },
-        "presentation.CreateAssetRequest": {
+        "presentation.UpdateTermDepositRequest": {
             "type": "object",
             "required": [
-                "accountId",
-                "definitionId",
-                "purchaseDate",
-                "quantity",
-                "type"
+                "maturityDate"
             ],
             "properties": {
-                "accountId": {
-                    "type": "string"
+                "annualRate": {
+                    "type": "number"
                 },
-                "definitionId": {
-                    "type": "string"
+                "compounding": {
+                    "$ref": "#/definitions/termdeposit.Compounding"
                 },
-                "notes": {
-                    "type": "string"
+                "maturityAction": {
+                    "$ref": "#/definitions/termdeposit.MaturityAction"
                 },
-                "purchaseDate": {
+                "maturityDate": {
                     "type": "integer"
                 },
-                "quantity": {
-                    "type": "number"
+                "payoutAccountId": {
+                    "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/asset.AssetType"
+                "withholdingTaxRate": {
+                    "type": "number"
                 }
             }
         },
-        "presentation.CreateDefinitionRequest": {
+        "presentation.UpdateUserRequest": {
             "type": "object",
             "required": [
-                "abbreviation",
-                "name"
+                "email",
+                "firstName",
+                "lastName"
             ],
             "properties": {
-                "abbreviation": {
+                "email": {
                     "type": "string"
                 },
-                "name": {
+                "firstName": {
                     "type": "string"
                 },
-                "suffix": {
+                "lastName": {
                     "type": "string"
                 }
             }
         },
-        "presentation.DefinitionResponse": {
+        "presentation.UpdateWebhookRequest": {
             "type": "object",
+            "required": [
+                "eventTypes",
+                "url"
+            ],
             "properties": {
-                "abbreviation": {
+                "description": {
                     "type": "string"
                 },
-                "createdAt": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
                     "type": "string"
                 },
-                "id": {
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "presentation.UserPublic": {
+            "type": "object",
+            "properties": {
+                "email": {
                     "type": "string"
                 },
-                "name": {
+                "firstName": {
                     "type": "string"
                 },
-                "suffix": {
+                "id": {
                     "type": "string"
                 },
-                "updatedAt": {
+                "lastName": {
                     "type": "string"
                 }
             }
         },
-        "presentation.DefinitionsListResponse": {
+        "presentation.WebhookDeliveriesListResponse": {
             "type": "object",
             "properties": {
-                "definitions": {
+                "deliveries": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/presentation.DefinitionResponse"
+                        "$ref": "#/definitions/presentation.WebhookDeliveryResponse"
                     }
                 },
                 "total": {
@@ -1704,127 +12306,197 @@ const docTemplate = `{
                 }
             }
         },
-        "presentation.DeleteUserRequest": {
+        "presentation.WebhookDeliveryResponse": {
             "type": "object",
-            "required": [
-                "password"
-            ],
             "properties": {
-                "password": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "createdAt": {
                     "type": "string"
-                }
-            }
-        },
-        "presentation.TokenResponse": {
-            "type": "object",
-            "properties": {
-                "token": {
+                },
+                "durationMs": {
+                    "type": "integer"
+                },
+                "error": {
                     "type": "string"
                 },
-                "user": {
-                    "$ref": "#/definitions/presentation.UserPublic"
-                }
-            }
-        },
-        "presentation.UpdateAccountRequest": {
-            "type": "object",
-            "required": [
-                "accountType",
-                "name"
-            ],
-            "properties": {
-                "accountType": {
-                    "$ref": "#/definitions/account.AccountType"
+                "eventId": {
+                    "type": "string"
                 },
-                "name": {
+                "eventType": {
                     "type": "string"
-                }
-            }
-        },
-        "presentation.UpdateAssetRequest": {
-            "type": "object",
-            "required": [
-                "accountId",
-                "definitionId",
-                "purchaseDate",
-                "quantity",
-                "type"
-            ],
-            "properties": {
-                "accountId": {
+                },
+                "id": {
                     "type": "string"
                 },
-                "definitionId": {
+                "lastAttemptAt": {
                     "type": "string"
                 },
-                "notes": {
+                "nextAttemptAt": {
                     "type": "string"
                 },
-                "purchaseDate": {
+                "payload": {
+                    "type": "object"
+                },
+                "redeliveryOf": {
+                    "type": "string"
+                },
+                "responseBody": {
+                    "type": "string"
+                },
+                "responseStatus": {
                     "type": "integer"
                 },
-                "quantity": {
-                    "type": "number"
+                "status": {
+                    "type": "string"
                 },
-                "type": {
-                    "$ref": "#/definitions/asset.AssetType"
+                "webhookId": {
+                    "type": "string"
                 }
             }
         },
-        "presentation.UpdateDefinitionRequest": {
+        "presentation.WebhookResponse": {
             "type": "object",
-            "required": [
-                "abbreviation",
-                "name"
-            ],
             "properties": {
-                "abbreviation": {
+                "createdAt": {
                     "type": "string"
                 },
-                "name": {
+                "description": {
                     "type": "string"
                 },
-                "suffix": {
+                "enabled": {
+                    "type": "boolean"
+                },
+                "eventTypes": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
                     "type": "string"
-                }
-            }
-        },
-        "presentation.UpdateUserRequest": {
-            "type": "object",
-            "required": [
-                "email",
-                "firstName",
-                "lastName"
-            ],
-            "properties": {
-                "email": {
+                },
+                "secret": {
+                    "description": "Secret is only returned when the webhook is created",
                     "type": "string"
                 },
-                "firstName": {
+                "updatedAt": {
                     "type": "string"
                 },
-                "lastName": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "presentation.UserPublic": {
+        "presentation.WebhooksListResponse": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "firstName": {
-                    "type": "string"
+                "total": {
+                    "type": "integer"
                 },
-                "id": {
-                    "type": "string"
+                "webhooks": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/presentation.WebhookResponse"
+                    }
+                }
+            }
+        },
+        "presentation.WriteOffRequest": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "integer"
                 },
-                "lastName": {
+                "notes": {
                     "type": "string"
                 }
             }
         },
+        "realestate.ExpenseCategory": {
+            "type": "string",
+            "enum": [
+                "MAINTENANCE",
+                "PROPERTY_TAX",
+                "INSURANCE",
+                "MANAGEMENT",
+                "UTILITIES",
+                "HOA",
+                "OTHER"
+            ],
+            "x-enum-varnames": [
+                "Maintenance",
+                "PropertyTax",
+                "Insurance",
+                "Management",
+                "Utilities",
+                "HOA",
+                "Other"
+            ]
+        },
+        "recurring.Frequency": {
+            "type": "string",
+            "enum": [
+                "WEEKLY",
+                "MONTHLY",
+                "YEARLY"
+            ],
+            "x-enum-varnames": [
+                "Weekly",
+                "Monthly",
+                "Yearly"
+            ]
+        },
+        "termdeposit.Compounding": {
+            "type": "string",
+            "enum": [
+                "SIMPLE",
+                "DAILY",
+                "MONTHLY",
+                "QUARTERLY",
+                "ANNUALLY"
+            ],
+            "x-enum-varnames": [
+                "Simple",
+                "Daily",
+                "Monthly",
+                "Quarterly",
+                "Annually"
+            ]
+        },
+        "termdeposit.MaturityAction": {
+            "type": "string",
+            "enum": [
+                "HOLD",
+                "ROLLOVER",
+                "MOVE_TO_CASH"
+            ],
+            "x-enum-varnames": [
+                "Hold",
+                "Rollover",
+                "MoveToCash"
+            ]
+        },
+        "transaction.TransactionType": {
+            "type": "string",
+            "enum": [
+                "BUY",
+                "SELL",
+                "DEPOSIT",
+                "WITHDRAW",
+                "FEE",
+                "ADJUSTMENT"
+            ],
+            "x-enum-varnames": [
+                "Buy",
+                "Sell",
+                "Deposit",
+                "Withdraw",
+                "Fee",
+                "Adjustment"
+            ]
+        },
         "user.LoginUserCommand": {
             "type": "object",
             "required": [
@@ -1866,6 +12538,12 @@ const docTemplate = `{
         }
     },
     "securityDefinitions": {
+        "APIKeyAuth": {
+            "description": "Key of a trusted service, for endpoints that write shared data such as prices.",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
+        },
         "BearerAuth": {
             "description": "Type \"Bearer\" followed by a space and JWT token.",
             "type": "apiKey",
@@ -24,6 +24,11 @@ const (
 	Other             AccountType = "OTHER"
 )
 
+// IsLiability reports whether balances held in this type of account are owed rather than owned.
+func (t AccountType) IsLiability() bool {
+	return t == CreditCard
+}
+
 type Account struct {
 	ID          uuid.UUID   `json:"id" db:"id"`
 	UserID      uuid.UUID   `json:"userId" db:"user_id"`
@@ -6,15 +6,21 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/valuation"
 )
 
 type Handler struct {
-	repo Repository
+	event.Publisher
+	repo             Repository
+	valuationService *valuation.Handler
 }
 
-func NewHandler(repo Repository) *Handler {
+func NewHandler(repo Repository, valuationService *valuation.Handler) *Handler {
 	return &Handler{
-		repo: repo,
+		repo:             repo,
+		valuationService: valuationService,
 	}
 }
 
@@ -30,6 +36,7 @@ func (h *Handler) HandleCreateAccountCommand(ctx context.Context, command Create
 	if err := h.repo.Create(ctx, account); err != nil {
 		return nil, err
 	}
+	h.Publish(ctx, event.AccountCreated, account.UserID, account)
 	return account, nil
 }
 
@@ -67,6 +74,7 @@ func (h *Handler) HandleUpdateAccountCommand(ctx context.Context, command Update
 	if err := h.repo.Update(ctx, existingAccount); err != nil {
 		return nil, err
 	}
+	h.Publish(ctx, event.AccountUpdated, userID, existingAccount)
 
 	return existingAccount, nil
 }
@@ -91,7 +99,11 @@ func (h *Handler) HandleDeleteAccountCommand(ctx context.Context, command Delete
 		return errors.New("unauthorized: account does not belong to user")
 	}
 
-	return h.repo.Delete(ctx, accountID)
+	if err := h.repo.Delete(ctx, accountID); err != nil {
+		return err
+	}
+	h.Publish(ctx, event.AccountDeleted, userID, existingAccount)
+	return nil
 }
 
 func (h *Handler) HandleGetAccountByIDQuery(ctx context.Context, query GetAccountByIDQuery) (*Account, error) {
@@ -181,7 +193,52 @@ func (h *Handler) HandleGetAccountSummaryQuery(ctx context.Context, query GetAcc
 		return nil, errors.New("invalid user ID")
 	}
 
-	return h.repo.GetAccountSummary(ctx, userID)
+	summary, err := h.repo.GetAccountSummary(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts, err := h.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	portfolio, err := h.valuationService.HandleValuePortfolioQuery(ctx, valuation.ValuePortfolioQuery{
+		UserID:       query.UserID,
+		BaseCurrency: query.BaseCurrency,
+		At:           time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary.BaseCurrency = portfolio.BaseCurrency
+	summary.GrossAssets = portfolio.GrossAssets
+	summary.GrossLiabilities = portfolio.GrossLiabilities
+	summary.TotalValue = portfolio.Total
+	summary.Unpriced = portfolio.Unpriced
+	summary.ByCurrency = make(map[string]float64)
+
+	byAccount := make(map[uuid.UUID]*AccountValue)
+	for _, a := range accounts {
+		value := &AccountValue{
+			AccountID:   a.ID,
+			Name:        a.Name,
+			AccountType: a.AccountType,
+		}
+		byAccount[a.ID] = value
+		summary.Accounts = append(summary.Accounts, value)
+	}
+
+	for _, holding := range portfolio.Holdings {
+		summary.ByCurrency[holding.Currency] += holding.NetValue()
+		if value, ok := byAccount[holding.AccountID]; ok {
+			value.Value += holding.NetValue()
+			value.Assets = append(value.Assets, holding)
+		}
+	}
+
+	return summary, nil
 }
 
 func isValidAccountType(t AccountType) bool {
@@ -22,5 +22,6 @@ type FilterAccountsQuery struct {
 }
 
 type GetAccountSummaryQuery struct {
-	UserID string `json:"userId" validate:"required"`
+	UserID       string `json:"userId" validate:"required"`
+	BaseCurrency string `json:"baseCurrency"`
 }
@@ -4,12 +4,28 @@ import (
 	"context"
 
 	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
 )
 
 type AccountSummary struct {
-	TotalAccounts int                 `json:"totalAccounts"`
-	ByType        map[AccountType]int `json:"byType"`
-	ByCurrency    map[string]float64  `json:"byCurrency"` // calculated from assets
+	TotalAccounts    int                 `json:"totalAccounts"`
+	ByType           map[AccountType]int `json:"byType"`
+	BaseCurrency     string              `json:"baseCurrency"`
+	GrossAssets      float64             `json:"grossAssets"`
+	GrossLiabilities float64             `json:"grossLiabilities"`
+	TotalValue       float64             `json:"totalValue"` // net of liabilities
+	ByCurrency       map[string]float64  `json:"byCurrency"` // base currency value per denomination
+	Unpriced         int                 `json:"unpriced"`
+	Accounts         []*AccountValue     `json:"accounts"`
+}
+
+type AccountValue struct {
+	AccountID   uuid.UUID              `json:"accountId"`
+	Name        string                 `json:"name"`
+	AccountType AccountType            `json:"accountType"`
+	Value       float64                `json:"value"`
+	Assets      []*valuation.Valuation `json:"assets"`
 }
 
 type Repository interface {
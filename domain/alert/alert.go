@@ -0,0 +1,211 @@
+package alert
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Kind string
+
+const (
+	// PriceAbove fires when the latest price of a definition reaches the threshold
+	PriceAbove Kind = "PRICE_ABOVE"
+	// PriceBelow fires when the latest price of a definition falls to the threshold
+	PriceBelow Kind = "PRICE_BELOW"
+	// PriceChange fires when the price moved by at least the threshold percent in 24 hours
+	PriceChange Kind = "PRICE_CHANGE"
+	// NetWorthBelow fires when the user's net worth drops below the threshold
+	NetWorthBelow Kind = "NET_WORTH_BELOW"
+	// AccountShareAbove fires when an account holds more than the threshold percent of the gross assets
+	AccountShareAbove Kind = "ACCOUNT_SHARE_ABOVE"
+)
+
+func (k Kind) IsValid() bool {
+	switch k {
+	case PriceAbove, PriceBelow, PriceChange, NetWorthBelow, AccountShareAbove:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPrice reports whether the alert watches the price of a definition rather than the portfolio.
+func (k Kind) IsPrice() bool {
+	return k == PriceAbove || k == PriceBelow || k == PriceChange
+}
+
+type Channel string
+
+const (
+	Inbox   Channel = "INBOX"
+	Webhook Channel = "WEBHOOK"
+	Email   Channel = "EMAIL"
+)
+
+func (c Channel) IsValid() bool {
+	switch c {
+	case Inbox, Webhook, Email:
+		return true
+	default:
+		return false
+	}
+}
+
+// Alert watches a price or the portfolio of a user. It fires once when its condition
+// becomes true and is armed again when the condition no longer holds.
+type Alert struct {
+	ID           uuid.UUID  `json:"id" db:"id"`
+	UserID       uuid.UUID  `json:"userId" db:"user_id"`
+	Name         string     `json:"name" db:"name"`
+	Kind         Kind       `json:"kind" db:"kind"`
+	DefinitionID *uuid.UUID `json:"definitionId,omitempty" db:"definition_id"`
+	AccountID    *uuid.UUID `json:"accountId,omitempty" db:"account_id"`
+	// Currency is the quote currency of a price alert or the base currency of a portfolio alert
+	Currency  string    `json:"currency" db:"currency"`
+	Threshold float64   `json:"threshold" db:"threshold"`
+	Channels  []Channel `json:"channels" db:"-"`
+	// WebhookURL receives the WEBHOOK deliveries
+	WebhookURL string `json:"webhookUrl" db:"webhook_url"`
+	// WebhookSecret signs the WEBHOOK deliveries
+	WebhookSecret string `json:"-" db:"webhook_secret"`
+	// Email receives the EMAIL deliveries. It is the email address of the user, looked up again on delivery
+	Email           string     `json:"email" db:"email"`
+	Enabled         bool       `json:"enabled" db:"enabled"`
+	Triggered       bool       `json:"triggered" db:"triggered"`
+	LastValue       *float64   `json:"lastValue,omitempty" db:"last_value"`
+	LastEvaluatedAt *time.Time `json:"lastEvaluatedAt,omitempty" db:"last_evaluated_at"`
+	LastTriggeredAt *time.Time `json:"lastTriggeredAt,omitempty" db:"last_triggered_at"`
+	CreatedAt       time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt       time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func NewAlert(command CreateAlertCommand) *Alert {
+	now := time.Now()
+	a := &Alert{
+		ID:         uuid.New(),
+		UserID:     uuid.MustParse(command.UserID),
+		Name:       command.Name,
+		Kind:       command.Kind,
+		Currency:   strings.ToUpper(command.Currency),
+		Threshold:  command.Threshold,
+		Channels:   normalizeChannels(command.Channels),
+		WebhookURL: command.WebhookURL,
+		Email:      command.Email,
+		Enabled:    true,
+		CreatedAt:  now,
+		UpdatedAt:  now,
+	}
+	if command.DefinitionID != nil {
+		definitionID := uuid.MustParse(*command.DefinitionID)
+		a.DefinitionID = &definitionID
+	}
+	if command.AccountID != nil {
+		accountID := uuid.MustParse(*command.AccountID)
+		a.AccountID = &accountID
+	}
+	return a
+}
+
+// Update changes the condition and delivery of the alert. A changed condition arms the alert again.
+func (a *Alert) Update(command UpdateAlertCommand) {
+	currency := strings.ToUpper(command.Currency)
+	if command.Threshold != a.Threshold || currency != a.Currency {
+		a.Triggered = false
+	}
+	a.Name = command.Name
+	a.Currency = currency
+	a.Threshold = command.Threshold
+	a.Channels = normalizeChannels(command.Channels)
+	a.WebhookURL = command.WebhookURL
+	a.Email = command.Email
+	if command.Enabled != nil {
+		a.Enabled = *command.Enabled
+		if !a.Enabled {
+			a.Triggered = false
+		}
+	}
+	a.UpdatedAt = time.Now()
+}
+
+func (a *Alert) HasChannel(channel Channel) bool {
+	for _, c := range a.Channels {
+		if c == channel {
+			return true
+		}
+	}
+	return false
+}
+
+// Holds reports whether the condition of the alert is met by the observed value.
+func (a *Alert) Holds(value float64) bool {
+	switch a.Kind {
+	case PriceAbove:
+		return value >= a.Threshold
+	case PriceBelow:
+		return value <= a.Threshold
+	case PriceChange:
+		return math.Abs(value) >= a.Threshold
+	case NetWorthBelow:
+		return value < a.Threshold
+	case AccountShareAbove:
+		return value > a.Threshold
+	}
+	return false
+}
+
+// Observe records a value the alert was checked against and returns a trigger when the
+// condition has just become true. subject names what was observed, such as a symbol.
+func (a *Alert) Observe(value float64, subject string, at time.Time) *Trigger {
+	a.LastValue = &value
+	a.LastEvaluatedAt = &at
+	a.UpdatedAt = time.Now()
+
+	if !a.Holds(value) {
+		a.Triggered = false
+		return nil
+	}
+	if a.Triggered {
+		return nil
+	}
+	a.Triggered = true
+	a.LastTriggeredAt = &at
+	return newTrigger(a, value, a.describe(value, subject), at)
+}
+
+func (a *Alert) describe(value float64, subject string) string {
+	switch a.Kind {
+	case PriceAbove:
+		return fmt.Sprintf("%s is at %.2f %s, at or above %.2f", subject, value, a.Currency, a.Threshold)
+	case PriceBelow:
+		return fmt.Sprintf("%s is at %.2f %s, at or below %.2f", subject, value, a.Currency, a.Threshold)
+	case PriceChange:
+		return fmt.Sprintf("%s moved %+.2f%% in 24 hours, at least %.2f%%", subject, value, a.Threshold)
+	case NetWorthBelow:
+		return fmt.Sprintf("Net worth is %.2f %s, below %.2f", value, a.Currency, a.Threshold)
+	case AccountShareAbove:
+		return fmt.Sprintf("%s holds %.2f%% of the gross assets, above %.2f%%", subject, value, a.Threshold)
+	}
+	return subject
+}
+
+// normalizeChannels upper-cases and deduplicates the channels, delivering to the inbox when none is given.
+func normalizeChannels(channels []Channel) []Channel {
+	seen := make(map[Channel]bool)
+	var normalized []Channel
+	for _, c := range channels {
+		c = Channel(strings.ToUpper(strings.TrimSpace(string(c))))
+		if seen[c] {
+			continue
+		}
+		seen[c] = true
+		normalized = append(normalized, c)
+	}
+	if len(normalized) == 0 {
+		normalized = []Channel{Inbox}
+	}
+	return normalized
+}
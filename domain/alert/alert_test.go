@@ -0,0 +1,116 @@
+package alert
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHolds(t *testing.T) {
+	tests := []struct {
+		kind  Kind
+		value float64
+		want  bool
+	}{
+		{PriceAbove, 99, false},
+		{PriceAbove, 100, true},
+		{PriceBelow, 100, true},
+		{PriceBelow, 101, false},
+		{PriceChange, -100, true},
+		{PriceChange, 99.9, false},
+		{NetWorthBelow, 100, false},
+		{NetWorthBelow, 99, true},
+		{AccountShareAbove, 100, false},
+		{AccountShareAbove, 100.1, true},
+	}
+	for _, tt := range tests {
+		a := &Alert{Kind: tt.kind, Threshold: 100}
+		if got := a.Holds(tt.value); got != tt.want {
+			t.Errorf("%s Holds(%v) = %v, want %v", tt.kind, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestObserve(t *testing.T) {
+	at := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		triggered bool
+		value     float64
+		fires     bool
+		armed     bool
+	}{
+		{"condition becomes true", false, 120, true, false},
+		{"condition still true", true, 130, false, false},
+		{"condition no longer true", true, 90, false, true},
+		{"condition still false", false, 90, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Alert{Kind: PriceAbove, Threshold: 100, Currency: "USD", Channels: []Channel{Inbox}, Triggered: tt.triggered}
+
+			trigger := a.Observe(tt.value, "BTC", at)
+
+			if (trigger != nil) != tt.fires {
+				t.Fatalf("Observe fired %v, want %v", trigger != nil, tt.fires)
+			}
+			if a.Triggered == tt.armed {
+				t.Errorf("Triggered = %v, want armed %v", a.Triggered, tt.armed)
+			}
+			if a.LastValue == nil || *a.LastValue != tt.value || !a.LastEvaluatedAt.Equal(at) {
+				t.Errorf("observation not recorded")
+			}
+			if trigger != nil && (!trigger.Inbox || trigger.Value != tt.value || trigger.Message != "BTC is at 120.00 USD, at or above 100.00") {
+				t.Errorf("trigger = %+v", trigger)
+			}
+		})
+	}
+}
+
+func TestUpdateRearms(t *testing.T) {
+	disabled := false
+
+	tests := []struct {
+		name    string
+		command UpdateAlertCommand
+		armed   bool
+	}{
+		{"same condition", UpdateAlertCommand{Currency: "usd", Threshold: 100}, false},
+		{"new threshold", UpdateAlertCommand{Currency: "USD", Threshold: 110}, true},
+		{"new currency", UpdateAlertCommand{Currency: "EUR", Threshold: 100}, true},
+		{"disabled", UpdateAlertCommand{Currency: "USD", Threshold: 100, Enabled: &disabled}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := &Alert{Kind: PriceAbove, Threshold: 100, Currency: "USD", Enabled: true, Triggered: true}
+			a.Update(tt.command)
+			if a.Triggered == tt.armed {
+				t.Errorf("Triggered = %v, want armed %v", a.Triggered, tt.armed)
+			}
+		})
+	}
+}
+
+func TestNormalizeChannels(t *testing.T) {
+	tests := []struct {
+		name     string
+		channels []Channel
+		want     []Channel
+	}{
+		{"defaults to the inbox", nil, []Channel{Inbox}},
+		{"upper-cased and deduplicated", []Channel{"webhook", " WEBHOOK ", "email"}, []Channel{Webhook, Email}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := normalizeChannels(tt.channels)
+			if len(got) != len(tt.want) {
+				t.Fatalf("normalizeChannels = %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("normalizeChannels = %v, want %v", got, tt.want)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package alert
+
+type CreateAlertCommand struct {
+	UserID string `json:"userId" validate:"required"`
+	Name   string `json:"name"`
+	Kind   Kind   `json:"kind" validate:"required"`
+	// DefinitionID is the definition a price alert watches
+	DefinitionID *string `json:"definitionId,omitempty"`
+	// AccountID is the account an ACCOUNT_SHARE_ABOVE alert watches
+	AccountID *string `json:"accountId,omitempty"`
+	// Currency defaults to the configured base currency
+	Currency   string    `json:"currency"`
+	Threshold  float64   `json:"threshold" validate:"required"`
+	Channels   []Channel `json:"channels"`
+	WebhookURL string    `json:"webhookUrl"`
+	// Email defaults to the user's email address, which is the only one accepted
+	Email string `json:"email"`
+}
+
+type UpdateAlertCommand struct {
+	ID         string    `json:"id" validate:"required"`
+	UserID     string    `json:"userId" validate:"required"`
+	Name       string    `json:"name"`
+	Currency   string    `json:"currency"`
+	Threshold  float64   `json:"threshold" validate:"required"`
+	Channels   []Channel `json:"channels"`
+	WebhookURL string    `json:"webhookUrl"`
+	Email      string    `json:"email"`
+	Enabled    *bool     `json:"enabled,omitempty"`
+}
+
+type DeleteAlertCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// EvaluateAlertsCommand checks the price alerts of the definitions priced since the last
+// run, or all of them when none were, together with every portfolio alert.
+type EvaluateAlertsCommand struct{}
+
+// MarkReadCommand marks an inbox entry as read, or the whole inbox when TriggerID is empty.
+type MarkReadCommand struct {
+	UserID    string `json:"userId" validate:"required"`
+	TriggerID string `json:"triggerId"`
+}
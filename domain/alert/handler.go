@@ -0,0 +1,500 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net/mail"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/endpoint"
+	"siyahsensei/wallet-service/domain/portfolio"
+	"siyahsensei/wallet-service/domain/price"
+	"siyahsensei/wallet-service/domain/user"
+	"siyahsensei/wallet-service/domain/webhook"
+)
+
+// changeWindow is the period a PRICE_CHANGE alert measures the move over
+const changeWindow = 24 * time.Hour
+
+type Handler struct {
+	repo                Repository
+	definitionRepo      definition.Repository
+	accountRepo         account.Repository
+	userRepo            user.Repository
+	priceRepo           price.Repository
+	portfolioService    *portfolio.Handler
+	notifiers           map[Channel]Notifier
+	defaultBaseCurrency string
+
+	mu      sync.Mutex
+	pending map[uuid.UUID]bool
+	wake    chan struct{}
+}
+
+func NewHandler(repo Repository, definitionRepo definition.Repository, accountRepo account.Repository, userRepo user.Repository,
+	priceRepo price.Repository, portfolioService *portfolio.Handler, notifiers []Notifier, defaultBaseCurrency string) *Handler {
+	byChannel := make(map[Channel]Notifier, len(notifiers))
+	for _, n := range notifiers {
+		byChannel[n.Channel()] = n
+	}
+	return &Handler{
+		repo:                repo,
+		definitionRepo:      definitionRepo,
+		accountRepo:         accountRepo,
+		userRepo:            userRepo,
+		priceRepo:           priceRepo,
+		portfolioService:    portfolioService,
+		notifiers:           byChannel,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+		pending:             make(map[uuid.UUID]bool),
+		wake:                make(chan struct{}, 1),
+	}
+}
+
+// PricesRecorded queues the priced definitions for evaluation and wakes the evaluator.
+func (h *Handler) PricesRecorded(prices []*price.Price) {
+	if len(prices) == 0 {
+		return
+	}
+	h.mu.Lock()
+	for _, p := range prices {
+		h.pending[p.DefinitionID] = true
+	}
+	h.mu.Unlock()
+
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Wake receives whenever prices were recorded since the evaluator last ran.
+func (h *Handler) Wake() <-chan struct{} {
+	return h.wake
+}
+
+func (h *Handler) HandleCreateAlertCommand(ctx context.Context, command CreateAlertCommand) (*Alert, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	command.Kind = Kind(strings.ToUpper(string(command.Kind)))
+	if !command.Kind.IsValid() {
+		return nil, errors.New("invalid alert kind")
+	}
+	if command.Currency = strings.TrimSpace(command.Currency); command.Currency == "" {
+		command.Currency = h.defaultBaseCurrency
+	}
+
+	if command.Kind.IsPrice() {
+		if command.DefinitionID == nil {
+			return nil, errors.New("definition ID is required for price alerts")
+		}
+		definitionID, err := uuid.Parse(*command.DefinitionID)
+		if err != nil {
+			return nil, errors.New("invalid definition ID")
+		}
+		if _, err := h.definitionRepo.GetByID(ctx, definitionID); err != nil {
+			return nil, errors.New("definition not found")
+		}
+	} else {
+		command.DefinitionID = nil
+	}
+	if command.Kind == AccountShareAbove {
+		if command.AccountID == nil {
+			return nil, errors.New("account ID is required for account share alerts")
+		}
+		accountID, err := uuid.Parse(*command.AccountID)
+		if err != nil {
+			return nil, errors.New("invalid account ID")
+		}
+		existingAccount, err := h.accountRepo.GetByID(ctx, accountID)
+		if err != nil {
+			return nil, errors.New("account not found")
+		}
+		if existingAccount.UserID != userID {
+			return nil, errors.New("unauthorized: account does not belong to user")
+		}
+	} else {
+		command.AccountID = nil
+	}
+
+	a := NewAlert(command)
+	if err := h.prepareDelivery(ctx, a); err != nil {
+		return nil, err
+	}
+	if err := validateThreshold(a.Kind, a.Threshold); err != nil {
+		return nil, err
+	}
+	if a.Name == "" {
+		a.Name = string(a.Kind)
+	}
+
+	if err := h.repo.Create(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (h *Handler) HandleUpdateAlertCommand(ctx context.Context, command UpdateAlertCommand) (*Alert, error) {
+	a, err := h.ownedAlert(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateThreshold(a.Kind, command.Threshold); err != nil {
+		return nil, err
+	}
+	if command.Currency = strings.TrimSpace(command.Currency); command.Currency == "" {
+		command.Currency = a.Currency
+	}
+	if command.Name == "" {
+		command.Name = a.Name
+	}
+
+	a.Update(command)
+	if err := h.prepareDelivery(ctx, a); err != nil {
+		return nil, err
+	}
+	if err := h.repo.Update(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (h *Handler) HandleDeleteAlertCommand(ctx context.Context, command DeleteAlertCommand) error {
+	a, err := h.ownedAlert(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, a.ID)
+}
+
+func (h *Handler) HandleGetAlertByIDQuery(ctx context.Context, query GetAlertByIDQuery) (*Alert, error) {
+	return h.ownedAlert(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserAlertsQuery(ctx context.Context, query GetUserAlertsQuery) ([]*Alert, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleGetTriggersQuery(ctx context.Context, query GetTriggersQuery) ([]*Trigger, error) {
+	a, err := h.ownedAlert(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	limit, offset := page(query.Limit, query.Offset)
+	return h.repo.GetTriggers(ctx, a.ID, limit, offset)
+}
+
+// HandleGetInboxQuery returns the inbox entries of the user, newest first, with the number of unread ones.
+func (h *Handler) HandleGetInboxQuery(ctx context.Context, query GetInboxQuery) ([]*Trigger, int, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, 0, errors.New("invalid user ID")
+	}
+	limit, offset := page(query.Limit, query.Offset)
+	triggers, err := h.repo.GetInbox(ctx, userID, query.UnreadOnly, limit, offset)
+	if err != nil {
+		return nil, 0, err
+	}
+	unread, err := h.repo.CountUnread(ctx, userID)
+	if err != nil {
+		return nil, 0, err
+	}
+	return triggers, unread, nil
+}
+
+// HandleMarkReadCommand returns how many inbox entries were marked as read.
+func (h *Handler) HandleMarkReadCommand(ctx context.Context, command MarkReadCommand) (int, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return 0, errors.New("invalid user ID")
+	}
+	if command.TriggerID == "" {
+		return h.repo.MarkRead(ctx, userID, nil)
+	}
+
+	triggerID, err := uuid.Parse(command.TriggerID)
+	if err != nil {
+		return 0, errors.New("invalid notification ID")
+	}
+	trigger, err := h.repo.GetTriggerByID(ctx, triggerID)
+	if err != nil || !trigger.Inbox {
+		return 0, errors.New("notification not found")
+	}
+	if trigger.UserID != userID {
+		return 0, errors.New("unauthorized: notification does not belong to user")
+	}
+	return h.repo.MarkRead(ctx, userID, &triggerID)
+}
+
+// HandleEvaluateAlertsCommand checks the alerts and delivers the ones that fired. A failing
+// alert does not stop the others; the errors are returned together with the number fired.
+func (h *Handler) HandleEvaluateAlertsCommand(ctx context.Context, command EvaluateAlertsCommand) (int, error) {
+	h.mu.Lock()
+	definitionIDs := make([]uuid.UUID, 0, len(h.pending))
+	for definitionID := range h.pending {
+		definitionIDs = append(definitionIDs, definitionID)
+	}
+	h.pending = make(map[uuid.UUID]bool)
+	h.mu.Unlock()
+
+	priceAlerts, err := h.repo.GetEnabledPriceAlerts(ctx, definitionIDs)
+	if err != nil {
+		return 0, err
+	}
+	portfolioAlerts, err := h.repo.GetEnabledPortfolioAlerts(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var fired int
+	var errs []error
+	now := time.Now()
+	symbols := make(map[uuid.UUID]string)
+	for _, a := range priceAlerts {
+		if ctx.Err() != nil {
+			return fired, ctx.Err()
+		}
+		value, ok, err := h.priceValue(ctx, a)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		symbol, err := h.symbol(ctx, symbols, *a.DefinitionID)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		if h.observe(ctx, a, value, symbol, now, &errs) {
+			fired++
+		}
+	}
+
+	netWorths := make(map[string]*portfolio.NetWorth)
+	for _, a := range portfolioAlerts {
+		if ctx.Err() != nil {
+			return fired, ctx.Err()
+		}
+		key := a.UserID.String() + ":" + a.Currency
+		netWorth, ok := netWorths[key]
+		if !ok {
+			netWorth, err = h.portfolioService.HandleGetNetWorthQuery(ctx, portfolio.GetNetWorthQuery{
+				UserID:       a.UserID.String(),
+				BaseCurrency: a.Currency,
+			})
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			netWorths[key] = netWorth
+		}
+		value, subject, ok := portfolioValue(a, netWorth)
+		if !ok {
+			continue
+		}
+		if h.observe(ctx, a, value, subject, now, &errs) {
+			fired++
+		}
+	}
+	return fired, errors.Join(errs...)
+}
+
+// observe checks the alert against the value, delivers the trigger if it fired and stores the outcome.
+func (h *Handler) observe(ctx context.Context, a *Alert, value float64, subject string, at time.Time, errs *[]error) bool {
+	trigger := a.Observe(value, subject, at)
+	if trigger != nil {
+		for _, channel := range a.Channels {
+			if channel == Inbox {
+				continue
+			}
+			notifier, ok := h.notifiers[channel]
+			if !ok {
+				trigger.record(channel, errors.New("channel is not configured"))
+				continue
+			}
+			if channel == Email {
+				// the account email address may have changed, or a restored alert may carry another one
+				existingUser, err := h.userRepo.GetByID(ctx, a.UserID)
+				if err != nil {
+					trigger.record(channel, errors.New("user not found"))
+					continue
+				}
+				a.Email = existingUser.Email
+			}
+			trigger.record(channel, notifier.Notify(ctx, a, trigger))
+		}
+	}
+	if err := h.repo.SaveEvaluation(ctx, a, trigger); err != nil {
+		*errs = append(*errs, err)
+		return false
+	}
+	return trigger != nil
+}
+
+// priceValue returns the latest price of a PRICE_ABOVE or PRICE_BELOW alert, or the
+// percent move over the last 24 hours of a PRICE_CHANGE alert. ok is false when there
+// are not enough prices in the alert currency yet.
+func (h *Handler) priceValue(ctx context.Context, a *Alert) (float64, bool, error) {
+	latest, err := h.priceRepo.GetLatest(ctx, *a.DefinitionID, a.Currency)
+	if err != nil {
+		return 0, false, nil
+	}
+	if a.Kind != PriceChange {
+		return latest.Price, true, nil
+	}
+
+	previous, err := h.priceRepo.GetAt(ctx, *a.DefinitionID, a.Currency, latest.PricedAt.Add(-changeWindow))
+	if err != nil || previous.Price <= 0 {
+		return 0, false, nil
+	}
+	return (latest.Price - previous.Price) / previous.Price * 100, true, nil
+}
+
+// portfolioValue returns the net worth of a NET_WORTH_BELOW alert, or the percentage of the
+// gross assets held in the account of an ACCOUNT_SHARE_ABOVE alert.
+func portfolioValue(a *Alert, netWorth *portfolio.NetWorth) (float64, string, bool) {
+	if a.Kind == NetWorthBelow {
+		return netWorth.NetWorth, "", true
+	}
+	if netWorth.GrossAssets <= 0 || a.AccountID == nil {
+		return 0, "", false
+	}
+	for _, account := range netWorth.Accounts {
+		if account.AccountID == *a.AccountID {
+			return account.Assets / netWorth.GrossAssets * 100, account.Name, true
+		}
+	}
+	return 0, "", true
+}
+
+func (h *Handler) symbol(ctx context.Context, symbols map[uuid.UUID]string, definitionID uuid.UUID) (string, error) {
+	if symbol, ok := symbols[definitionID]; ok {
+		return symbol, nil
+	}
+	d, err := h.definitionRepo.GetByID(ctx, definitionID)
+	if err != nil {
+		return "", err
+	}
+	symbols[definitionID] = d.Abbreviation
+	return d.Abbreviation, nil
+}
+
+// prepareDelivery checks the channels of the alert and fills in the user's email address
+// for EMAIL deliveries when none is given. No other address is accepted.
+func (h *Handler) prepareDelivery(ctx context.Context, a *Alert) error {
+	for _, channel := range a.Channels {
+		if !channel.IsValid() {
+			return errors.New("invalid channel " + string(channel))
+		}
+		if channel == Inbox {
+			continue
+		}
+		if _, ok := h.notifiers[channel]; !ok {
+			return errors.New(strings.ToLower(string(channel)) + " alerts are not configured")
+		}
+	}
+
+	if a.HasChannel(Webhook) {
+		if err := endpoint.Validate(ctx, a.WebhookURL); err != nil {
+			return errors.New("webhook URL " + err.Error())
+		}
+		if a.WebhookSecret == "" {
+			secret, err := webhook.NewSecret()
+			if err != nil {
+				return err
+			}
+			a.WebhookSecret = secret
+		}
+	}
+	if a.HasChannel(Email) {
+		if err := h.checkEmail(ctx, a); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkEmail fills in the account email address of an alert without one and rejects any
+// other address, so EMAIL deliveries only ever reach the owner of the alert.
+func (h *Handler) checkEmail(ctx context.Context, a *Alert) error {
+	existingUser, err := h.userRepo.GetByID(ctx, a.UserID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+	if strings.TrimSpace(a.Email) == "" {
+		a.Email = existingUser.Email
+	}
+	if _, err := mail.ParseAddress(a.Email); err != nil {
+		return errors.New("invalid email address")
+	}
+	if !strings.EqualFold(strings.TrimSpace(a.Email), existingUser.Email) {
+		return errors.New("email alerts can only be sent to the account email address")
+	}
+	a.Email = existingUser.Email
+	return nil
+}
+
+func (h *Handler) ownedAlert(ctx context.Context, alertIDValue, userIDValue string) (*Alert, error) {
+	alertID, err := uuid.Parse(alertIDValue)
+	if err != nil {
+		return nil, errors.New("invalid alert ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	a, err := h.repo.GetByID(ctx, alertID)
+	if err != nil {
+		return nil, errors.New("alert not found")
+	}
+
+	if a.UserID != userID {
+		return nil, errors.New("unauthorized: alert does not belong to user")
+	}
+
+	return a, nil
+}
+
+func validateThreshold(kind Kind, threshold float64) error {
+	if math.IsNaN(threshold) || math.IsInf(threshold, 0) {
+		return errors.New("invalid threshold")
+	}
+	switch kind {
+	case PriceAbove, PriceBelow, PriceChange:
+		if threshold <= 0 {
+			return errors.New("threshold must be greater than zero")
+		}
+	case AccountShareAbove:
+		if threshold <= 0 || threshold >= 100 {
+			return errors.New("threshold must be a percentage between 0 and 100")
+		}
+	}
+	return nil
+}
+
+func page(limit, offset int) (int, int) {
+	if limit <= 0 {
+		limit = 50
+	}
+	if offset < 0 {
+		offset = 0
+	}
+	return limit, offset
+}
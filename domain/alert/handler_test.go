@@ -0,0 +1,125 @@
+package alert
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/user"
+)
+
+type fakeUsers struct {
+	user.Repository
+	users map[uuid.UUID]*user.User
+}
+
+func (f *fakeUsers) GetByID(ctx context.Context, id uuid.UUID) (*user.User, error) {
+	u, ok := f.users[id]
+	if !ok {
+		return nil, errors.New("user not found")
+	}
+	return u, nil
+}
+
+type fakeAlerts struct {
+	Repository
+	saved []*Trigger
+}
+
+func (f *fakeAlerts) SaveEvaluation(ctx context.Context, alert *Alert, trigger *Trigger) error {
+	f.saved = append(f.saved, trigger)
+	return nil
+}
+
+type fakeMailer struct {
+	sentTo []string
+}
+
+func (f *fakeMailer) Channel() Channel {
+	return Email
+}
+
+func (f *fakeMailer) Notify(ctx context.Context, a *Alert, t *Trigger) error {
+	f.sentTo = append(f.sentTo, a.Email)
+	return nil
+}
+
+func TestPrepareDeliveryEmail(t *testing.T) {
+	owner := &user.User{ID: uuid.New(), Email: "owner@example.com"}
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		email  string
+		want   string
+		err    string
+	}{
+		{name: "no address defaults to the account email", userID: owner.ID, want: "owner@example.com"},
+		{name: "the account email", userID: owner.ID, email: "owner@example.com", want: "owner@example.com"},
+		{name: "the account email in another case", userID: owner.ID, email: " Owner@Example.com ", want: "owner@example.com"},
+		{name: "another address", userID: owner.ID, email: "someone@example.com", err: "email alerts can only be sent to the account email address"},
+		{name: "not an address", userID: owner.ID, email: "owner", err: "invalid email address"},
+		{name: "unknown user", userID: uuid.New(), err: "user not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			users := &fakeUsers{users: map[uuid.UUID]*user.User{owner.ID: owner}}
+			h := NewHandler(&fakeAlerts{}, nil, nil, users, nil, nil, []Notifier{&fakeMailer{}}, "USD")
+			a := &Alert{UserID: tt.userID, Channels: []Channel{Email}, Email: tt.email}
+
+			err := h.prepareDelivery(context.Background(), a)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("prepareDelivery error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("prepareDelivery: %v", err)
+			}
+			if a.Email != tt.want {
+				t.Errorf("Email = %q, want %q", a.Email, tt.want)
+			}
+		})
+	}
+}
+
+func TestObserveEmailsTheAccount(t *testing.T) {
+	owner := &user.User{ID: uuid.New(), Email: "owner@example.com"}
+
+	tests := []struct {
+		name   string
+		userID uuid.UUID
+		email  string
+		sentTo []string
+		err    string
+	}{
+		{name: "the account email", userID: owner.ID, email: "owner@example.com", sentTo: []string{"owner@example.com"}},
+		{name: "a stored address that is not the account email", userID: owner.ID, email: "someone@example.com", sentTo: []string{"owner@example.com"}},
+		{name: "user gone", userID: uuid.New(), email: "owner@example.com", err: "user not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeAlerts{}
+			mailer := &fakeMailer{}
+			users := &fakeUsers{users: map[uuid.UUID]*user.User{owner.ID: owner}}
+			h := NewHandler(repo, nil, nil, users, nil, nil, []Notifier{mailer}, "USD")
+			a := &Alert{ID: uuid.New(), UserID: tt.userID, Kind: PriceAbove, Threshold: 100, Channels: []Channel{Email}, Email: tt.email, Enabled: true}
+
+			var errs []error
+			if !h.observe(context.Background(), a, 101, "BTC", time.Now(), &errs) || len(errs) != 0 {
+				t.Fatalf("observe did not fire, errors %v", errs)
+			}
+			if len(mailer.sentTo) != len(tt.sentTo) || (len(tt.sentTo) == 1 && mailer.sentTo[0] != tt.sentTo[0]) {
+				t.Errorf("emailed %v, want %v", mailer.sentTo, tt.sentTo)
+			}
+			delivery := repo.saved[0].Deliveries[0]
+			if delivery.Error != tt.err {
+				t.Errorf("delivery error = %q, want %q", delivery.Error, tt.err)
+			}
+		})
+	}
+}
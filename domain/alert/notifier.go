@@ -0,0 +1,12 @@
+package alert
+
+import (
+	"context"
+)
+
+// Notifier delivers triggered alerts over one outbound channel. The inbox needs no
+// notifier: a trigger is in the inbox as soon as it is recorded.
+type Notifier interface {
+	Channel() Channel
+	Notify(ctx context.Context, a *Alert, t *Trigger) error
+}
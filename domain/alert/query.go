@@ -0,0 +1,24 @@
+package alert
+
+type GetAlertByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserAlertsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetTriggersQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+	Limit  int    `json:"limit"`
+	Offset int    `json:"offset"`
+}
+
+type GetInboxQuery struct {
+	UserID     string `json:"userId" validate:"required"`
+	UnreadOnly bool   `json:"unreadOnly"`
+	Limit      int    `json:"limit"`
+	Offset     int    `json:"offset"`
+}
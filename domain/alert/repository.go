@@ -0,0 +1,27 @@
+package alert
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, alert *Alert) error
+	Update(ctx context.Context, alert *Alert) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Alert, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Alert, error)
+	// GetEnabledPriceAlerts returns the enabled price alerts on the given definitions,
+	// or on any definition when definitionIDs is empty.
+	GetEnabledPriceAlerts(ctx context.Context, definitionIDs []uuid.UUID) ([]*Alert, error)
+	GetEnabledPortfolioAlerts(ctx context.Context) ([]*Alert, error)
+	// SaveEvaluation stores the state of the alert and the trigger it fired, if any, together.
+	SaveEvaluation(ctx context.Context, alert *Alert, trigger *Trigger) error
+	GetTriggers(ctx context.Context, alertID uuid.UUID, limit, offset int) ([]*Trigger, error)
+	GetInbox(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*Trigger, error)
+	CountUnread(ctx context.Context, userID uuid.UUID) (int, error)
+	GetTriggerByID(ctx context.Context, id uuid.UUID) (*Trigger, error)
+	// MarkRead marks the given inbox entry of the user as read, or all of them when triggerID is nil.
+	MarkRead(ctx context.Context, userID uuid.UUID, triggerID *uuid.UUID) (int, error)
+}
@@ -0,0 +1,68 @@
+package alert
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type DeliveryStatus string
+
+const (
+	Delivered DeliveryStatus = "DELIVERED"
+	Failed    DeliveryStatus = "FAILED"
+)
+
+// Delivery is the outcome of sending a trigger over one channel.
+type Delivery struct {
+	Channel     Channel        `json:"channel"`
+	Status      DeliveryStatus `json:"status"`
+	Error       string         `json:"error,omitempty"`
+	AttemptedAt time.Time      `json:"attemptedAt"`
+}
+
+// Trigger records an alert firing. Triggers of alerts delivered to the inbox make up
+// the user's inbox, where they stay unread until ReadAt is set.
+type Trigger struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	AlertID     uuid.UUID  `json:"alertId" db:"alert_id"`
+	UserID      uuid.UUID  `json:"userId" db:"user_id"`
+	Kind        Kind       `json:"kind" db:"kind"`
+	Value       float64    `json:"value" db:"value"`
+	Threshold   float64    `json:"threshold" db:"threshold"`
+	Message     string     `json:"message" db:"message"`
+	Inbox       bool       `json:"inbox" db:"inbox"`
+	Deliveries  []Delivery `json:"deliveries" db:"-"`
+	TriggeredAt time.Time  `json:"triggeredAt" db:"triggered_at"`
+	ReadAt      *time.Time `json:"readAt,omitempty" db:"read_at"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+}
+
+func newTrigger(a *Alert, value float64, message string, at time.Time) *Trigger {
+	return &Trigger{
+		ID:          uuid.New(),
+		AlertID:     a.ID,
+		UserID:      a.UserID,
+		Kind:        a.Kind,
+		Value:       value,
+		Threshold:   a.Threshold,
+		Message:     message,
+		Inbox:       a.HasChannel(Inbox),
+		Deliveries:  []Delivery{},
+		TriggeredAt: at,
+		CreatedAt:   time.Now(),
+	}
+}
+
+func (t *Trigger) record(channel Channel, err error) {
+	delivery := Delivery{
+		Channel:     channel,
+		Status:      Delivered,
+		AttemptedAt: time.Now(),
+	}
+	if err != nil {
+		delivery.Status = Failed
+		delivery.Error = err.Error()
+	}
+	t.Deliveries = append(t.Deliveries, delivery)
+}
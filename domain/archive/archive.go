@@ -0,0 +1,79 @@
+package archive
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/user"
+)
+
+// Version is the layout version of the archive, raised whenever a table or column changes
+// in a way an import must know about.
+const Version = 1
+
+// Tables lists the tables of an archive in the order they depend on each other: every
+// table only refers to tables listed before it. Definitions are the shared ones the
+// user's holdings refer to.
+var Tables = []string{
+	"definitions",
+	"accounts",
+	"assets",
+	"transactions",
+	"lots",
+	"lot_disposals",
+	"portfolio_snapshots",
+	"allocation_targets",
+	"recurring_rules",
+	"recurring_occurrences",
+	"term_deposits",
+	"bonds",
+	"income",
+	"loans",
+	"loan_payments",
+	"receivables",
+	"receivable_repayments",
+	"properties",
+	"property_appraisals",
+	"property_expenses",
+	"statement_accounts",
+	"statement_transactions",
+	"alerts",
+	"alert_triggers",
+	"webhook_subscriptions",
+}
+
+// Record is one row of a table keyed by column name.
+type Record map[string]interface{}
+
+// Text returns the value of the column as text, empty when it is missing or null.
+func (r Record) Text(column string) string {
+	return stringValue(r[column])
+}
+
+// Archive is everything a user owns: the profile and the rows of every table, as stored.
+type Archive struct {
+	Version    int                 `json:"version"`
+	ExportedAt time.Time           `json:"exportedAt"`
+	Profile    *user.User          `json:"profile"`
+	Tables     map[string][]Record `json:"tables"`
+	// Columns keeps the column order of every table for the CSV files
+	Columns map[string][]string `json:"-"`
+}
+
+func NewArchive(profile *user.User) *Archive {
+	return &Archive{
+		Version:    Version,
+		ExportedAt: time.Now(),
+		Profile:    profile,
+		Tables:     make(map[string][]Record),
+		Columns:    make(map[string][]string),
+	}
+}
+
+// Count is the number of rows in the archive.
+func (a *Archive) Count() int {
+	count := 0
+	for _, records := range a.Tables {
+		count += len(records)
+	}
+	return count
+}
@@ -0,0 +1,18 @@
+package archive
+
+type ExportCommand struct {
+	UserID string `json:"userId" validate:"required"`
+	// Async queues the export even when it is small enough to stream right away
+	Async bool `json:"async"`
+}
+
+type ProcessExportsCommand struct{}
+
+// RestoreCommand restores an archive into the account of the user. Every row gets a new
+// ID, so the same archive can be restored next to the data it was exported from.
+type RestoreCommand struct {
+	UserID string `json:"userId" validate:"required"`
+	Data   []byte `json:"-"`
+	// Mode is MERGE or REPLACE, MERGE when empty
+	Mode Mode `json:"mode"`
+}
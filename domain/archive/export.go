@@ -0,0 +1,78 @@
+package archive
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Status string
+
+const (
+	Pending Status = "PENDING"
+	Running Status = "RUNNING"
+	Ready   Status = "READY"
+	Failed  Status = "FAILED"
+)
+
+// Export is an archive generated in the background for a user with too much data to
+// stream in one request. The file is kept until ExpiresAt.
+type Export struct {
+	ID          uuid.UUID  `json:"id" db:"id"`
+	UserID      uuid.UUID  `json:"userId" db:"user_id"`
+	Status      Status     `json:"status" db:"status"`
+	FileName    string     `json:"fileName" db:"file_name"`
+	Size        int64      `json:"size" db:"size"`
+	Records     int        `json:"records" db:"records"`
+	Error       string     `json:"error,omitempty" db:"error"`
+	StartedAt   *time.Time `json:"startedAt,omitempty" db:"started_at"`
+	CompletedAt *time.Time `json:"completedAt,omitempty" db:"completed_at"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty" db:"expires_at"`
+	CreatedAt   time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func NewExport(userID uuid.UUID) *Export {
+	now := time.Now()
+	return &Export{
+		ID:        uuid.New(),
+		UserID:    userID,
+		Status:    Pending,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
+
+// IsActive reports whether the export is still waiting for or being generated.
+func (e *Export) IsActive() bool {
+	return e.Status == Pending || e.Status == Running
+}
+
+// IsAvailable reports whether the file can be downloaded at t.
+func (e *Export) IsAvailable(t time.Time) bool {
+	return e.Status == Ready && (e.ExpiresAt == nil || t.Before(*e.ExpiresAt))
+}
+
+func (e *Export) Complete(archive *Archive, size int64, ttl time.Duration) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	e.Status = Ready
+	e.FileName = archive.FileName()
+	e.Size = size
+	e.Records = archive.Count()
+	e.Error = ""
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	e.UpdatedAt = now
+}
+
+// Fail records why the export could not be generated; the record expires like a file would.
+func (e *Export) Fail(err error, ttl time.Duration) {
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	e.Status = Failed
+	e.Error = err.Error()
+	e.CompletedAt = &now
+	e.ExpiresAt = &expiresAt
+	e.UpdatedAt = now
+}
@@ -0,0 +1,243 @@
+package archive
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/user"
+)
+
+// staleAfter is how long an export may stay running before it is assumed abandoned,
+// for example by a restart, and generated again.
+const staleAfter = time.Hour
+
+type Handler struct {
+	repo     Repository
+	userRepo user.Repository
+	storage  Storage
+	// inlineLimit is the number of rows up to which an export is built within the request
+	inlineLimit int
+	ttl         time.Duration
+	// restoreLimit is the largest export.json in bytes a restored ZIP may unpack to
+	restoreLimit int64
+}
+
+func NewHandler(repo Repository, userRepo user.Repository, storage Storage, inlineLimit int, ttl time.Duration, restoreLimit int64) *Handler {
+	return &Handler{
+		repo:         repo,
+		userRepo:     userRepo,
+		storage:      storage,
+		inlineLimit:  inlineLimit,
+		ttl:          ttl,
+		restoreLimit: restoreLimit,
+	}
+}
+
+// ExportResult holds the archive when it was small enough to build right away, and the
+// queued export otherwise.
+type ExportResult struct {
+	Archive *Archive
+	Export  *Export
+}
+
+func (h *Handler) HandleExportCommand(ctx context.Context, command ExportCommand) (*ExportResult, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	profile, err := h.userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	if !command.Async {
+		count, err := h.repo.CountRecords(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+		if count <= h.inlineLimit {
+			archive := NewArchive(profile)
+			if err := h.repo.Collect(ctx, archive); err != nil {
+				return nil, err
+			}
+			return &ExportResult{Archive: archive}, nil
+		}
+	}
+
+	exports, err := h.repo.GetExportsByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, export := range exports {
+		if export.IsActive() {
+			return &ExportResult{Export: export}, nil
+		}
+	}
+
+	export := NewExport(userID)
+	if err := h.repo.CreateExport(ctx, export); err != nil {
+		return nil, err
+	}
+	return &ExportResult{Export: export}, nil
+}
+
+func (h *Handler) HandleRestoreCommand(ctx context.Context, command RestoreCommand) (*RestoreResult, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if len(command.Data) == 0 {
+		return nil, errors.New("archive is required")
+	}
+	if command.Mode == "" {
+		command.Mode = Merge
+	}
+	if !command.Mode.IsValid() {
+		return nil, errors.New("invalid mode, expected MERGE or REPLACE")
+	}
+	if _, err := h.userRepo.GetByID(ctx, userID); err != nil {
+		return nil, errors.New("user not found")
+	}
+
+	archive, err := ReadArchive(command.Data, h.restoreLimit)
+	if err != nil {
+		return nil, err
+	}
+	return h.repo.Restore(ctx, userID, archive, command.Mode)
+}
+
+// HandleProcessExportsCommand removes expired exports and generates the pending ones,
+// returning how many were generated.
+func (h *Handler) HandleProcessExportsCommand(ctx context.Context, command ProcessExportsCommand) (int, error) {
+	now := time.Now()
+	expired, err := h.repo.DeleteExpired(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	for _, export := range expired {
+		if err := h.storage.Remove(export.ID); err != nil {
+			return 0, err
+		}
+	}
+	if _, err := h.storage.Prune(now.Add(-h.ttl)); err != nil {
+		return 0, err
+	}
+
+	processed := 0
+	for ctx.Err() == nil {
+		export, err := h.repo.ClaimPending(ctx, time.Now().Add(-staleAfter))
+		if err != nil {
+			return processed, err
+		}
+		if export == nil {
+			break
+		}
+
+		if err := h.generate(ctx, export); err != nil {
+			export.Fail(err, h.ttl)
+		}
+		if err := h.repo.UpdateExport(ctx, export); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, ctx.Err()
+}
+
+func (h *Handler) HandleGetExportByIDQuery(ctx context.Context, query GetExportByIDQuery) (*Export, error) {
+	return h.ownedExport(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserExportsQuery(ctx context.Context, query GetUserExportsQuery) ([]*Export, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetExportsByUserID(ctx, userID)
+}
+
+// HandleOpenExportQuery opens the file of a ready export for download. The caller closes it.
+func (h *Handler) HandleOpenExportQuery(ctx context.Context, query OpenExportQuery) (*Export, io.ReadCloser, error) {
+	export, err := h.ownedExport(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if export.Status != Ready {
+		return nil, nil, errors.New("export is not ready")
+	}
+	if !export.IsAvailable(time.Now()) {
+		return nil, nil, errors.New("export has expired")
+	}
+
+	file, err := h.storage.Open(export.ID)
+	if err != nil {
+		return nil, nil, errors.New("export file not found")
+	}
+	return export, file, nil
+}
+
+func (h *Handler) generate(ctx context.Context, export *Export) error {
+	profile, err := h.userRepo.GetByID(ctx, export.UserID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+	archive := NewArchive(profile)
+	if err := h.repo.Collect(ctx, archive); err != nil {
+		return err
+	}
+
+	file, err := h.storage.Create(export.ID)
+	if err != nil {
+		return err
+	}
+	counter := &countingWriter{w: file}
+	err = archive.WriteZip(counter)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		h.storage.Remove(export.ID)
+		return err
+	}
+
+	export.Complete(archive, counter.n, h.ttl)
+	return nil
+}
+
+func (h *Handler) ownedExport(ctx context.Context, exportIDValue, userIDValue string) (*Export, error) {
+	exportID, err := uuid.Parse(exportIDValue)
+	if err != nil {
+		return nil, errors.New("invalid export ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	export, err := h.repo.GetExportByID(ctx, exportID)
+	if err != nil {
+		return nil, errors.New("export not found")
+	}
+
+	if export.UserID != userID {
+		return nil, errors.New("unauthorized: export does not belong to user")
+	}
+
+	return export, nil
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
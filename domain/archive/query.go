@@ -0,0 +1,15 @@
+package archive
+
+type GetExportByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserExportsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type OpenExportQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
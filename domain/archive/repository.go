@@ -0,0 +1,40 @@
+package archive
+
+import (
+	"context"
+	"io"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Collect reads every table of the user into the archive.
+	Collect(ctx context.Context, archive *Archive) error
+	// CountRecords returns how many rows Collect would read for the user.
+	CountRecords(ctx context.Context, userID uuid.UUID) (int, error)
+	// Restore writes the tables of the archive for the user in one database transaction,
+	// giving every row a new ID and pointing references at the new IDs. Definitions are
+	// matched by abbreviation and created for the user alone when missing. In REPLACE
+	// mode the rows the user has are deleted first.
+	Restore(ctx context.Context, userID uuid.UUID, archive *Archive, mode Mode) (*RestoreResult, error)
+
+	CreateExport(ctx context.Context, export *Export) error
+	GetExportByID(ctx context.Context, id uuid.UUID) (*Export, error)
+	GetExportsByUserID(ctx context.Context, userID uuid.UUID) ([]*Export, error)
+	UpdateExport(ctx context.Context, export *Export) error
+	// ClaimPending marks the oldest pending export as running and returns it, or nil when
+	// none is waiting. Exports left running since before staleBefore are claimed again.
+	ClaimPending(ctx context.Context, staleBefore time.Time) (*Export, error)
+	// DeleteExpired removes the exports that expired before t and returns them.
+	DeleteExpired(ctx context.Context, t time.Time) ([]*Export, error)
+}
+
+// Storage keeps generated archive files until they expire.
+type Storage interface {
+	Create(id uuid.UUID) (io.WriteCloser, error)
+	Open(id uuid.UUID) (io.ReadCloser, error)
+	Remove(id uuid.UUID) error
+	// Prune removes files written before t, including those of exports deleted with their user.
+	Prune(t time.Time) (int, error)
+}
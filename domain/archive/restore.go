@@ -0,0 +1,169 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+type Mode string
+
+const (
+	// Merge adds the archive next to what the user already has
+	Merge Mode = "MERGE"
+	// Replace removes everything the user has before restoring the archive
+	Replace Mode = "REPLACE"
+)
+
+func (m Mode) IsValid() bool {
+	return m == Merge || m == Replace
+}
+
+// TableCount reports how many rows of a table were restored, and how many were left out
+// because the user already had an equivalent row, such as a snapshot of the same day.
+type TableCount struct {
+	Table    string `json:"table"`
+	Restored int    `json:"restored"`
+	Skipped  int    `json:"skipped"`
+}
+
+type RestoreResult struct {
+	Mode Mode `json:"mode"`
+	// DefinitionsMatched and DefinitionsCreated count how the definitions of the archive
+	// were resolved by abbreviation; created definitions belong to the user alone
+	DefinitionsMatched int           `json:"definitionsMatched"`
+	DefinitionsCreated int           `json:"definitionsCreated"`
+	Tables             []*TableCount `json:"tables"`
+	// Secrets holds the signing secrets generated for the restored webhooks and alert
+	// webhooks. They are not shown again, so this is the only chance to copy them.
+	Secrets []*RestoredSecret `json:"secrets"`
+}
+
+// RestoredSecret is the new signing secret of a restored row.
+type RestoredSecret struct {
+	Table  string    `json:"table"`
+	ID     uuid.UUID `json:"id"`
+	Secret string    `json:"secret"`
+}
+
+// ReadArchive reads an archive from its export.json, either on its own or inside the ZIP
+// written by WriteZip. The export.json of a ZIP may unpack to at most limit bytes, so a
+// small upload cannot expand into more than the restore is meant to hold.
+func ReadArchive(data []byte, limit int64) (*Archive, error) {
+	if bytes.HasPrefix(data, []byte("PK")) {
+		reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ZIP: %w", err)
+		}
+		if data, err = readEntry(reader, "export.json", limit); err != nil {
+			return nil, err
+		}
+	}
+
+	var archive Archive
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	// Numbers stay as written so decimals keep their precision
+	decoder.UseNumber()
+	if err := decoder.Decode(&archive); err != nil {
+		return nil, fmt.Errorf("invalid archive: %w", err)
+	}
+
+	if archive.Version == 0 || archive.Tables == nil {
+		return nil, errors.New("invalid archive: not a wallet export")
+	}
+	if archive.Version > Version {
+		return nil, fmt.Errorf("archive version %d is newer than the supported version %d", archive.Version, Version)
+	}
+	known := make(map[string]bool, len(Tables))
+	for _, table := range Tables {
+		known[table] = true
+	}
+	for table := range archive.Tables {
+		if !known[table] {
+			return nil, fmt.Errorf("archive has unknown table %q", table)
+		}
+	}
+	return &archive, nil
+}
+
+func readEntry(reader *zip.Reader, name string, limit int64) ([]byte, error) {
+	for _, entry := range reader.File {
+		if entry.Name != name {
+			continue
+		}
+		tooLarge := fmt.Errorf("%s is larger than %d bytes", name, limit)
+		// The size in the header is only a claim, so the read is limited as well
+		if entry.UncompressedSize64 > uint64(limit) {
+			return nil, tooLarge
+		}
+		file, err := entry.Open()
+		if err != nil {
+			return nil, fmt.Errorf("invalid ZIP: %w", err)
+		}
+		defer file.Close()
+		data, err := io.ReadAll(io.LimitReader(file, limit+1))
+		if err != nil {
+			return nil, fmt.Errorf("invalid ZIP: %w", err)
+		}
+		if int64(len(data)) > limit {
+			return nil, tooLarge
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("ZIP has no %s", name)
+}
+
+// RestoreValue converts an archived value for the column back to one that can be
+// written. The user_id column becomes userID, and the id column and every other *_id
+// column is mapped through ids, where an identifier seen for the first time gets a new
+// one. Decimals stay exact and JSON values are written back as JSON text.
+func RestoreValue(column string, value interface{}, userID uuid.UUID, ids map[uuid.UUID]uuid.UUID) (interface{}, error) {
+	if value == nil {
+		return nil, nil
+	}
+	switch {
+	case column == "user_id":
+		return userID, nil
+	case column == "id" || strings.HasSuffix(column, "_id"):
+		oldID, err := uuid.Parse(stringValue(value))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s %v", column, value)
+		}
+		id, ok := ids[oldID]
+		if !ok {
+			id = uuid.New()
+			ids[oldID] = id
+		}
+		return id, nil
+	}
+
+	switch v := value.(type) {
+	case json.Number:
+		return v.String(), nil
+	case map[string]interface{}, []interface{}:
+		raw, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(raw), nil
+	default:
+		return v, nil
+	}
+}
+
+func stringValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	default:
+		return fmt.Sprint(v)
+	}
+}
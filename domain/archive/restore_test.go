@@ -0,0 +1,167 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"hash/crc32"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+const exportJSON = `{"version":1,"exportedAt":"2024-03-15T10:00:00Z","tables":{"assets":[{"id":"6f1c3c53-1f35-4a55-9a53-3f5f7d3c0d11","quantity":0.10000000000000001}]}}`
+
+func zipOf(t *testing.T, name, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	file, err := w.Create(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+// understatedZip stores content under a header claiming it is a single byte.
+func understatedZip(t *testing.T, content string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	file, err := w.CreateRaw(&zip.FileHeader{
+		Name:               "export.json",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE([]byte(content)),
+		CompressedSize64:   uint64(len(content)),
+		UncompressedSize64: 1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := file.Write([]byte(content)); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestReadArchive(t *testing.T) {
+	written := NewArchive(nil)
+	written.Tables["accounts"] = []Record{{"id": "0b3f9f4e-8d5c-4c39-9d4f-2a4b1f6e7a10", "name": "Cash"}}
+	written.Columns["accounts"] = []string{"id", "name"}
+	var exported bytes.Buffer
+	if err := written.WriteZip(&exported); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name  string
+		data  []byte
+		limit int64
+		table string
+		err   string
+	}{
+		{name: "plain export.json", data: []byte(exportJSON), limit: 1 << 20, table: "assets"},
+		{name: "export ZIP", data: exported.Bytes(), limit: 1 << 20, table: "accounts"},
+		{name: "ZIP without export.json", data: zipOf(t, "data.json", exportJSON), limit: 1 << 20, err: "ZIP has no export.json"},
+		{name: "export.json over the limit", data: zipOf(t, "export.json", exportJSON), limit: 64, err: "export.json is larger than 64 bytes"},
+		{name: "size in the header understated", data: understatedZip(t, exportJSON), limit: 64, err: "invalid ZIP"},
+		{name: "not JSON", data: []byte("symbol,price"), limit: 1 << 20, err: "invalid archive"},
+		{name: "not an export", data: []byte(`{"name":"wallet"}`), limit: 1 << 20, err: "invalid archive: not a wallet export"},
+		{name: "newer version", data: []byte(`{"version":2,"tables":{}}`), limit: 1 << 20, err: "archive version 2 is newer than the supported version 1"},
+		{name: "unknown table", data: []byte(`{"version":1,"tables":{"users":[]}}`), limit: 1 << 20, err: `archive has unknown table "users"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			archive, err := ReadArchive(tt.data, tt.limit)
+			if tt.err != "" {
+				if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+					t.Fatalf("ReadArchive error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ReadArchive: %v", err)
+			}
+			if len(archive.Tables[tt.table]) != 1 {
+				t.Errorf("archive has %d %s, want 1", len(archive.Tables[tt.table]), tt.table)
+			}
+		})
+	}
+}
+
+func TestReadArchiveKeepsDecimals(t *testing.T) {
+	archive, err := ReadArchive([]byte(exportJSON), 1<<20)
+	if err != nil {
+		t.Fatalf("ReadArchive: %v", err)
+	}
+	if got := archive.Tables["assets"][0]["quantity"]; got != json.Number("0.10000000000000001") {
+		t.Errorf("quantity = %#v, want the number as written", got)
+	}
+}
+
+func TestRestoreValue(t *testing.T) {
+	userID := uuid.New()
+	known, mapped := uuid.New(), uuid.New()
+	ids := map[uuid.UUID]uuid.UUID{known: mapped}
+	fresh := uuid.New()
+
+	tests := []struct {
+		name   string
+		column string
+		value  interface{}
+		want   interface{}
+		err    string
+	}{
+		{name: "null stays null", column: "asset_id", value: nil, want: nil},
+		{name: "owner becomes the user", column: "user_id", value: uuid.NewString(), want: userID},
+		{name: "known id is remapped", column: "id", value: known.String(), want: mapped},
+		{name: "reference to a known id is remapped", column: "asset_id", value: known.String(), want: mapped},
+		{name: "invalid reference", column: "asset_id", value: "cash", err: "invalid asset_id cash"},
+		{name: "decimal keeps its digits", column: "quantity", value: json.Number("0.10000000000000001"), want: "0.10000000000000001"},
+		{name: "object becomes JSON", column: "channels", value: map[string]interface{}{"inbox": true}, want: `{"inbox":true}`},
+		{name: "array becomes JSON", column: "event_types", value: []interface{}{"*"}, want: `["*"]`},
+		{name: "text is kept", column: "name", value: "Cash", want: "Cash"},
+		{name: "column ending in id without an underscore is kept", column: "fitid", value: "20240315001", want: "20240315001"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RestoreValue(tt.column, tt.value, userID, ids)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("RestoreValue error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RestoreValue: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("RestoreValue = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("new id is generated once", func(t *testing.T) {
+		first, err := RestoreValue("id", fresh.String(), userID, ids)
+		if err != nil {
+			t.Fatalf("RestoreValue: %v", err)
+		}
+		again, err := RestoreValue("transaction_id", fresh.String(), userID, ids)
+		if err != nil {
+			t.Fatalf("RestoreValue: %v", err)
+		}
+		if first == fresh || first != again || ids[fresh] != first {
+			t.Errorf("id %v restored as %v and referenced as %v, want one new id", fresh, first, again)
+		}
+	})
+}
@@ -0,0 +1,93 @@
+package archive
+
+import (
+	"archive/zip"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// FileName is the name offered for the download of an archive.
+func (a *Archive) FileName() string {
+	return fmt.Sprintf("wallet-export-%s.zip", a.ExportedAt.Format("20060102-150405"))
+}
+
+// WriteZip writes the archive as a ZIP holding the whole archive as export.json and a
+// CSV file per table under csv/.
+func (a *Archive) WriteZip(w io.Writer) error {
+	archive := zip.NewWriter(w)
+
+	file, err := archive.Create("export.json")
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(file)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(a); err != nil {
+		return err
+	}
+
+	if a.Profile != nil {
+		profile := Record{
+			"id":         a.Profile.ID,
+			"email":      a.Profile.Email,
+			"first_name": a.Profile.FirstName,
+			"last_name":  a.Profile.LastName,
+			"created_at": a.Profile.CreatedAt,
+			"updated_at": a.Profile.UpdatedAt,
+		}
+		columns := []string{"id", "email", "first_name", "last_name", "created_at", "updated_at"}
+		if err := writeCSV(archive, "profile", columns, []Record{profile}); err != nil {
+			return err
+		}
+	}
+	for _, table := range Tables {
+		if err := writeCSV(archive, table, a.Columns[table], a.Tables[table]); err != nil {
+			return err
+		}
+	}
+
+	return archive.Close()
+}
+
+func writeCSV(archive *zip.Writer, table string, columns []string, records []Record) error {
+	file, err := archive.Create("csv/" + table + ".csv")
+	if err != nil {
+		return err
+	}
+	writer := csv.NewWriter(file)
+	if err := writer.Write(columns); err != nil {
+		return err
+	}
+	line := make([]string, len(columns))
+	for _, record := range records {
+		for i, column := range columns {
+			line[i] = csvValue(record[column])
+		}
+		if err := writer.Write(line); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func csvValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.Format(time.RFC3339)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case json.RawMessage:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
@@ -29,32 +29,93 @@ const (
 	Other         AssetType = "OTHER"
 )
 
+// IsLiability reports whether holdings of this type are owed rather than owned.
+func (t AssetType) IsLiability() bool {
+	return t == Debt
+}
+
+func (t AssetType) IsValid() bool {
+	return isValidAssetType(t)
+}
+
+// CostBasisMethod decides which lots are relieved when the quantity of an asset is reduced.
+type CostBasisMethod string
+
+const (
+	FIFO        CostBasisMethod = "FIFO"
+	LIFO        CostBasisMethod = "LIFO"
+	HighestCost CostBasisMethod = "HIGHEST_COST"
+	// AverageCost needs every open lot in one currency
+	AverageCost CostBasisMethod = "AVERAGE"
+)
+
 type Asset struct {
-	ID           uuid.UUID `json:"id" db:"id"`
-	UserID       uuid.UUID `json:"userId" db:"user_id"`
-	AccountID    uuid.UUID `json:"accountId" db:"account_id"`
-	DefinitionID uuid.UUID `json:"definitionId" db:"definition_id"`
-	Type         AssetType `json:"type" db:"type"`
-	Quantity     float64   `json:"quantity" db:"quantity"`
-	Notes        string    `json:"notes" db:"notes"`
-	PurchaseDate time.Time `json:"purchaseDate" db:"purchase_date"`
-	CreatedAt    time.Time `json:"createdAt" db:"created_at"`
-	UpdatedAt    time.Time `json:"updatedAt" db:"updated_at"`
+	ID               uuid.UUID       `json:"id" db:"id"`
+	UserID           uuid.UUID       `json:"userId" db:"user_id"`
+	AccountID        uuid.UUID       `json:"accountId" db:"account_id"`
+	DefinitionID     uuid.UUID       `json:"definitionId" db:"definition_id"`
+	Type             AssetType       `json:"type" db:"type"`
+	Quantity         float64         `json:"quantity" db:"quantity"`
+	Notes            string          `json:"notes" db:"notes"`
+	PurchaseDate     time.Time       `json:"purchaseDate" db:"purchase_date"`
+	PurchasePrice    float64         `json:"purchasePrice" db:"purchase_price"`
+	PurchaseCurrency string          `json:"purchaseCurrency" db:"purchase_currency"`
+	CostBasisMethod  CostBasisMethod `json:"costBasisMethod" db:"cost_basis_method"`
+	CreatedAt        time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt        time.Time       `json:"updatedAt" db:"updated_at"`
 }
 
 func NewAsset(command CreateAssetCommand) *Asset {
 	now := time.Now()
 	purchaseDate := time.Unix(command.PurchaseDate, 0)
+	costBasisMethod := command.CostBasisMethod
+	if costBasisMethod == "" {
+		costBasisMethod = FIFO
+	}
 	return &Asset{
-		ID:           uuid.New(),
-		UserID:       uuid.MustParse(command.UserID),
-		AccountID:    uuid.MustParse(command.AccountID),
-		DefinitionID: uuid.MustParse(command.DefinitionID),
-		Type:         command.Type,
-		Quantity:     command.Quantity,
-		Notes:        command.Notes,
-		PurchaseDate: purchaseDate,
-		CreatedAt:    now,
-		UpdatedAt:    now,
+		ID:               uuid.New(),
+		UserID:           uuid.MustParse(command.UserID),
+		AccountID:        uuid.MustParse(command.AccountID),
+		DefinitionID:     uuid.MustParse(command.DefinitionID),
+		Type:             command.Type,
+		Quantity:         command.Quantity,
+		Notes:            command.Notes,
+		PurchaseDate:     purchaseDate,
+		PurchasePrice:    command.PurchasePrice,
+		PurchaseCurrency: command.PurchaseCurrency,
+		CostBasisMethod:  costBasisMethod,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+}
+
+// Transfer moves a quantity of one asset into the asset holding the same definition
+// in another account of the same user. Fee is charged on the source asset on top of Quantity.
+type Transfer struct {
+	ID              uuid.UUID `json:"id"`
+	UserID          uuid.UUID `json:"userId"`
+	SourceAssetID   uuid.UUID `json:"sourceAssetId"`
+	TargetAccountID uuid.UUID `json:"targetAccountId"`
+	TargetAssetID   uuid.UUID `json:"targetAssetId"`
+	DefinitionID    uuid.UUID `json:"definitionId"`
+	Type            AssetType `json:"type"`
+	Quantity        float64   `json:"quantity"`
+	Fee             float64   `json:"fee"`
+	Notes           string    `json:"notes"`
+	TransferDate    time.Time `json:"transferDate"`
+}
+
+func NewTransfer(command TransferAssetCommand, source *Asset, targetAccountID uuid.UUID) *Transfer {
+	return &Transfer{
+		ID:              uuid.New(),
+		UserID:          source.UserID,
+		SourceAssetID:   source.ID,
+		TargetAccountID: targetAccountID,
+		DefinitionID:    source.DefinitionID,
+		Type:            source.Type,
+		Quantity:        command.Quantity,
+		Fee:             command.Fee,
+		Notes:           command.Notes,
+		TransferDate:    time.Unix(command.TransferDate, 0),
 	}
 }
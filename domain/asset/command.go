@@ -1,27 +1,40 @@
 package asset
 
 type CreateAssetCommand struct {
-	UserID       string    `json:"userId" validate:"required"`
-	AccountID    string    `json:"accountId" validate:"required"`
-	DefinitionID string    `json:"definitionId" validate:"required"`
-	Type         AssetType `json:"type" validate:"required"`
-	Quantity     float64   `json:"quantity" validate:"required"`
-	Notes        string    `json:"notes"`
-	PurchaseDate int64     `json:"purchaseDate" validate:"required"`
+	UserID           string          `json:"userId" validate:"required"`
+	AccountID        string          `json:"accountId" validate:"required"`
+	DefinitionID     string          `json:"definitionId" validate:"required"`
+	Type             AssetType       `json:"type" validate:"required"`
+	Quantity         float64         `json:"quantity" validate:"required"`
+	Notes            string          `json:"notes"`
+	PurchaseDate     int64           `json:"purchaseDate" validate:"required"`
+	PurchasePrice    float64         `json:"purchasePrice"`
+	PurchaseCurrency string          `json:"purchaseCurrency"`
+	CostBasisMethod  CostBasisMethod `json:"costBasisMethod"`
 }
 
 type UpdateAssetCommand struct {
-	ID           string    `json:"id" validate:"required"`
-	UserID       string    `json:"userId" validate:"required"`
-	AccountID    string    `json:"accountId" validate:"required"`
-	DefinitionID string    `json:"definitionId" validate:"required"`
-	Type         AssetType `json:"type" validate:"required"`
-	Quantity     float64   `json:"quantity" validate:"required"`
-	Notes        string    `json:"notes"`
-	PurchaseDate int64     `json:"purchaseDate" validate:"required"`
+	ID              string          `json:"id" validate:"required"`
+	UserID          string          `json:"userId" validate:"required"`
+	AccountID       string          `json:"accountId" validate:"required"`
+	DefinitionID    string          `json:"definitionId" validate:"required"`
+	Type            AssetType       `json:"type" validate:"required"`
+	Notes           string          `json:"notes"`
+	PurchaseDate    int64           `json:"purchaseDate" validate:"required"`
+	CostBasisMethod CostBasisMethod `json:"costBasisMethod"`
 }
 
 type DeleteAssetCommand struct {
 	ID     string `json:"id" validate:"required"`
 	UserID string `json:"userId" validate:"required"`
 }
+
+type TransferAssetCommand struct {
+	UserID          string  `json:"userId" validate:"required"`
+	SourceAssetID   string  `json:"sourceAssetId" validate:"required"`
+	TargetAccountID string  `json:"targetAccountId" validate:"required"`
+	Quantity        float64 `json:"quantity" validate:"required"`
+	Fee             float64 `json:"fee"`
+	Notes           string  `json:"notes"`
+	TransferDate    int64   `json:"transferDate" validate:"required"`
+}
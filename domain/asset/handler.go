@@ -6,27 +6,30 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/fx"
 )
 
-type AssetPerformance struct {
-	AssetID        uuid.UUID `json:"assetId"`
-	Name           string    `json:"name"`
-	Symbol         string    `json:"symbol"`
-	Type           AssetType `json:"type"`
-	InitialValue   float64   `json:"initialValue"`
-	CurrentValue   float64   `json:"currentValue"`
-	ProfitLoss     float64   `json:"profitLoss"`
-	ProfitLossPerc float64   `json:"profitLossPercentage"`
-	Currency       string    `json:"currency"`
+type Handler struct {
+	event.Publisher
+	repo        Repository
+	accountRepo account.Repository
+	fxService   *fx.Handler
 }
 
-type Handler struct {
-	repo Repository
+type TransferResult struct {
+	Transfer    *Transfer `json:"transfer"`
+	SourceAsset *Asset    `json:"sourceAsset"`
+	TargetAsset *Asset    `json:"targetAsset"`
 }
 
-func NewHandler(repo Repository) *Handler {
+func NewHandler(repo Repository, accountRepo account.Repository, fxService *fx.Handler) *Handler {
 	return &Handler{
-		repo: repo,
+		repo:        repo,
+		accountRepo: accountRepo,
+		fxService:   fxService,
 	}
 }
 
@@ -38,10 +41,17 @@ func (s *Handler) HandleCreateAssetCommand(
 	if command.Quantity <= 0 {
 		return nil, errors.New("quantity must be greater than zero")
 	}
+	if command.PurchasePrice < 0 {
+		return nil, errors.New("purchase price must not be negative")
+	}
+	if command.CostBasisMethod != "" && !isValidCostBasisMethod(command.CostBasisMethod) {
+		return nil, errors.New("invalid cost basis method")
+	}
 	asset := NewAsset(command)
 	if err := s.repo.Create(ctx, asset); err != nil {
 		return nil, err
 	}
+	s.Publish(ctx, event.AssetCreated, asset.UserID, asset)
 	return asset, nil
 }
 
@@ -49,8 +59,8 @@ func (s *Handler) HandleUpdateAssetCommand(ctx context.Context, command UpdateAs
 	if !isValidAssetType(command.Type) {
 		return nil, errors.New("invalid asset type")
 	}
-	if command.Quantity <= 0 {
-		return nil, errors.New("quantity must be greater than zero")
+	if command.CostBasisMethod != "" && !isValidCostBasisMethod(command.CostBasisMethod) {
+		return nil, errors.New("invalid cost basis method")
 	}
 
 	assetID, err := uuid.Parse(command.ID)
@@ -74,14 +84,17 @@ func (s *Handler) HandleUpdateAssetCommand(ctx context.Context, command UpdateAs
 	existingAsset.AccountID = uuid.MustParse(command.AccountID)
 	existingAsset.DefinitionID = uuid.MustParse(command.DefinitionID)
 	existingAsset.Type = command.Type
-	existingAsset.Quantity = command.Quantity
 	existingAsset.Notes = command.Notes
 	existingAsset.PurchaseDate = time.Unix(command.PurchaseDate, 0)
+	if command.CostBasisMethod != "" {
+		existingAsset.CostBasisMethod = command.CostBasisMethod
+	}
 	existingAsset.UpdatedAt = time.Now()
 
 	if err := s.repo.Update(ctx, existingAsset); err != nil {
 		return nil, err
 	}
+	s.Publish(ctx, event.AssetUpdated, userID, existingAsset)
 
 	return existingAsset, nil
 }
@@ -106,7 +119,84 @@ func (s *Handler) HandleDeleteAssetCommand(ctx context.Context, command DeleteAs
 		return errors.New("unauthorized: asset does not belong to user")
 	}
 
-	return s.repo.Delete(ctx, assetID)
+	if err := s.repo.Delete(ctx, assetID); err != nil {
+		return err
+	}
+	s.Publish(ctx, event.AssetDeleted, userID, existingAsset)
+	return nil
+}
+
+func (s *Handler) HandleTransferAssetCommand(ctx context.Context, command TransferAssetCommand) (*TransferResult, error) {
+	if command.Quantity <= 0 {
+		return nil, errors.New("quantity must be greater than zero")
+	}
+	if command.Fee < 0 {
+		return nil, errors.New("fee must not be negative")
+	}
+
+	sourceAssetID, err := uuid.Parse(command.SourceAssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+
+	targetAccountID, err := uuid.Parse(command.TargetAccountID)
+	if err != nil {
+		return nil, errors.New("invalid account ID")
+	}
+
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	source, err := s.repo.GetByID(ctx, sourceAssetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if source.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+
+	targetAccount, err := s.accountRepo.GetByID(ctx, targetAccountID)
+	if err != nil {
+		return nil, errors.New("account not found")
+	}
+
+	if targetAccount.UserID != userID {
+		return nil, errors.New("unauthorized: account does not belong to user")
+	}
+
+	if targetAccount.ID == source.AccountID {
+		return nil, errors.New("source and target account must differ")
+	}
+
+	if source.Quantity < command.Quantity+command.Fee {
+		return nil, errors.New("insufficient quantity")
+	}
+
+	transfer := NewTransfer(command, source, targetAccount.ID)
+	if err := s.repo.Transfer(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	updatedSource, err := s.repo.GetByID(ctx, transfer.SourceAssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := s.repo.GetByID(ctx, transfer.TargetAssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &TransferResult{
+		Transfer:    transfer,
+		SourceAsset: updatedSource,
+		TargetAsset: target,
+	}
+	s.Publish(ctx, event.AssetTransferred, userID, result)
+	return result, nil
 }
 
 func (s *Handler) HandleGetAssetByIDQuery(ctx context.Context, query GetAssetByIDQuery) (*Asset, error) {
@@ -141,6 +231,31 @@ func (s *Handler) HandleGetUserAssetsQuery(ctx context.Context, query GetUserAss
 	return s.repo.GetByUserID(ctx, userID)
 }
 
+func (s *Handler) HandleGetAssetPerformanceQuery(ctx context.Context, query GetAssetPerformanceQuery) ([]*AssetPerformance, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	if query.EndDate.Before(query.StartDate) {
+		return nil, errors.New("end date must not be before start date")
+	}
+
+	performances, err := s.repo.GetAssetPerformance(ctx, userID, query.StartDate, query.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := s.fxService.Table(ctx, query.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range performances {
+		p.Calculate(table)
+	}
+	return performances, nil
+}
+
 func (s *Handler) HandleFilterAssetsQuery(ctx context.Context, query FilterAssetsQuery) ([]*Asset, error) {
 	userID, err := uuid.Parse(query.UserID)
 	if err != nil {
@@ -225,3 +340,12 @@ func isValidAssetType(t AssetType) bool {
 	}
 	return false
 }
+
+func isValidCostBasisMethod(m CostBasisMethod) bool {
+	switch m {
+	case FIFO, LIFO, HighestCost, AverageCost:
+		return true
+	default:
+		return false
+	}
+}
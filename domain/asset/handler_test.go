@@ -0,0 +1,168 @@
+package asset
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+)
+
+type fakeAssets struct {
+	Repository
+	assets    map[uuid.UUID]*Asset
+	transfers []*Transfer
+}
+
+func (f *fakeAssets) GetByID(ctx context.Context, id uuid.UUID) (*Asset, error) {
+	a, ok := f.assets[id]
+	if !ok {
+		return nil, errors.New("asset not found")
+	}
+	copied := *a
+	return &copied, nil
+}
+
+// Transfer books the transfer the way the ledger does: the source gives up the
+// quantity and the fee, and the target asset in the other account receives the quantity.
+func (f *fakeAssets) Transfer(ctx context.Context, transfer *Transfer) error {
+	source := f.assets[transfer.SourceAssetID]
+	source.Quantity -= transfer.Quantity + transfer.Fee
+	target := &Asset{ID: uuid.New(), UserID: transfer.UserID, AccountID: transfer.TargetAccountID, DefinitionID: transfer.DefinitionID, Quantity: transfer.Quantity}
+	f.assets[target.ID] = target
+	transfer.TargetAssetID = target.ID
+	f.transfers = append(f.transfers, transfer)
+	return nil
+}
+
+type fakeAccounts struct {
+	account.Repository
+	accounts map[uuid.UUID]*account.Account
+}
+
+func (f *fakeAccounts) GetByID(ctx context.Context, id uuid.UUID) (*account.Account, error) {
+	a, ok := f.accounts[id]
+	if !ok {
+		return nil, errors.New("account not found")
+	}
+	return a, nil
+}
+
+func TestHandleTransferAssetCommand(t *testing.T) {
+	userID, otherUserID := uuid.New(), uuid.New()
+	wallet, exchange, foreign := uuid.New(), uuid.New(), uuid.New()
+	btc, foreignBTC := uuid.New(), uuid.New()
+
+	tests := []struct {
+		name         string
+		command      TransferAssetCommand
+		sourceLeft   float64
+		targetGained float64
+		err          string
+	}{
+		{
+			name:         "quantity moves to the other account",
+			command:      TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 0.4},
+			sourceLeft:   0.6,
+			targetGained: 0.4,
+		},
+		{
+			name:         "fee is charged on the source on top of the quantity",
+			command:      TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 0.4, Fee: 0.1},
+			sourceLeft:   0.5,
+			targetGained: 0.4,
+		},
+		{
+			name:         "the whole holding including the fee",
+			command:      TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 0.9, Fee: 0.1},
+			sourceLeft:   0,
+			targetGained: 0.9,
+		},
+		{
+			name:    "quantity and fee over the holding",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 0.95, Fee: 0.1},
+			err:     "insufficient quantity",
+		},
+		{
+			name:    "more than the holding",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 2},
+			err:     "insufficient quantity",
+		},
+		{
+			name:    "negative fee",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String(), Quantity: 0.4, Fee: -0.1},
+			err:     "fee must not be negative",
+		},
+		{
+			name:    "no quantity",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: exchange.String()},
+			err:     "quantity must be greater than zero",
+		},
+		{
+			name:    "into the account it is already in",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: wallet.String(), Quantity: 0.4},
+			err:     "source and target account must differ",
+		},
+		{
+			name:    "source asset of another user",
+			command: TransferAssetCommand{SourceAssetID: foreignBTC.String(), TargetAccountID: exchange.String(), Quantity: 0.4},
+			err:     "unauthorized: asset does not belong to user",
+		},
+		{
+			name:    "target account of another user",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: foreign.String(), Quantity: 0.4},
+			err:     "unauthorized: account does not belong to user",
+		},
+		{
+			name:    "unknown source asset",
+			command: TransferAssetCommand{SourceAssetID: uuid.NewString(), TargetAccountID: exchange.String(), Quantity: 0.4},
+			err:     "asset not found",
+		},
+		{
+			name:    "unknown target account",
+			command: TransferAssetCommand{SourceAssetID: btc.String(), TargetAccountID: uuid.NewString(), Quantity: 0.4},
+			err:     "account not found",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assets := &fakeAssets{assets: map[uuid.UUID]*Asset{
+				btc:        {ID: btc, UserID: userID, AccountID: wallet, Quantity: 1},
+				foreignBTC: {ID: foreignBTC, UserID: otherUserID, AccountID: foreign, Quantity: 1},
+			}}
+			accounts := &fakeAccounts{accounts: map[uuid.UUID]*account.Account{
+				wallet:   {ID: wallet, UserID: userID},
+				exchange: {ID: exchange, UserID: userID},
+				foreign:  {ID: foreign, UserID: otherUserID},
+			}}
+			h := NewHandler(assets, accounts, nil)
+
+			tt.command.UserID = userID.String()
+			result, err := h.HandleTransferAssetCommand(context.Background(), tt.command)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("HandleTransferAssetCommand error = %v, want %q", err, tt.err)
+				}
+				if len(assets.transfers) != 0 {
+					t.Errorf("a rejected transfer was booked")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("HandleTransferAssetCommand: %v", err)
+			}
+
+			if result.SourceAsset.Quantity != tt.sourceLeft {
+				t.Errorf("source holds %v, want %v", result.SourceAsset.Quantity, tt.sourceLeft)
+			}
+			if result.TargetAsset.Quantity != tt.targetGained || result.TargetAsset.AccountID != exchange {
+				t.Errorf("target holds %v in %v, want %v in %v", result.TargetAsset.Quantity, result.TargetAsset.AccountID, tt.targetGained, exchange)
+			}
+			if transfer := result.Transfer; transfer.Fee != tt.command.Fee || transfer.UserID != userID {
+				t.Errorf("transfer booked with fee %v for %v, want fee %v for %v", transfer.Fee, transfer.UserID, tt.command.Fee, userID)
+			}
+		})
+	}
+}
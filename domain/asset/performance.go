@@ -0,0 +1,115 @@
+package asset
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/fx"
+)
+
+// AssetPerformance reports the result of a holding over a period, as it stood at the end
+// of the period. InitialValue is the acquisition cost of what was still held plus the cost
+// of what was sold in the period, CurrentValue prices the quantity held at the end at the
+// latest stored price up to then. Amounts are in Currency, converted with the exchange
+// rates known at the end of the period.
+type AssetPerformance struct {
+	AssetID              uuid.UUID `json:"assetId"`
+	Name                 string    `json:"name"`
+	Symbol               string    `json:"symbol"`
+	Type                 AssetType `json:"type"`
+	Quantity             float64   `json:"quantity"`
+	CostBasis            float64   `json:"costBasis"`
+	InitialValue         float64   `json:"initialValue"`
+	CurrentPrice         float64   `json:"currentPrice"`
+	CurrentValue         float64   `json:"currentValue"`
+	RealizedProfitLoss   float64   `json:"realizedProfitLoss"`
+	UnrealizedProfitLoss float64   `json:"unrealizedProfitLoss"`
+	ProfitLoss           float64   `json:"profitLoss"`
+	ProfitLossPerc       float64   `json:"profitLossPercentage"`
+	Currency             string    `json:"currency"`
+	// Priced is set when a price was found; CurrentPrice is then in Currency
+	Priced bool `json:"-"`
+	// Amounts are the costs and proceeds of the lots in the currencies they were booked in
+	Amounts []PerformanceAmount `json:"-"`
+	// Unconverted lists the currencies of amounts left out for lack of an exchange rate
+	Unconverted []string `json:"unconverted,omitempty"`
+}
+
+// PerformanceAmount is what the lots of an asset cost, and the sales in the period
+// brought in, in one currency.
+type PerformanceAmount struct {
+	Currency string
+	// OpenCost is the cost of the lot quantities still held at the end of the period
+	OpenCost float64
+	// SoldCost is the cost of the lot quantities sold in the period
+	SoldCost float64
+	// Proceeds is what the sales in the period brought in
+	Proceeds float64
+}
+
+// Add accumulates amounts of the same currency.
+func (p *AssetPerformance) Add(amount PerformanceAmount) {
+	amount.Currency = strings.ToUpper(amount.Currency)
+	for i := range p.Amounts {
+		if p.Amounts[i].Currency == amount.Currency {
+			p.Amounts[i].OpenCost += amount.OpenCost
+			p.Amounts[i].SoldCost += amount.SoldCost
+			p.Amounts[i].Proceeds += amount.Proceeds
+			return
+		}
+	}
+	p.Amounts = append(p.Amounts, amount)
+}
+
+// Calculate converts the amounts into the currency of the holding and derives the
+// profit and loss figures. The holding is reported in the currency of its price, or
+// without one in the currency most of its open cost is in, and then carried at cost.
+func (p *AssetPerformance) Calculate(table *fx.Table) {
+	sort.SliceStable(p.Amounts, func(i, j int) bool {
+		return p.Amounts[i].OpenCost > p.Amounts[j].OpenCost
+	})
+	p.Currency = strings.ToUpper(p.Currency)
+	if !p.Priced || p.Currency == "" {
+		p.Currency = ""
+		for _, a := range p.Amounts {
+			if a.Currency != "" {
+				p.Currency = a.Currency
+				break
+			}
+		}
+	}
+
+	var soldCost, proceeds float64
+	p.CostBasis = 0
+	p.Unconverted = nil
+	for _, a := range p.Amounts {
+		rate := 1.0
+		if a.Currency != "" && p.Currency != "" {
+			var ok bool
+			if rate, ok = table.Rate(a.Currency, p.Currency); !ok {
+				p.Unconverted = append(p.Unconverted, a.Currency)
+				continue
+			}
+		}
+		p.CostBasis += a.OpenCost * rate
+		soldCost += a.SoldCost * rate
+		proceeds += a.Proceeds * rate
+	}
+
+	p.InitialValue = p.CostBasis + soldCost
+	p.RealizedProfitLoss = proceeds - soldCost
+	if p.Priced {
+		p.CurrentValue = p.Quantity * p.CurrentPrice
+	} else {
+		// Without any stored price the holding is carried at cost
+		p.CurrentValue = p.CostBasis
+	}
+	p.UnrealizedProfitLoss = p.CurrentValue - p.CostBasis
+	p.ProfitLoss = p.RealizedProfitLoss + p.UnrealizedProfitLoss
+	p.ProfitLossPerc = 0
+	if p.InitialValue != 0 {
+		p.ProfitLossPerc = p.ProfitLoss / p.InitialValue * 100
+	}
+}
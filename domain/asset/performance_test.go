@@ -0,0 +1,108 @@
+package asset
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"siyahsensei/wallet-service/domain/fx"
+)
+
+func TestAssetPerformanceCalculate(t *testing.T) {
+	table := fx.NewTable([]*fx.Rate{
+		{BaseCurrency: "USD", QuoteCurrency: "TRY", Rate: 30},
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: 1.1},
+	}, "USD")
+
+	tests := []struct {
+		name        string
+		performance AssetPerformance
+		amounts     []PerformanceAmount
+		currency    string
+		costBasis   float64
+		current     float64
+		realized    float64
+		profitLoss  float64
+		percentage  float64
+		unconverted []string
+	}{
+		{
+			name:        "priced in the lot currency",
+			performance: AssetPerformance{Quantity: 10, CurrentPrice: 12, Currency: "usd", Priced: true},
+			amounts:     []PerformanceAmount{{Currency: "USD", OpenCost: 100}},
+			currency:    "USD",
+			costBasis:   100,
+			current:     120,
+			profitLoss:  20,
+			percentage:  20,
+		},
+		{
+			name:        "lot costs converted into the price currency",
+			performance: AssetPerformance{Quantity: 10, CurrentPrice: 400, Currency: "TRY", Priced: true},
+			amounts:     []PerformanceAmount{{Currency: "USD", OpenCost: 100}, {Currency: "try", OpenCost: 1000}},
+			currency:    "TRY",
+			costBasis:   4000,
+			current:     4000,
+		},
+		{
+			name:        "sales in the period are realized",
+			performance: AssetPerformance{Quantity: 5, CurrentPrice: 20, Currency: "USD", Priced: true},
+			amounts:     []PerformanceAmount{{Currency: "USD", OpenCost: 50, SoldCost: 50, Proceeds: 80}},
+			currency:    "USD",
+			costBasis:   50,
+			current:     100,
+			realized:    30,
+			profitLoss:  80,
+			percentage:  80,
+		},
+		{
+			name:        "unpriced holdings are carried at cost in their main currency",
+			performance: AssetPerformance{Quantity: 3},
+			amounts:     []PerformanceAmount{{Currency: "EUR", OpenCost: 10}, {Currency: "USD", OpenCost: 300}},
+			currency:    "USD",
+			costBasis:   311,
+			current:     311,
+		},
+		{
+			name:        "currencies without a rate are left out",
+			performance: AssetPerformance{Quantity: 1, CurrentPrice: 150, Currency: "USD", Priced: true},
+			amounts:     []PerformanceAmount{{Currency: "USD", OpenCost: 100}, {Currency: "XAU", OpenCost: 2}},
+			currency:    "USD",
+			costBasis:   100,
+			current:     150,
+			profitLoss:  50,
+			percentage:  50,
+			unconverted: []string{"XAU"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := tt.performance
+			for _, amount := range tt.amounts {
+				p.Add(amount)
+			}
+			p.Calculate(table)
+
+			if p.Currency != tt.currency {
+				t.Errorf("Currency = %q, want %q", p.Currency, tt.currency)
+			}
+			for _, check := range []struct {
+				field     string
+				got, want float64
+			}{
+				{"CostBasis", p.CostBasis, tt.costBasis},
+				{"CurrentValue", p.CurrentValue, tt.current},
+				{"RealizedProfitLoss", p.RealizedProfitLoss, tt.realized},
+				{"ProfitLoss", p.ProfitLoss, tt.profitLoss},
+				{"ProfitLossPerc", p.ProfitLossPerc, tt.percentage},
+			} {
+				if math.Abs(check.got-check.want) > 1e-9 {
+					t.Errorf("%s = %v, want %v", check.field, check.got, check.want)
+				}
+			}
+			if !reflect.DeepEqual(p.Unconverted, tt.unconverted) {
+				t.Errorf("Unconverted = %v, want %v", p.Unconverted, tt.unconverted)
+			}
+		})
+	}
+}
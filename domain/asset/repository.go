@@ -2,6 +2,7 @@ package asset
 
 import (
 	"context"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -13,4 +14,6 @@ type Repository interface {
 	GetByID(ctx context.Context, id uuid.UUID) (*Asset, error)
 	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Asset, error)
 	GetByType(ctx context.Context, userID uuid.UUID, assetType AssetType) ([]*Asset, error)
+	Transfer(ctx context.Context, transfer *Transfer) error
+	GetAssetPerformance(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) ([]*AssetPerformance, error)
 }
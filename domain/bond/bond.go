@@ -0,0 +1,82 @@
+package bond
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// DayCount is the convention used to turn a date range into a fraction of a year when
+// interest is accrued.
+type DayCount string
+
+const (
+	Thirty360    DayCount = "30_360"
+	Actual360    DayCount = "ACT_360"
+	Actual365    DayCount = "ACT_365"
+	ActualActual DayCount = "ACT_ACT"
+)
+
+// Bond holds the terms of a BOND asset. FaceValue is per bond, the asset quantity is
+// the number of bonds held, CouponRate is an annual percentage paid CouponFrequency
+// times a year. A frequency of zero is a zero-coupon bond.
+type Bond struct {
+	ID              uuid.UUID `json:"id" db:"id"`
+	UserID          uuid.UUID `json:"userId" db:"user_id"`
+	AssetID         uuid.UUID `json:"assetId" db:"asset_id"`
+	FaceValue       float64   `json:"faceValue" db:"face_value"`
+	Currency        string    `json:"currency" db:"currency"`
+	CouponRate      float64   `json:"couponRate" db:"coupon_rate"`
+	CouponFrequency int       `json:"couponFrequency" db:"coupon_frequency"`
+	IssueDate       time.Time `json:"issueDate" db:"issue_date"`
+	MaturityDate    time.Time `json:"maturityDate" db:"maturity_date"`
+	DayCount        DayCount  `json:"dayCount" db:"day_count"`
+	CreatedAt       time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt       time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+func NewBond(command CreateBondCommand) *Bond {
+	now := time.Now()
+	b := &Bond{
+		ID:              uuid.New(),
+		UserID:          uuid.MustParse(command.UserID),
+		AssetID:         uuid.MustParse(command.AssetID),
+		FaceValue:       command.FaceValue,
+		Currency:        command.Currency,
+		CouponRate:      command.CouponRate,
+		CouponFrequency: command.CouponFrequency,
+		IssueDate:       calendar.TruncateDay(time.Unix(command.IssueDate, 0)),
+		MaturityDate:    calendar.TruncateDay(time.Unix(command.MaturityDate, 0)),
+		DayCount:        command.DayCount,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if b.DayCount == "" {
+		b.DayCount = ActualActual
+	}
+	return b
+}
+
+func (b *Bond) Update(command UpdateBondCommand) {
+	b.FaceValue = command.FaceValue
+	b.Currency = command.Currency
+	b.CouponRate = command.CouponRate
+	b.CouponFrequency = command.CouponFrequency
+	b.IssueDate = calendar.TruncateDay(time.Unix(command.IssueDate, 0))
+	b.MaturityDate = calendar.TruncateDay(time.Unix(command.MaturityDate, 0))
+	b.DayCount = command.DayCount
+	if b.DayCount == "" {
+		b.DayCount = ActualActual
+	}
+	b.UpdatedAt = time.Now()
+}
+
+// couponAmount is the regular coupon paid per bond on every payment date.
+func (b *Bond) couponAmount() float64 {
+	if b.CouponFrequency == 0 {
+		return 0
+	}
+	return b.FaceValue * b.CouponRate / 100 / float64(b.CouponFrequency)
+}
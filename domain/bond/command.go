@@ -0,0 +1,30 @@
+package bond
+
+type CreateBondCommand struct {
+	UserID          string   `json:"userId" validate:"required"`
+	AssetID         string   `json:"assetId" validate:"required"`
+	FaceValue       float64  `json:"faceValue" validate:"required"`
+	Currency        string   `json:"currency" validate:"required"`
+	CouponRate      float64  `json:"couponRate"`
+	CouponFrequency int      `json:"couponFrequency"`
+	IssueDate       int64    `json:"issueDate" validate:"required"`
+	MaturityDate    int64    `json:"maturityDate" validate:"required"`
+	DayCount        DayCount `json:"dayCount"`
+}
+
+type UpdateBondCommand struct {
+	ID              string   `json:"id" validate:"required"`
+	UserID          string   `json:"userId" validate:"required"`
+	FaceValue       float64  `json:"faceValue" validate:"required"`
+	Currency        string   `json:"currency" validate:"required"`
+	CouponRate      float64  `json:"couponRate"`
+	CouponFrequency int      `json:"couponFrequency"`
+	IssueDate       int64    `json:"issueDate" validate:"required"`
+	MaturityDate    int64    `json:"maturityDate" validate:"required"`
+	DayCount        DayCount `json:"dayCount"`
+}
+
+type DeleteBondCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
@@ -0,0 +1,33 @@
+package bond
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// YearFraction returns the part of a year between two dates under the convention.
+// Actual/actual counts actual days over a 365-day year here; within a coupon period
+// the accrual uses the actual length of the period instead.
+func (dc DayCount) YearFraction(from, to time.Time) float64 {
+	switch dc {
+	case Thirty360:
+		return float64(days360(from, to)) / 360
+	case Actual360:
+		return float64(calendar.Days(from, to)) / 360
+	default:
+		return float64(calendar.Days(from, to)) / 365
+	}
+}
+
+// days360 counts days under the US 30/360 bond basis.
+func days360(from, to time.Time) int {
+	d1, d2 := from.Day(), to.Day()
+	if d1 == 31 {
+		d1 = 30
+	}
+	if d2 == 31 && d1 == 30 {
+		d2 = 30
+	}
+	return 360*(to.Year()-from.Year()) + 30*int(to.Month()-from.Month()) + d2 - d1
+}
@@ -0,0 +1,251 @@
+package bond
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/price"
+)
+
+type Handler struct {
+	repo      Repository
+	assetRepo asset.Repository
+	priceRepo price.Repository
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, priceRepo price.Repository) *Handler {
+	return &Handler{
+		repo:      repo,
+		assetRepo: assetRepo,
+		priceRepo: priceRepo,
+	}
+}
+
+func (h *Handler) HandleCreateBondCommand(ctx context.Context, command CreateBondCommand) (*Bond, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	command.Currency = strings.ToUpper(strings.TrimSpace(command.Currency))
+	if err := validateTerms(command.FaceValue, command.Currency, command.CouponRate, command.CouponFrequency,
+		command.IssueDate, command.MaturityDate, command.DayCount); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+	if existingAsset.Type != asset.Bond {
+		return nil, errors.New("asset is not a bond")
+	}
+	if _, err := h.repo.GetByAssetID(ctx, assetID); err == nil {
+		return nil, errors.New("asset already has bond terms")
+	}
+
+	bond := NewBond(command)
+	if err := h.repo.Create(ctx, bond); err != nil {
+		return nil, err
+	}
+	return bond, nil
+}
+
+func (h *Handler) HandleUpdateBondCommand(ctx context.Context, command UpdateBondCommand) (*Bond, error) {
+	bond, err := h.ownedBond(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	command.Currency = strings.ToUpper(strings.TrimSpace(command.Currency))
+	if err := validateTerms(command.FaceValue, command.Currency, command.CouponRate, command.CouponFrequency,
+		command.IssueDate, command.MaturityDate, command.DayCount); err != nil {
+		return nil, err
+	}
+
+	bond.Update(command)
+	if err := h.repo.Update(ctx, bond); err != nil {
+		return nil, err
+	}
+	return bond, nil
+}
+
+func (h *Handler) HandleDeleteBondCommand(ctx context.Context, command DeleteBondCommand) error {
+	bond, err := h.ownedBond(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, bond.ID)
+}
+
+func (h *Handler) HandleGetBondByIDQuery(ctx context.Context, query GetBondByIDQuery) (*Bond, error) {
+	return h.ownedBond(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserBondsQuery(ctx context.Context, query GetUserBondsQuery) ([]*Bond, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+// HandleGetCouponsQuery returns the coupon calendar of one or all bonds of the user,
+// sized to the quantity currently held and ordered by payment date.
+func (h *Handler) HandleGetCouponsQuery(ctx context.Context, query GetCouponsQuery) ([]*Coupon, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.From != nil && query.To != nil && query.To.Before(*query.From) {
+		return nil, errors.New("to date must not be before from date")
+	}
+
+	var bonds []*Bond
+	if query.ID != nil {
+		bond, err := h.ownedBond(ctx, *query.ID, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+		bonds = append(bonds, bond)
+	} else {
+		bonds, err = h.repo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	assets, err := h.assetRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	quantities := make(map[uuid.UUID]float64, len(assets))
+	for _, a := range assets {
+		quantities[a.ID] = a.Quantity
+	}
+
+	var coupons []*Coupon
+	for _, bond := range bonds {
+		for _, c := range bond.Schedule(quantities[bond.AssetID]) {
+			if query.From != nil && c.Date.Before(*query.From) {
+				continue
+			}
+			if query.To != nil && c.Date.After(*query.To) {
+				continue
+			}
+			coupons = append(coupons, c)
+		}
+	}
+
+	sort.SliceStable(coupons, func(i, j int) bool {
+		return coupons[i].Date.Before(coupons[j].Date)
+	})
+	return coupons, nil
+}
+
+func (h *Handler) HandleGetPricingQuery(ctx context.Context, query GetPricingQuery) (*Pricing, error) {
+	bond, err := h.ownedBond(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if query.SettlementDate.IsZero() {
+		query.SettlementDate = time.Now()
+	}
+
+	holding, err := h.assetRepo.GetByID(ctx, bond.AssetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	source := "REQUEST"
+	if query.CleanPrice == nil {
+		stored, err := h.priceRepo.GetAt(ctx, holding.DefinitionID, bond.Currency, query.SettlementDate)
+		if err != nil {
+			return nil, errors.New("no price found for bond, pass a clean price")
+		}
+		cleanPrice := stored.Price / bond.FaceValue * 100
+		query.CleanPrice = &cleanPrice
+		source = "PRICE_HISTORY"
+	}
+
+	pricing, err := bond.Price(*query.CleanPrice, query.SettlementDate, holding.Quantity)
+	if err != nil {
+		return nil, err
+	}
+	pricing.PriceSource = source
+	return pricing, nil
+}
+
+func (h *Handler) ownedBond(ctx context.Context, bondIDValue, userIDValue string) (*Bond, error) {
+	bondID, err := uuid.Parse(bondIDValue)
+	if err != nil {
+		return nil, errors.New("invalid bond ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	bond, err := h.repo.GetByID(ctx, bondID)
+	if err != nil {
+		return nil, errors.New("bond not found")
+	}
+
+	if bond.UserID != userID {
+		return nil, errors.New("unauthorized: bond does not belong to user")
+	}
+
+	return bond, nil
+}
+
+func validateTerms(faceValue float64, currency string, couponRate float64, couponFrequency int,
+	issueDate, maturityDate int64, dayCount DayCount) error {
+	if faceValue <= 0 {
+		return errors.New("face value must be greater than zero")
+	}
+	if currency == "" {
+		return errors.New("currency is required")
+	}
+	if couponRate < 0 {
+		return errors.New("coupon rate must not be negative")
+	}
+	switch couponFrequency {
+	case 0, 1, 2, 4, 12:
+	default:
+		return errors.New("coupon frequency must be 0, 1, 2, 4 or 12 payments a year")
+	}
+	if couponFrequency == 0 && couponRate != 0 {
+		return errors.New("zero-coupon bonds must not have a coupon rate")
+	}
+	if issueDate <= 0 {
+		return errors.New("issue date is required")
+	}
+	if maturityDate <= issueDate {
+		return errors.New("maturity date must be after issue date")
+	}
+	if dayCount != "" && !isValidDayCount(dayCount) {
+		return errors.New("invalid day count convention")
+	}
+	return nil
+}
+
+func isValidDayCount(dc DayCount) bool {
+	switch dc {
+	case Thirty360, Actual360, Actual365, ActualActual:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,31 @@
+package bond
+
+import (
+	"time"
+)
+
+type GetBondByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserBondsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetCouponsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+	// ID limits the calendar to one bond
+	ID   *string    `json:"id,omitempty"`
+	From *time.Time `json:"from,omitempty"`
+	To   *time.Time `json:"to,omitempty"`
+}
+
+type GetPricingQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+	// CleanPrice is a percentage of face value. When it is not given the latest stored
+	// price of the bond in its currency is used as the clean price of one bond.
+	CleanPrice     *float64  `json:"cleanPrice,omitempty"`
+	SettlementDate time.Time `json:"settlementDate"`
+}
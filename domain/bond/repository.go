@@ -0,0 +1,16 @@
+package bond
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, bond *Bond) error
+	Update(ctx context.Context, bond *Bond) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Bond, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) (*Bond, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Bond, error)
+}
@@ -0,0 +1,103 @@
+package bond
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// Coupon is one payment date of a bond. Per-bond amounts are scaled by the quantity
+// held into the amounts the holding receives. The redemption is paid with the last coupon.
+type Coupon struct {
+	BondID            uuid.UUID `json:"bondId"`
+	AssetID           uuid.UUID `json:"assetId"`
+	Date              time.Time `json:"date"`
+	PeriodStart       time.Time `json:"periodStart"`
+	CouponPerBond     float64   `json:"couponPerBond"`
+	RedemptionPerBond float64   `json:"redemptionPerBond"`
+	Quantity          float64   `json:"quantity"`
+	CouponAmount      float64   `json:"couponAmount"`
+	RedemptionAmount  float64   `json:"redemptionAmount"`
+	Currency          string    `json:"currency"`
+}
+
+// Schedule generates every payment of the bond from issue to maturity, oldest first.
+// Payment dates are counted back from maturity, so an irregular period can only be
+// the first one; its coupon is prorated with the day count convention.
+func (b *Bond) Schedule(quantity float64) []*Coupon {
+	if b.CouponFrequency == 0 {
+		return []*Coupon{b.coupon(b.IssueDate, b.MaturityDate, 0, quantity)}
+	}
+
+	step := 12 / b.CouponFrequency
+	var coupons []*Coupon
+	for k := 0; ; k++ {
+		date := calendar.AddMonths(b.MaturityDate, -k*step)
+		if !date.After(b.IssueDate) {
+			break
+		}
+
+		regularStart := calendar.AddMonths(b.MaturityDate, -(k+1)*step)
+		amount := b.couponAmount()
+		start := regularStart
+		if regularStart.Before(b.IssueDate) {
+			start = b.IssueDate
+			amount = b.accrue(regularStart, start, date, date)
+		}
+		coupons = append(coupons, b.coupon(start, date, amount, quantity))
+	}
+
+	for i, j := 0, len(coupons)-1; i < j; i, j = i+1, j-1 {
+		coupons[i], coupons[j] = coupons[j], coupons[i]
+	}
+	return coupons
+}
+
+func (b *Bond) coupon(start, date time.Time, amount, quantity float64) *Coupon {
+	c := &Coupon{
+		BondID:        b.ID,
+		AssetID:       b.AssetID,
+		Date:          date,
+		PeriodStart:   start,
+		CouponPerBond: amount,
+		Quantity:      quantity,
+		CouponAmount:  amount * quantity,
+		Currency:      b.Currency,
+	}
+	if date.Equal(b.MaturityDate) {
+		c.RedemptionPerBond = b.FaceValue
+		c.RedemptionAmount = b.FaceValue * quantity
+	}
+	return c
+}
+
+// AccruedInterest is the coupon earned per bond since the last payment date up to the
+// settlement date, which the buyer of a bond pays on top of its clean price.
+func (b *Bond) AccruedInterest(settlement time.Time) float64 {
+	if b.CouponFrequency == 0 {
+		return 0
+	}
+	settlement = calendar.TruncateDay(settlement)
+	for _, c := range b.Schedule(1) {
+		if !settlement.Before(c.PeriodStart) && settlement.Before(c.Date) {
+			regularStart := calendar.AddMonths(c.Date, -12/b.CouponFrequency)
+			return b.accrue(regularStart, c.PeriodStart, settlement, c.Date)
+		}
+	}
+	return 0
+}
+
+// accrue is the interest per bond from start to until within the coupon period that
+// regularly runs from periodStart to periodEnd.
+func (b *Bond) accrue(periodStart, start, until, periodEnd time.Time) float64 {
+	if b.DayCount == ActualActual {
+		periodDays := calendar.Days(periodStart, periodEnd)
+		if periodDays == 0 {
+			return 0
+		}
+		return b.couponAmount() * float64(calendar.Days(start, until)) / float64(periodDays)
+	}
+	return b.FaceValue * b.CouponRate / 100 * b.DayCount.YearFraction(start, until)
+}
@@ -0,0 +1,113 @@
+package bond
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// Pricing values a bond at a clean price quoted as a percentage of face value.
+// Yields are annual percentages; YieldToMaturity compounds at the coupon frequency.
+type Pricing struct {
+	SettlementDate  time.Time `json:"settlementDate"`
+	CleanPrice      float64   `json:"cleanPrice"`
+	PriceSource     string    `json:"priceSource"`
+	AccruedInterest float64   `json:"accruedInterest"`
+	DirtyPrice      float64   `json:"dirtyPrice"`
+	CurrentYield    float64   `json:"currentYield"`
+	YieldToMaturity float64   `json:"yieldToMaturity"`
+	Quantity        float64   `json:"quantity"`
+	MarketValue     float64   `json:"marketValue"`
+	Currency        string    `json:"currency"`
+}
+
+// Price computes the accrued interest, dirty price and yields of one bond at the given
+// clean price. Prices and accrued interest are per bond in the bond currency.
+func (b *Bond) Price(cleanPrice float64, settlement time.Time, quantity float64) (*Pricing, error) {
+	settlement = calendar.TruncateDay(settlement)
+	if !settlement.Before(b.MaturityDate) {
+		return nil, errors.New("bond has matured")
+	}
+	if cleanPrice <= 0 {
+		return nil, errors.New("clean price must be greater than zero")
+	}
+
+	p := &Pricing{
+		SettlementDate:  settlement,
+		CleanPrice:      cleanPrice,
+		AccruedInterest: b.AccruedInterest(settlement),
+		Quantity:        quantity,
+		Currency:        b.Currency,
+	}
+	p.DirtyPrice = cleanPrice/100*b.FaceValue + p.AccruedInterest
+	p.MarketValue = p.DirtyPrice * quantity
+	p.CurrentYield = b.couponAmount() * float64(b.CouponFrequency) / (cleanPrice / 100 * b.FaceValue) * 100
+
+	ytm, err := b.yieldToMaturity(p.DirtyPrice, settlement)
+	if err != nil {
+		return nil, err
+	}
+	p.YieldToMaturity = ytm * 100
+	return p, nil
+}
+
+type cashFlow struct {
+	periods float64
+	amount  float64
+}
+
+// yieldToMaturity solves for the annual yield that discounts the remaining cash flows
+// to the dirty price. The first flow is discounted over the fraction of its coupon
+// period still to run, each later one over a whole period more.
+func (b *Bond) yieldToMaturity(dirtyPrice float64, settlement time.Time) (float64, error) {
+	frequency := float64(b.CouponFrequency)
+	var flows []cashFlow
+	if b.CouponFrequency == 0 {
+		frequency = 1
+		flows = append(flows, cashFlow{
+			periods: float64(calendar.Days(settlement, b.MaturityDate)) / 365,
+			amount:  b.FaceValue,
+		})
+	} else {
+		var first float64
+		for _, c := range b.Schedule(1) {
+			if !c.Date.After(settlement) {
+				continue
+			}
+			if flows == nil {
+				regularStart := calendar.AddMonths(c.Date, -12/b.CouponFrequency)
+				first = float64(calendar.Days(settlement, c.Date)) / float64(calendar.Days(regularStart, c.Date))
+			}
+			flows = append(flows, cashFlow{
+				periods: first + float64(len(flows)),
+				amount:  c.CouponPerBond + c.RedemptionPerBond,
+			})
+		}
+	}
+
+	presentValue := func(y float64) float64 {
+		var pv float64
+		for _, f := range flows {
+			pv += f.amount / math.Pow(1+y/frequency, f.periods)
+		}
+		return pv
+	}
+
+	// The present value falls as the yield rises, so bisect between a near total loss
+	// and an implausibly high yield
+	low, high := -0.99*frequency, 10.0
+	if presentValue(low) < dirtyPrice || presentValue(high) > dirtyPrice {
+		return 0, errors.New("no yield matches the price")
+	}
+	for i := 0; i < 200 && high-low > 1e-12; i++ {
+		mid := (low + high) / 2
+		if presentValue(mid) > dirtyPrice {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+	return (low + high) / 2, nil
+}
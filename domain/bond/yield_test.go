@@ -0,0 +1,183 @@
+package bond
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// semiannual is a two-year 5% bond paying 25 per 1000 face value twice a year.
+func semiannual(issue time.Time, dayCount DayCount) *Bond {
+	return &Bond{
+		FaceValue:       1000,
+		Currency:        "USD",
+		CouponRate:      5,
+		CouponFrequency: 2,
+		IssueDate:       issue,
+		MaturityDate:    date(2026, 1, 1),
+		DayCount:        dayCount,
+	}
+}
+
+func TestYearFraction(t *testing.T) {
+	tests := []struct {
+		name     string
+		dayCount DayCount
+		from, to time.Time
+		want     float64
+	}{
+		{"30/360 half year", Thirty360, date(2024, 1, 15), date(2024, 7, 15), 0.5},
+		{"30/360 end of month start", Thirty360, date(2024, 1, 31), date(2024, 3, 1), 31.0 / 360},
+		{"30/360 end of month both", Thirty360, date(2024, 1, 30), date(2024, 3, 31), 60.0 / 360},
+		{"30/360 ignores february", Thirty360, date(2024, 2, 1), date(2024, 3, 1), 30.0 / 360},
+		{"actual/360", Actual360, date(2024, 1, 1), date(2024, 7, 1), 182.0 / 360},
+		{"actual/365", Actual365, date(2024, 1, 1), date(2024, 7, 1), 182.0 / 365},
+		{"actual/actual over a leap year", ActualActual, date(2024, 1, 1), date(2025, 1, 1), 366.0 / 365},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.dayCount.YearFraction(tt.from, tt.to); math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("YearFraction = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		name    string
+		bond    *Bond
+		dates   []time.Time
+		first   float64
+		regular float64
+	}{
+		{
+			name:    "regular periods",
+			bond:    semiannual(date(2024, 1, 1), ActualActual),
+			dates:   []time.Time{date(2024, 7, 1), date(2025, 1, 1), date(2025, 7, 1), date(2026, 1, 1)},
+			first:   25,
+			regular: 25,
+		},
+		{
+			name:    "short first period prorated on actual days of the period",
+			bond:    semiannual(date(2024, 3, 1), ActualActual),
+			dates:   []time.Time{date(2024, 7, 1), date(2025, 1, 1), date(2025, 7, 1), date(2026, 1, 1)},
+			first:   25 * 122.0 / 182,
+			regular: 25,
+		},
+		{
+			name:    "short first period prorated on 30/360",
+			bond:    semiannual(date(2024, 3, 1), Thirty360),
+			dates:   []time.Time{date(2024, 7, 1), date(2025, 1, 1), date(2025, 7, 1), date(2026, 1, 1)},
+			first:   1000 * 0.05 * 120 / 360,
+			regular: 25,
+		},
+		{
+			name:  "zero coupon pays only the redemption",
+			bond:  &Bond{FaceValue: 1000, IssueDate: date(2024, 1, 1), MaturityDate: date(2026, 1, 1)},
+			dates: []time.Time{date(2026, 1, 1)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coupons := tt.bond.Schedule(3)
+			if len(coupons) != len(tt.dates) {
+				t.Fatalf("Schedule returned %d coupons, want %d", len(coupons), len(tt.dates))
+			}
+			for i, c := range coupons {
+				if !c.Date.Equal(tt.dates[i]) {
+					t.Errorf("coupon %d paid on %v, want %v", i, c.Date, tt.dates[i])
+				}
+				want := tt.regular
+				if i == 0 {
+					want = tt.first
+				}
+				if math.Abs(c.CouponPerBond-want) > 1e-9 || math.Abs(c.CouponAmount-3*want) > 1e-9 {
+					t.Errorf("coupon %d = %v per bond and %v held, want %v", i, c.CouponPerBond, c.CouponAmount, want)
+				}
+				redemption := 0.0
+				if i == len(coupons)-1 {
+					redemption = 1000
+				}
+				if c.RedemptionPerBond != redemption {
+					t.Errorf("coupon %d redeems %v, want %v", i, c.RedemptionPerBond, redemption)
+				}
+			}
+			if !coupons[0].PeriodStart.Equal(tt.bond.IssueDate) {
+				t.Errorf("first period starts %v, want the issue date", coupons[0].PeriodStart)
+			}
+		})
+	}
+}
+
+func TestAccruedInterest(t *testing.T) {
+	tests := []struct {
+		name       string
+		bond       *Bond
+		settlement time.Time
+		want       float64
+	}{
+		{"on the issue date", semiannual(date(2024, 1, 1), ActualActual), date(2024, 1, 1), 0},
+		{"half way through a period", semiannual(date(2024, 1, 1), ActualActual), date(2024, 4, 1), 25 * 91.0 / 182},
+		{"on a coupon date", semiannual(date(2024, 1, 1), ActualActual), date(2024, 7, 1), 0},
+		{"30/360", semiannual(date(2024, 1, 1), Thirty360), date(2024, 4, 1), 1000 * 0.05 * 90 / 360},
+		{"inside a short first period", semiannual(date(2024, 3, 1), ActualActual), date(2024, 4, 1), 25 * 31.0 / 182},
+		{"zero coupon", &Bond{FaceValue: 1000, IssueDate: date(2024, 1, 1), MaturityDate: date(2026, 1, 1)}, date(2025, 1, 1), 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.bond.AccruedInterest(tt.settlement); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("AccruedInterest = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPrice(t *testing.T) {
+	zero := &Bond{FaceValue: 1000, IssueDate: date(2024, 1, 1), MaturityDate: date(2026, 1, 1)}
+
+	tests := []struct {
+		name         string
+		bond         *Bond
+		cleanPrice   float64
+		settlement   time.Time
+		dirtyPrice   float64
+		currentYield float64
+		ytm          float64
+		err          bool
+	}{
+		{"at par the yield is the coupon", semiannual(date(2024, 1, 1), ActualActual), 100, date(2024, 1, 1), 1000, 5, 5, false},
+		{"at a discount the yield is higher", semiannual(date(2024, 1, 1), ActualActual), 95, date(2024, 1, 1), 950, 25 * 2 / 9.5, 7.746682, false},
+		{"between coupons the buyer pays accrued interest", semiannual(date(2024, 1, 1), ActualActual), 100, date(2024, 4, 1), 1000 + 25*91.0/182, 5, 4.995345, false},
+		{"zero coupon", zero, 90, date(2024, 1, 1), 900, 0, 5.401659, false},
+		{"matured", zero, 90, date(2026, 1, 1), 0, 0, 0, true},
+		{"price must be positive", zero, 0, date(2024, 1, 1), 0, 0, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := tt.bond.Price(tt.cleanPrice, tt.settlement, 2)
+			if tt.err {
+				if err == nil {
+					t.Fatal("Price succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Price: %v", err)
+			}
+			if math.Abs(p.DirtyPrice-tt.dirtyPrice) > 1e-9 || math.Abs(p.MarketValue-2*tt.dirtyPrice) > 1e-9 {
+				t.Errorf("dirty price %v and market value %v, want %v for one bond", p.DirtyPrice, p.MarketValue, tt.dirtyPrice)
+			}
+			if math.Abs(p.CurrentYield-tt.currentYield) > 1e-9 {
+				t.Errorf("CurrentYield = %v, want %v", p.CurrentYield, tt.currentYield)
+			}
+			if math.Abs(p.YieldToMaturity-tt.ytm) > 1e-4 {
+				t.Errorf("YieldToMaturity = %v, want %v", p.YieldToMaturity, tt.ytm)
+			}
+		})
+	}
+}
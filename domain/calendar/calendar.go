@@ -0,0 +1,35 @@
+package calendar
+
+import (
+	"math"
+	"time"
+)
+
+// TruncateDay returns midnight UTC of the UTC day that t falls on. Dates are stored and
+// compared in UTC, so the zone of t never moves it onto another day.
+func TruncateDay(t time.Time) time.Time {
+	t = t.UTC()
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// AddMonths moves a date by whole months, keeping the day of the month where it exists
+// and falling back to the last day of shorter months.
+func AddMonths(t time.Time, months int) time.Time {
+	t = t.UTC()
+	return MonthDay(t.Year(), t.Month()+time.Month(months), t.Day())
+}
+
+// MonthDay builds a date, moving days past the end of the month onto its last day.
+// Months beyond December roll over into the following years.
+func MonthDay(year int, month time.Month, day int) time.Time {
+	firstOfMonth := time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+	if last := firstOfMonth.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return time.Date(firstOfMonth.Year(), firstOfMonth.Month(), day, 0, 0, 0, 0, time.UTC)
+}
+
+// Days counts calendar days between two instants, ignoring the time of day.
+func Days(from, to time.Time) int {
+	return int(math.Round(TruncateDay(to).Sub(TruncateDay(from)).Hours() / 24))
+}
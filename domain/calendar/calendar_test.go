@@ -0,0 +1,96 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestTruncateDay(t *testing.T) {
+	istanbul := time.FixedZone("TRT", 3*60*60)
+	tests := []struct {
+		name string
+		in   time.Time
+		want time.Time
+	}{
+		{"utc midday", time.Date(2024, 5, 10, 13, 45, 0, 0, time.UTC), date(2024, 5, 10)},
+		{"utc midnight", date(2024, 5, 10), date(2024, 5, 10)},
+		{"ahead of utc before midnight utc", time.Date(2024, 5, 11, 1, 0, 0, 0, istanbul), date(2024, 5, 10)},
+		{"ahead of utc after midnight utc", time.Date(2024, 5, 11, 4, 0, 0, 0, istanbul), date(2024, 5, 11)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TruncateDay(tt.in); !got.Equal(tt.want) || got.Location() != time.UTC {
+				t.Errorf("TruncateDay(%v) = %v, want %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAddMonths(t *testing.T) {
+	tests := []struct {
+		name   string
+		in     time.Time
+		months int
+		want   time.Time
+	}{
+		{"same day exists", date(2024, 1, 15), 1, date(2024, 2, 15)},
+		{"clamped to leap february", date(2024, 1, 31), 1, date(2024, 2, 29)},
+		{"clamped to february", date(2023, 1, 31), 1, date(2023, 2, 28)},
+		{"across the year end", date(2024, 11, 30), 3, date(2025, 2, 28)},
+		{"backwards", date(2024, 3, 31), -1, date(2024, 2, 29)},
+		{"backwards across the year", date(2024, 2, 15), -6, date(2023, 8, 15)},
+		{"drops the time of day", time.Date(2024, 1, 15, 18, 0, 0, 0, time.UTC), 0, date(2024, 1, 15)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := AddMonths(tt.in, tt.months); !got.Equal(tt.want) {
+				t.Errorf("AddMonths(%v, %d) = %v, want %v", tt.in, tt.months, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMonthDay(t *testing.T) {
+	tests := []struct {
+		name  string
+		year  int
+		month time.Month
+		day   int
+		want  time.Time
+	}{
+		{"regular", 2024, time.April, 10, date(2024, 4, 10)},
+		{"past the month end", 2024, time.April, 31, date(2024, 4, 30)},
+		{"month rolls into next year", 2024, 14, 31, date(2025, 2, 28)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := MonthDay(tt.year, tt.month, tt.day); !got.Equal(tt.want) {
+				t.Errorf("MonthDay(%d, %d, %d) = %v, want %v", tt.year, tt.month, tt.day, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDays(t *testing.T) {
+	tests := []struct {
+		name     string
+		from, to time.Time
+		want     int
+	}{
+		{"same day", time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 20, 0, 0, 0, time.UTC), 0},
+		{"late to early next day", time.Date(2024, 1, 1, 23, 0, 0, 0, time.UTC), time.Date(2024, 1, 2, 1, 0, 0, 0, time.UTC), 1},
+		{"leap year", date(2024, 1, 1), date(2025, 1, 1), 366},
+		{"negative", date(2024, 3, 1), date(2024, 2, 1), -29},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Days(tt.from, tt.to); got != tt.want {
+				t.Errorf("Days(%v, %v) = %d, want %d", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package capitalgains
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+var csvHeader = []string{
+	"symbol", "asset_name", "asset_type", "account", "quantity", "acquired", "disposed", "holding_days", "term",
+	"currency", "proceeds", "cost", "gain", "report_currency", "report_proceeds", "report_cost", "report_gain",
+	"exempt", "exempt_reason",
+}
+
+// FileName is the name a report is downloaded as.
+func (r *Report) FileName() string {
+	return fmt.Sprintf("capital-gains-%s-%d.csv", r.Jurisdiction, r.Year)
+}
+
+// WriteCSV writes one row per line. The report amounts of unconverted lines are left empty.
+func (r *Report) WriteCSV(w io.Writer) error {
+	out := csv.NewWriter(w)
+	if err := out.Write(csvHeader); err != nil {
+		return err
+	}
+	for _, l := range r.Lines {
+		reportProceeds, reportCost, reportGain := "", "", ""
+		if l.Converted {
+			reportProceeds, reportCost, reportGain = formatAmount(l.ReportProceeds), formatAmount(l.ReportCost), formatAmount(l.ReportGain)
+		}
+		record := []string{
+			l.Symbol,
+			l.AssetName,
+			string(l.AssetType),
+			l.AccountName,
+			strconv.FormatFloat(l.Quantity, 'f', -1, 64),
+			l.AcquiredAt.Format("2006-01-02"),
+			l.DisposedAt.Format("2006-01-02"),
+			strconv.Itoa(l.HoldingDays),
+			string(l.Term),
+			l.Currency,
+			formatAmount(l.Proceeds),
+			formatAmount(l.Cost),
+			formatAmount(l.Gain),
+			r.Currency,
+			reportProceeds,
+			reportCost,
+			reportGain,
+			strconv.FormatBool(l.Exempt),
+			l.ExemptReason,
+		}
+		if err := out.Write(record); err != nil {
+			return err
+		}
+	}
+	out.Flush()
+	return out.Error()
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', 2, 64)
+}
@@ -0,0 +1,99 @@
+package capitalgains
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+	"siyahsensei/wallet-service/domain/fx"
+)
+
+type Handler struct {
+	repo                Repository
+	fxService           *fx.Handler
+	defaultBaseCurrency string
+	defaultJurisdiction string
+}
+
+func NewHandler(repo Repository, fxService *fx.Handler, defaultBaseCurrency, defaultJurisdiction string) *Handler {
+	return &Handler{
+		repo:                repo,
+		fxService:           fxService,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+		defaultJurisdiction: strings.ToUpper(defaultJurisdiction),
+	}
+}
+
+func (h *Handler) HandleGetReportQuery(ctx context.Context, query GetReportQuery) (*Report, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.Year < 1900 || query.Year > 9999 {
+		return nil, errors.New("invalid year")
+	}
+
+	code := strings.TrimSpace(query.Jurisdiction)
+	if code == "" {
+		code = h.defaultJurisdiction
+	}
+	jurisdiction, ok := Lookup(code)
+	if !ok {
+		return nil, errors.New("unknown jurisdiction, expected one of " + strings.Join(Codes(), ", "))
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(query.Currency))
+	if currency == "" {
+		currency = strings.ToUpper(jurisdiction.Currency())
+	}
+	if currency == "" {
+		currency = h.defaultBaseCurrency
+	}
+
+	from, to := jurisdiction.FiscalYear(query.Year)
+	sales, err := h.repo.GetSales(ctx, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[time.Time]*fx.Table)
+	report := newReport(query.Year, jurisdiction, currency, from, to)
+	for _, s := range sales {
+		line := newLine(s, jurisdiction)
+		costAt, proceedsAt := jurisdiction.RateDates(line.AcquiredAt, line.DisposedAt)
+
+		costTable, err := h.table(ctx, tables, costAt)
+		if err != nil {
+			return nil, err
+		}
+		proceedsTable, err := h.table(ctx, tables, proceedsAt)
+		if err != nil {
+			return nil, err
+		}
+		cost, costOK := costTable.Convert(line.Cost, line.Currency, currency)
+		proceeds, proceedsOK := proceedsTable.Convert(line.Proceeds, line.ProceedsCurrency, currency)
+		if costOK && proceedsOK {
+			line.convert(proceeds, cost)
+		}
+		report.add(line)
+	}
+	return report, nil
+}
+
+// table returns the rates known at the end of the day of at, loading each day only once.
+func (h *Handler) table(ctx context.Context, tables map[time.Time]*fx.Table, at time.Time) (*fx.Table, error) {
+	day := calendar.TruncateDay(at)
+	if table, ok := tables[day]; ok {
+		return table, nil
+	}
+	table, err := h.fxService.Table(ctx, day.AddDate(0, 0, 1).Add(-time.Nanosecond))
+	if err != nil {
+		return nil, err
+	}
+	tables[day] = table
+	return table, nil
+}
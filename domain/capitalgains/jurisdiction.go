@@ -0,0 +1,166 @@
+package capitalgains
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+type Term string
+
+const (
+	ShortTerm Term = "SHORT"
+	LongTerm  Term = "LONG"
+)
+
+// RateDatePolicy tells on which dates foreign currency amounts are converted.
+type RateDatePolicy string
+
+const (
+	// TransactionDate converts the cost at the acquisition date and the proceeds at the disposal date
+	TransactionDate RateDatePolicy = "TRANSACTION_DATE"
+	// DisposalDate converts both the cost and the proceeds at the disposal date
+	DisposalDate RateDatePolicy = "DISPOSAL_DATE"
+)
+
+// Jurisdiction holds the tax rules a capital gains report is computed under.
+type Jurisdiction interface {
+	Code() string
+	// Currency is the currency gains are reported in, empty to use the base currency
+	Currency() string
+	// FiscalYear returns the first instant of the fiscal year and of the year after it
+	FiscalYear(year int) (time.Time, time.Time)
+	// Term classifies a holding period as short or long term
+	Term(acquiredAt, disposedAt time.Time) Term
+	// RateDates returns the dates the cost and the proceeds are converted at
+	RateDates(acquiredAt, disposedAt time.Time) (time.Time, time.Time)
+	// Exemption returns why the gain of a line is tax free, or an empty string when it is not
+	Exemption(line *Line) string
+}
+
+// Rules is a Jurisdiction described by a handful of parameters, which covers most
+// regimes that tax disposals by holding period.
+type Rules struct {
+	JurisdictionCode string
+	ReportCurrency   string
+	// FiscalYearStart is the month and day the fiscal year starts on, January 1 when zero
+	FiscalYearStart time.Month
+	FiscalYearDay   int
+	// LongTermMonths is the holding period a disposal must exceed to be long term
+	LongTermMonths int
+	RateDate       RateDatePolicy
+	// ExemptLongTerm lists the asset types whose long term gains are tax free
+	ExemptLongTerm []asset.AssetType
+}
+
+func (r *Rules) Code() string {
+	return r.JurisdictionCode
+}
+
+func (r *Rules) Currency() string {
+	return r.ReportCurrency
+}
+
+func (r *Rules) FiscalYear(year int) (time.Time, time.Time) {
+	month, day := r.FiscalYearStart, r.FiscalYearDay
+	if month == 0 {
+		month = time.January
+	}
+	if day == 0 {
+		day = 1
+	}
+	from := time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+	return from, from.AddDate(1, 0, 0)
+}
+
+func (r *Rules) Term(acquiredAt, disposedAt time.Time) Term {
+	if disposedAt.After(acquiredAt.AddDate(0, r.LongTermMonths, 0)) {
+		return LongTerm
+	}
+	return ShortTerm
+}
+
+func (r *Rules) RateDates(acquiredAt, disposedAt time.Time) (time.Time, time.Time) {
+	if r.RateDate == DisposalDate {
+		return disposedAt, disposedAt
+	}
+	return acquiredAt, disposedAt
+}
+
+func (r *Rules) Exemption(line *Line) string {
+	if line.Term != LongTerm {
+		return ""
+	}
+	for _, assetType := range r.ExemptLongTerm {
+		if line.AssetType == assetType {
+			return "long term " + strings.ToLower(string(assetType)) + " disposal"
+		}
+	}
+	return ""
+}
+
+var (
+	jurisdictionsMu sync.RWMutex
+	jurisdictions   = map[string]Jurisdiction{}
+)
+
+// Register makes a jurisdiction available to reports under its code.
+func Register(j Jurisdiction) {
+	jurisdictionsMu.Lock()
+	defer jurisdictionsMu.Unlock()
+	jurisdictions[strings.ToUpper(j.Code())] = j
+}
+
+func Lookup(code string) (Jurisdiction, bool) {
+	jurisdictionsMu.RLock()
+	defer jurisdictionsMu.RUnlock()
+	j, ok := jurisdictions[strings.ToUpper(code)]
+	return j, ok
+}
+
+// Codes lists the registered jurisdictions.
+func Codes() []string {
+	jurisdictionsMu.RLock()
+	defer jurisdictionsMu.RUnlock()
+	codes := make([]string, 0, len(jurisdictions))
+	for code := range jurisdictions {
+		codes = append(codes, code)
+	}
+	sort.Strings(codes)
+	return codes
+}
+
+func init() {
+	// GENERIC splits gains at one year without any exemption, in the base currency
+	Register(&Rules{
+		JurisdictionCode: "GENERIC",
+		LongTermMonths:   12,
+		RateDate:         TransactionDate,
+	})
+	Register(&Rules{
+		JurisdictionCode: "US",
+		ReportCurrency:   "USD",
+		LongTermMonths:   12,
+		RateDate:         TransactionDate,
+	})
+	// The UK tax year runs from April 6; the holding period only informs the report
+	Register(&Rules{
+		JurisdictionCode: "UK",
+		ReportCurrency:   "GBP",
+		FiscalYearStart:  time.April,
+		FiscalYearDay:    6,
+		LongTermMonths:   12,
+		RateDate:         TransactionDate,
+	})
+	// Private sales of crypto assets held for more than a year are tax free in Germany
+	Register(&Rules{
+		JurisdictionCode: "DE",
+		ReportCurrency:   "EUR",
+		LongTermMonths:   12,
+		RateDate:         TransactionDate,
+		ExemptLongTerm:   []asset.AssetType{asset.Cryptocurrency},
+	})
+}
@@ -0,0 +1,109 @@
+package capitalgains
+
+import (
+	"testing"
+	"time"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func jurisdiction(t *testing.T, code string) Jurisdiction {
+	t.Helper()
+	j, ok := Lookup(code)
+	if !ok {
+		t.Fatalf("jurisdiction %s is not registered", code)
+	}
+	return j
+}
+
+func TestTerm(t *testing.T) {
+	acquired := date(2023, 3, 15)
+
+	tests := []struct {
+		name       string
+		rules      Rules
+		disposedAt time.Time
+		want       Term
+	}{
+		{"same day", Rules{LongTermMonths: 12}, acquired, ShortTerm},
+		{"exactly a year", Rules{LongTermMonths: 12}, date(2024, 3, 15), ShortTerm},
+		{"more than a year", Rules{LongTermMonths: 12}, date(2024, 3, 16), LongTerm},
+		{"later on the anniversary", Rules{LongTermMonths: 12}, date(2024, 3, 15).Add(time.Hour), LongTerm},
+		{"shorter holding period", Rules{LongTermMonths: 6}, date(2023, 9, 16), LongTerm},
+		{"no holding period", Rules{}, acquired.Add(time.Second), LongTerm},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.rules.Term(acquired, tt.disposedAt); got != tt.want {
+				t.Errorf("Term = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFiscalYear(t *testing.T) {
+	tests := []struct {
+		code     string
+		from, to time.Time
+	}{
+		{"GENERIC", date(2024, 1, 1), date(2025, 1, 1)},
+		{"us", date(2024, 1, 1), date(2025, 1, 1)},
+		{"UK", date(2024, 4, 6), date(2025, 4, 6)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			from, to := jurisdiction(t, tt.code).FiscalYear(2024)
+			if !from.Equal(tt.from) || !to.Equal(tt.to) {
+				t.Errorf("FiscalYear(2024) = %v to %v, want %v to %v", from, to, tt.from, tt.to)
+			}
+		})
+	}
+}
+
+func TestExemption(t *testing.T) {
+	tests := []struct {
+		name      string
+		code      string
+		assetType asset.AssetType
+		term      Term
+		exempt    bool
+	}{
+		{"long term crypto in Germany", "DE", asset.Cryptocurrency, LongTerm, true},
+		{"short term crypto in Germany", "DE", asset.Cryptocurrency, ShortTerm, false},
+		{"long term stock in Germany", "DE", asset.Stock, LongTerm, false},
+		{"long term crypto in the US", "US", asset.Cryptocurrency, LongTerm, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := jurisdiction(t, tt.code).Exemption(&Line{AssetType: tt.assetType, Term: tt.term})
+			if (reason != "") != tt.exempt {
+				t.Errorf("Exemption = %q, want exempt %v", reason, tt.exempt)
+			}
+		})
+	}
+}
+
+func TestRateDates(t *testing.T) {
+	acquired, disposed := date(2023, 3, 15), date(2024, 5, 1)
+
+	tests := []struct {
+		policy             RateDatePolicy
+		costAt, proceedsAt time.Time
+	}{
+		{TransactionDate, acquired, disposed},
+		{DisposalDate, disposed, disposed},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.policy), func(t *testing.T) {
+			rules := &Rules{RateDate: tt.policy}
+			cost, proceeds := rules.RateDates(acquired, disposed)
+			if !cost.Equal(tt.costAt) || !proceeds.Equal(tt.proceedsAt) {
+				t.Errorf("RateDates = %v and %v, want %v and %v", cost, proceeds, tt.costAt, tt.proceedsAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package capitalgains
+
+type GetReportQuery struct {
+	UserID       string `json:"userId" validate:"required"`
+	Year         int    `json:"year" validate:"required"`
+	Jurisdiction string `json:"jurisdiction,omitempty"`
+	Currency     string `json:"currency,omitempty"`
+}
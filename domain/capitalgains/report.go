@@ -0,0 +1,160 @@
+package capitalgains
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/lot"
+)
+
+// Sale is a lot disposal made by a sale, with the asset it was sold from.
+type Sale struct {
+	lot.Disposal
+	Symbol           string          `db:"symbol"`
+	AssetName        string          `db:"asset_name"`
+	AssetType        asset.AssetType `db:"asset_type"`
+	AccountName      string          `db:"account_name"`
+	ProceedsCurrency string          `db:"proceeds_currency"`
+}
+
+// Line is the realized gain of one disposed lot. Proceeds, Cost and Gain are in the
+// currency the lot was bought in; the Report amounts are in the report currency and
+// stay zero when Converted is false.
+type Line struct {
+	DisposalID       uuid.UUID       `json:"disposalId"`
+	LotID            uuid.UUID       `json:"lotId"`
+	AssetID          uuid.UUID       `json:"assetId"`
+	TransactionID    uuid.UUID       `json:"transactionId"`
+	Symbol           string          `json:"symbol"`
+	AssetName        string          `json:"assetName"`
+	AssetType        asset.AssetType `json:"assetType"`
+	AccountName      string          `json:"accountName"`
+	Quantity         float64         `json:"quantity"`
+	AcquiredAt       time.Time       `json:"acquiredAt"`
+	DisposedAt       time.Time       `json:"disposedAt"`
+	HoldingDays      int             `json:"holdingDays"`
+	Term             Term            `json:"term"`
+	Currency         string          `json:"currency"`
+	ProceedsCurrency string          `json:"proceedsCurrency"`
+	Proceeds         float64         `json:"proceeds"`
+	Cost             float64         `json:"cost"`
+	Gain             float64         `json:"gain"`
+	Converted        bool            `json:"converted"`
+	ReportProceeds   float64         `json:"reportProceeds"`
+	ReportCost       float64         `json:"reportCost"`
+	ReportGain       float64         `json:"reportGain"`
+	Exempt           bool            `json:"exempt"`
+	ExemptReason     string          `json:"exemptReason,omitempty"`
+}
+
+func newLine(s *Sale, j Jurisdiction) *Line {
+	line := &Line{
+		DisposalID:       s.ID,
+		LotID:            s.LotID,
+		AssetID:          s.AssetID,
+		TransactionID:    s.TransactionID,
+		Symbol:           s.Symbol,
+		AssetName:        s.AssetName,
+		AssetType:        s.AssetType,
+		AccountName:      s.AccountName,
+		Quantity:         s.Quantity,
+		AcquiredAt:       s.AcquiredAt,
+		DisposedAt:       s.DisposedAt,
+		HoldingDays:      int(s.DisposedAt.Sub(s.AcquiredAt).Hours() / 24),
+		Term:             j.Term(s.AcquiredAt, s.DisposedAt),
+		Currency:         s.Currency,
+		ProceedsCurrency: s.ProceedsCurrency,
+		Proceeds:         s.Proceeds(),
+		Cost:             s.Cost(),
+		Gain:             s.Gain(),
+	}
+	if line.ProceedsCurrency == "" {
+		line.ProceedsCurrency = line.Currency
+	}
+	if reason := j.Exemption(line); reason != "" {
+		line.Exempt = true
+		line.ExemptReason = reason
+	}
+	return line
+}
+
+// convert sets the report amounts from the proceeds and cost already converted into the
+// report currency.
+func (l *Line) convert(proceeds, cost float64) {
+	l.Converted = true
+	l.ReportProceeds = proceeds
+	l.ReportCost = cost
+	l.ReportGain = proceeds - cost
+}
+
+// TermTotals adds up the converted lines of one holding period.
+type TermTotals struct {
+	Count    int     `json:"count"`
+	Proceeds float64 `json:"proceeds"`
+	Cost     float64 `json:"cost"`
+	Gains    float64 `json:"gains"`
+	Losses   float64 `json:"losses"`
+	Net      float64 `json:"net"`
+}
+
+func (t *TermTotals) add(l *Line) {
+	t.Count++
+	t.Proceeds += l.ReportProceeds
+	t.Cost += l.ReportCost
+	if l.ReportGain >= 0 {
+		t.Gains += l.ReportGain
+	} else {
+		t.Losses -= l.ReportGain
+	}
+	t.Net += l.ReportGain
+}
+
+// Report lists the realized gains of a fiscal year under a jurisdiction. Totals only
+// cover converted lines; lines whose currency cannot be converted are counted in
+// Unconverted.
+type Report struct {
+	Year         int        `json:"year"`
+	Jurisdiction string     `json:"jurisdiction"`
+	Currency     string     `json:"currency"`
+	From         time.Time  `json:"from"`
+	To           time.Time  `json:"to"`
+	Lines        []*Line    `json:"lines"`
+	ShortTerm    TermTotals `json:"shortTerm"`
+	LongTerm     TermTotals `json:"longTerm"`
+	ExemptGain   float64    `json:"exemptGain"`
+	NetGain      float64    `json:"netGain"`
+	TaxableGain  float64    `json:"taxableGain"`
+	Unconverted  int        `json:"unconverted"`
+}
+
+func newReport(year int, j Jurisdiction, currency string, from, to time.Time) *Report {
+	return &Report{
+		Year:         year,
+		Jurisdiction: j.Code(),
+		Currency:     currency,
+		From:         from,
+		To:           to,
+		Lines:        []*Line{},
+	}
+}
+
+func (r *Report) add(l *Line) {
+	r.Lines = append(r.Lines, l)
+	if !l.Converted {
+		r.Unconverted++
+		return
+	}
+	if l.Term == LongTerm {
+		r.LongTerm.add(l)
+	} else {
+		r.ShortTerm.add(l)
+	}
+	r.NetGain += l.ReportGain
+	if l.Exempt {
+		r.ExemptGain += l.ReportGain
+		return
+	}
+	r.TaxableGain += l.ReportGain
+}
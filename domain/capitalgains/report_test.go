@@ -0,0 +1,75 @@
+package capitalgains
+
+import (
+	"testing"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/lot"
+)
+
+func TestReportAdd(t *testing.T) {
+	j := jurisdiction(t, "DE")
+	sale := func(assetType asset.AssetType, acquiredYear int, unitProceeds float64) *Sale {
+		return &Sale{
+			Disposal: lot.Disposal{
+				Kind:         lot.Sale,
+				Quantity:     2,
+				UnitCost:     100,
+				UnitProceeds: unitProceeds,
+				Currency:     "EUR",
+				AcquiredAt:   date(acquiredYear, 6, 1),
+				DisposedAt:   date(2024, 5, 1),
+			},
+			AssetType: assetType,
+		}
+	}
+
+	tests := []struct {
+		name      string
+		sale      *Sale
+		converted bool
+		term      Term
+		exempt    bool
+		taxable   float64
+	}{
+		{"short term gain", sale(asset.Stock, 2023, 150), true, ShortTerm, false, 100},
+		{"short term loss", sale(asset.Stock, 2023, 80), true, ShortTerm, false, -40},
+		{"long term gain", sale(asset.Stock, 2020, 150), true, LongTerm, false, 100},
+		{"exempt long term gain", sale(asset.Cryptocurrency, 2020, 150), true, LongTerm, true, 0},
+		{"unconverted line", sale(asset.Stock, 2023, 150), false, ShortTerm, false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newReport(2024, j, "EUR", date(2024, 1, 1), date(2025, 1, 1))
+			line := newLine(tt.sale, j)
+			if tt.converted {
+				line.convert(line.Proceeds, line.Cost)
+			}
+
+			r.add(line)
+
+			if line.Term != tt.term || line.Exempt != tt.exempt {
+				t.Errorf("line is %s exempt %v, want %s exempt %v", line.Term, line.Exempt, tt.term, tt.exempt)
+			}
+			if r.TaxableGain != tt.taxable {
+				t.Errorf("TaxableGain = %v, want %v", r.TaxableGain, tt.taxable)
+			}
+			if !tt.converted {
+				if r.Unconverted != 1 || r.ShortTerm.Count != 0 {
+					t.Errorf("unconverted line counted in the totals")
+				}
+				return
+			}
+			totals := r.ShortTerm
+			if tt.term == LongTerm {
+				totals = r.LongTerm
+			}
+			if totals.Count != 1 || totals.Net != line.Gain || r.NetGain != line.Gain {
+				t.Errorf("%s totals %+v and net gain %v, want one line of %v", tt.term, totals, r.NetGain, line.Gain)
+			}
+			if tt.exempt && r.ExemptGain != line.Gain {
+				t.Errorf("ExemptGain = %v, want %v", r.ExemptGain, line.Gain)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+package capitalgains
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// GetSales returns the disposals of the user made by sales between from (inclusive)
+	// and to (exclusive). Transfers and withdrawals are not sales.
+	GetSales(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*Sale, error)
+}
@@ -12,5 +12,9 @@ type Repository interface {
 	Delete(ctx context.Context, id uuid.UUID) error
 	GetByID(ctx context.Context, id uuid.UUID) (*Definition, error)
 	GetAll(ctx context.Context, limit, offset int) ([]*Definition, error)
+	// GetByAbbreviations returns the shared definitions whose abbreviation matches one of
+	// the given ones, ignoring case. Definitions owned by a user are left out, as they
+	// are by GetAll and Search.
+	GetByAbbreviations(ctx context.Context, abbreviations []string) ([]*Definition, error)
 	Search(ctx context.Context, searchTerm string, limit, offset int, definitionType string) ([]*Definition, error)
 }
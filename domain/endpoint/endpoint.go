@@ -0,0 +1,78 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"net/url"
+)
+
+var (
+	ErrInvalidURL   = errors.New("must be an http or https URL")
+	ErrNotPublic    = errors.New("must point to a public address")
+	ErrUnresolvable = errors.New("has a host that cannot be resolved")
+)
+
+// reserved holds the ranges that are neither private nor loopback by the standard
+// library's definition but still never reach a public service.
+var reserved = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("64:ff9b:1::/48"),
+}
+
+// IsPublic reports whether addr is a globally routable unicast address. Loopback,
+// private, link-local (which holds the cloud metadata address 169.254.169.254),
+// unspecified, multicast and shared carrier-grade NAT addresses are not.
+func IsPublic(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	if !addr.IsValid() || addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsMulticast() {
+		return false
+	}
+	for _, prefix := range reserved {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+	return true
+}
+
+// Resolve looks up host and returns its addresses. It fails when any of them is not
+// public, so that a name cannot list an internal service next to a public one.
+func Resolve(ctx context.Context, host string) ([]netip.Addr, error) {
+	if addr, err := netip.ParseAddr(host); err == nil {
+		if !IsPublic(addr) {
+			return nil, ErrNotPublic
+		}
+		return []netip.Addr{addr}, nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupNetIP(ctx, "ip", host)
+	if err != nil || len(addrs) == 0 {
+		return nil, ErrUnresolvable
+	}
+	for _, addr := range addrs {
+		if !IsPublic(addr) {
+			return nil, ErrNotPublic
+		}
+	}
+	return addrs, nil
+}
+
+// Validate checks that rawURL is an http or https URL whose host resolves to public
+// addresses only. Requests to it must still be dialled through Resolve, because the
+// name can point somewhere else by the time they are sent.
+func Validate(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" {
+		return ErrInvalidURL
+	}
+	_, err = Resolve(ctx, u.Hostname())
+	return err
+}
@@ -0,0 +1,66 @@
+package endpoint
+
+import (
+	"context"
+	"errors"
+	"net/netip"
+	"testing"
+)
+
+func TestIsPublic(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.1.2.3", false},
+		{"172.16.0.1", false},
+		{"192.168.1.10", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"fd00::1", false},
+		{"0.0.0.0", false},
+		{"::", false},
+		{"100.64.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:93.184.216.34", true},
+		{"64:ff9b::a00:1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.addr, func(t *testing.T) {
+			if got := IsPublic(netip.MustParseAddr(tt.addr)); got != tt.want {
+				t.Errorf("IsPublic(%s) = %v, want %v", tt.addr, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name string
+		url  string
+		want error
+	}{
+		{"public address", "https://93.184.216.34/hook", nil},
+		{"public address with port", "http://93.184.216.34:8080/hook", nil},
+		{"loopback", "http://127.0.0.1/hook", ErrNotPublic},
+		{"loopback ipv6", "http://[::1]:9000/hook", ErrNotPublic},
+		{"metadata", "http://169.254.169.254/latest/meta-data", ErrNotPublic},
+		{"private", "https://10.0.0.5/hook", ErrNotPublic},
+		{"other scheme", "ftp://93.184.216.34/hook", ErrInvalidURL},
+		{"no host", "https:///hook", ErrInvalidURL},
+		{"not a url", "::", ErrInvalidURL},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := Validate(context.Background(), tt.url); !errors.Is(err, tt.want) {
+				t.Errorf("Validate(%q) = %v, want %v", tt.url, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package event
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Type names a domain event as resource.action. Account and asset events are published
+// by their handlers. Transaction events are written to the Outbox by the ledger itself, so
+// they also cover the entries made by transfers, imports, recurring rules, term deposit
+// settlements and loan and receivable payments. Restoring an archive publishes nothing.
+type Type string
+
+const (
+	AccountCreated     Type = "account.created"
+	AccountUpdated     Type = "account.updated"
+	AccountDeleted     Type = "account.deleted"
+	AssetCreated       Type = "asset.created"
+	AssetUpdated       Type = "asset.updated"
+	AssetDeleted       Type = "asset.deleted"
+	AssetTransferred   Type = "asset.transferred"
+	TransactionCreated Type = "transaction.created"
+	TransactionDeleted Type = "transaction.deleted"
+)
+
+// Types lists every event that is published.
+var Types = []Type{
+	AccountCreated,
+	AccountUpdated,
+	AccountDeleted,
+	AssetCreated,
+	AssetUpdated,
+	AssetDeleted,
+	AssetTransferred,
+	TransactionCreated,
+	TransactionDeleted,
+}
+
+func (t Type) IsValid() bool {
+	for _, known := range Types {
+		if t == known {
+			return true
+		}
+	}
+	return false
+}
+
+// Event is a change to the data of a user. Data is the resource after the change, or
+// as it was before a deletion.
+type Event struct {
+	ID         uuid.UUID   `json:"id"`
+	Type       Type        `json:"type"`
+	UserID     uuid.UUID   `json:"userId"`
+	OccurredAt time.Time   `json:"occurredAt"`
+	Data       interface{} `json:"data"`
+}
+
+func New(t Type, userID uuid.UUID, data interface{}) *Event {
+	return &Event{
+		ID:         uuid.New(),
+		Type:       t,
+		UserID:     userID,
+		OccurredAt: time.Now(),
+		Data:       data,
+	}
+}
+
+// Listener is told about an event right after the change was stored.
+type Listener func(ctx context.Context, e *Event)
+
+// Publisher hands the events of a handler to its listeners. Handlers embed it.
+type Publisher struct {
+	listeners []Listener
+}
+
+// OnEvent registers a listener for every event the handler publishes.
+func (p *Publisher) OnEvent(listener Listener) {
+	p.listeners = append(p.listeners, listener)
+}
+
+func (p *Publisher) Publish(ctx context.Context, t Type, userID uuid.UUID, data interface{}) {
+	if len(p.listeners) == 0 {
+		return
+	}
+	e := New(t, userID, data)
+	for _, listener := range p.listeners {
+		listener(ctx, e)
+	}
+}
@@ -0,0 +1,14 @@
+package event
+
+import (
+	"context"
+)
+
+// Outbox holds the events written in the same database transaction as the change they
+// describe, so that an event is stored exactly when its change is.
+type Outbox interface {
+	// Relay hands up to limit pending events, oldest first, to publish and removes each
+	// one publish accepted. The first failure stops the run and leaves that event and
+	// the ones after it for the next run.
+	Relay(ctx context.Context, limit int, publish func(ctx context.Context, e *Event) error) (int, error)
+}
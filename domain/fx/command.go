@@ -0,0 +1,13 @@
+package fx
+
+type RateItem struct {
+	BaseCurrency  string  `json:"baseCurrency" validate:"required"`
+	QuoteCurrency string  `json:"quoteCurrency" validate:"required"`
+	Rate          float64 `json:"rate" validate:"required"`
+	ObservedAt    int64   `json:"observedAt" validate:"required"`
+	Source        string  `json:"source"`
+}
+
+type RecordRatesCommand struct {
+	Rates []RateItem `json:"rates" validate:"required"`
+}
@@ -0,0 +1,40 @@
+package fx
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Rate says that one unit of BaseCurrency buys Rate units of QuoteCurrency.
+type Rate struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	BaseCurrency  string    `json:"baseCurrency" db:"base_currency"`
+	QuoteCurrency string    `json:"quoteCurrency" db:"quote_currency"`
+	Rate          float64   `json:"rate" db:"rate"`
+	ObservedAt    time.Time `json:"observedAt" db:"observed_at"`
+	Source        string    `json:"source" db:"source"`
+	CreatedAt     time.Time `json:"createdAt" db:"created_at"`
+}
+
+type Conversion struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      float64   `json:"rate"`
+	Amount    float64   `json:"amount"`
+	Converted float64   `json:"converted"`
+	At        time.Time `json:"at"`
+}
+
+func NewRate(item RateItem) *Rate {
+	return &Rate{
+		ID:            uuid.New(),
+		BaseCurrency:  strings.ToUpper(item.BaseCurrency),
+		QuoteCurrency: strings.ToUpper(item.QuoteCurrency),
+		Rate:          item.Rate,
+		ObservedAt:    time.Unix(item.ObservedAt, 0),
+		Source:        item.Source,
+		CreatedAt:     time.Now(),
+	}
+}
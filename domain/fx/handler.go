@@ -0,0 +1,85 @@
+package fx
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+)
+
+type Handler struct {
+	repo  Repository
+	pivot string
+}
+
+func NewHandler(repo Repository, pivot string) *Handler {
+	return &Handler{
+		repo:  repo,
+		pivot: pivot,
+	}
+}
+
+func (h *Handler) HandleRecordRatesCommand(ctx context.Context, command RecordRatesCommand) ([]*Rate, error) {
+	if len(command.Rates) == 0 {
+		return nil, errors.New("at least one rate is required")
+	}
+
+	rates := make([]*Rate, 0, len(command.Rates))
+	for _, item := range command.Rates {
+		if strings.TrimSpace(item.BaseCurrency) == "" || strings.TrimSpace(item.QuoteCurrency) == "" {
+			return nil, errors.New("base and quote currency are required")
+		}
+		if strings.EqualFold(item.BaseCurrency, item.QuoteCurrency) {
+			return nil, errors.New("base and quote currency must differ")
+		}
+		if item.Rate <= 0 {
+			return nil, errors.New("rate must be positive")
+		}
+		if item.ObservedAt <= 0 {
+			return nil, errors.New("observed at is required")
+		}
+		rates = append(rates, NewRate(item))
+	}
+
+	if err := h.repo.BulkUpsert(ctx, rates); err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
+
+func (h *Handler) HandleConvertQuery(ctx context.Context, query ConvertQuery) (*Conversion, error) {
+	if query.From == "" || query.To == "" {
+		return nil, errors.New("from and to currency are required")
+	}
+	if query.At.IsZero() {
+		query.At = time.Now()
+	}
+
+	table, err := h.Table(ctx, query.At)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, ok := table.Rate(query.From, query.To)
+	if !ok {
+		return nil, errors.New("exchange rate not found")
+	}
+
+	return &Conversion{
+		From:      strings.ToUpper(query.From),
+		To:        strings.ToUpper(query.To),
+		Rate:      rate,
+		Amount:    query.Amount,
+		Converted: query.Amount * rate,
+		At:        query.At,
+	}, nil
+}
+
+// Table loads the rates known at the given time into a conversion table.
+func (h *Handler) Table(ctx context.Context, at time.Time) (*Table, error) {
+	rates, err := h.repo.GetLatestRates(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+	return NewTable(rates, h.pivot), nil
+}
@@ -0,0 +1,12 @@
+package fx
+
+import (
+	"time"
+)
+
+type ConvertQuery struct {
+	From   string    `json:"from" validate:"required"`
+	To     string    `json:"to" validate:"required"`
+	Amount float64   `json:"amount"`
+	At     time.Time `json:"at"`
+}
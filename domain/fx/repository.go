@@ -0,0 +1,12 @@
+package fx
+
+import (
+	"context"
+	"time"
+)
+
+type Repository interface {
+	BulkUpsert(ctx context.Context, rates []*Rate) error
+	// GetLatestRates returns the most recent rate of every currency pair observed at or before at.
+	GetLatestRates(ctx context.Context, at time.Time) ([]*Rate, error)
+}
@@ -0,0 +1,82 @@
+package fx
+
+import (
+	"strings"
+)
+
+// Table converts between currencies from a set of rates. A pair is resolved
+// from a direct rate, the inverse of the opposite rate, or by triangulating
+// through the pivot currency.
+type Table struct {
+	rates map[string]map[string]float64
+	pivot string
+}
+
+func NewTable(rates []*Rate, pivot string) *Table {
+	t := &Table{
+		rates: make(map[string]map[string]float64),
+		pivot: strings.ToUpper(pivot),
+	}
+
+	// Inverses first so that an explicitly recorded rate always wins
+	for _, r := range rates {
+		if r.Rate > 0 {
+			t.set(r.QuoteCurrency, r.BaseCurrency, 1/r.Rate, false)
+		}
+	}
+	for _, r := range rates {
+		if r.Rate > 0 {
+			t.set(r.BaseCurrency, r.QuoteCurrency, r.Rate, true)
+		}
+	}
+	return t
+}
+
+func (t *Table) set(from, to string, rate float64, overwrite bool) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if t.rates[from] == nil {
+		t.rates[from] = make(map[string]float64)
+	}
+	if _, exists := t.rates[from][to]; exists && !overwrite {
+		return
+	}
+	t.rates[from][to] = rate
+}
+
+// Rate returns how many units of to one unit of from buys.
+func (t *Table) Rate(from, to string) (float64, bool) {
+	from, to = strings.ToUpper(from), strings.ToUpper(to)
+	if from == to {
+		return 1, true
+	}
+	if rate, ok := t.rates[from][to]; ok {
+		return rate, true
+	}
+	if t.pivot == "" || from == t.pivot || to == t.pivot {
+		return 0, false
+	}
+
+	toPivot, ok := t.rates[from][t.pivot]
+	if !ok {
+		return 0, false
+	}
+	fromPivot, ok := t.rates[t.pivot][to]
+	if !ok {
+		return 0, false
+	}
+	return toPivot * fromPivot, true
+}
+
+func (t *Table) Convert(amount float64, from, to string) (float64, bool) {
+	rate, ok := t.Rate(from, to)
+	if !ok {
+		return 0, false
+	}
+	return amount * rate, true
+}
+
+// Knows reports whether the table has any rate for the currency.
+func (t *Table) Knows(currency string) bool {
+	_, ok := t.rates[strings.ToUpper(currency)]
+	return ok
+}
@@ -0,0 +1,68 @@
+package fx
+
+import (
+	"math"
+	"testing"
+)
+
+func TestTableRate(t *testing.T) {
+	table := NewTable([]*Rate{
+		{BaseCurrency: "USD", QuoteCurrency: "TRY", Rate: 32},
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: 1.08},
+		{BaseCurrency: "USD", QuoteCurrency: "JPY", Rate: 150},
+		// An explicit rate beats the inverse of the opposite one
+		{BaseCurrency: "JPY", QuoteCurrency: "USD", Rate: 0.0066},
+		{BaseCurrency: "GBP", QuoteCurrency: "CHF", Rate: 1.12},
+		{BaseCurrency: "XXX", QuoteCurrency: "USD", Rate: 0},
+	}, "usd")
+
+	tests := []struct {
+		name     string
+		from, to string
+		want     float64
+		ok       bool
+	}{
+		{"same currency", "TRY", "try", 1, true},
+		{"direct", "USD", "TRY", 32, true},
+		{"inverse", "TRY", "USD", 1.0 / 32, true},
+		{"explicit rate kept over the inverse", "JPY", "USD", 0.0066, true},
+		{"triangulated through the pivot", "EUR", "TRY", 1.08 * 32, true},
+		{"triangulated back", "TRY", "EUR", 1 / 32.0 / 1.08, true},
+		{"lower case", "eur", "try", 1.08 * 32, true},
+		{"no route through the pivot", "GBP", "TRY", 0, false},
+		{"unknown currency", "ABC", "USD", 0, false},
+		{"non-positive rates are ignored", "XXX", "USD", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := table.Rate(tt.from, tt.to)
+			if ok != tt.ok || math.Abs(got-tt.want) > 1e-12 {
+				t.Errorf("Rate(%s, %s) = %v, %v, want %v, %v", tt.from, tt.to, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestTableConvert(t *testing.T) {
+	table := NewTable([]*Rate{{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: 1.1}}, "USD")
+
+	tests := []struct {
+		name     string
+		amount   float64
+		from, to string
+		want     float64
+		ok       bool
+	}{
+		{"converts", 100, "EUR", "USD", 110, true},
+		{"converts back", 110, "USD", "EUR", 100, true},
+		{"unknown pair", 100, "EUR", "TRY", 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := table.Convert(tt.amount, tt.from, tt.to)
+			if ok != tt.ok || math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("Convert(%v, %s, %s) = %v, %v, want %v, %v", tt.amount, tt.from, tt.to, got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package importer
+
+import (
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+// ImportCSVCommand imports a CSV file of holdings or transactions. A dry run validates
+// and posts every row in a transaction that is rolled back, so the preview carries the
+// same per-row errors a real import would.
+type ImportCSVCommand struct {
+	UserID  string     `json:"userId" validate:"required"`
+	Data    []byte     `json:"-"`
+	Mapping Mapping    `json:"mapping"`
+	Options CSVOptions `json:"-"`
+	DryRun  bool       `json:"dryRun"`
+	// DefaultAccountID is used for rows without an account column or value
+	DefaultAccountID string `json:"defaultAccountId"`
+	// DefaultType is used for rows without a type, BUY when empty
+	DefaultType transaction.TransactionType `json:"defaultType"`
+	// DefaultAssetType is the type of assets created by the import, OTHER when empty
+	DefaultAssetType asset.AssetType `json:"defaultAssetType"`
+	// Currency is used for rows without a currency, the base currency when empty
+	Currency string `json:"currency"`
+}
+
+// ImportStatementCommand imports an OFX, QFX or QIF bank statement. Each statement account
+// goes to the given account, else to the one it was imported into before, else to an
+// existing account of the same name, else to a new account.
+type ImportStatementCommand struct {
+	UserID   string `json:"userId" validate:"required"`
+	Data     []byte `json:"-"`
+	FileName string `json:"fileName"`
+	// Format is OFX or QIF, detected from the file when empty
+	Format    Format `json:"format"`
+	DryRun    bool   `json:"dryRun"`
+	AccountID string `json:"accountId"`
+	// Currency is used for statements that do not state one, the base currency when empty
+	Currency string     `json:"currency"`
+	Options  QIFOptions `json:"-"`
+}
@@ -0,0 +1,212 @@
+package importer
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+// MaxRows caps how many lines a single import may contain.
+const MaxRows = 10000
+
+// Mapping names the CSV column holding each field, either by its header or by its
+// 1-based position. Date, symbol and quantity are required; the others fall back to
+// the defaults of the import.
+type Mapping struct {
+	Date      string `json:"date"`
+	Symbol    string `json:"symbol"`
+	Quantity  string `json:"quantity"`
+	Price     string `json:"price"`
+	Account   string `json:"account"`
+	Type      string `json:"type"`
+	Currency  string `json:"currency"`
+	AssetType string `json:"assetType"`
+	Notes     string `json:"notes"`
+}
+
+// CSVOptions describes the layout of the file.
+type CSVOptions struct {
+	Delimiter rune
+	HasHeader bool
+	// DateFormat is a Go time layout; without it common formats are tried in turn
+	DateFormat string
+	// DecimalComma reads 1.234,56 instead of 1,234.56
+	DecimalComma bool
+}
+
+var dateFormats = []string{
+	time.RFC3339,
+	"2006-01-02",
+	"2006-01-02 15:04:05",
+	"2006-01-02T15:04:05",
+	"02.01.2006",
+	"02.01.2006 15:04:05",
+}
+
+// columns holds the resolved position of every mapped field, -1 when not mapped.
+type columns struct {
+	date, symbol, quantity, price, account, transactionType, currency, assetType, notes int
+}
+
+// ParseCSV reads the file into rows. Problems with a single line are recorded on its row;
+// only an unreadable file or an unusable mapping fails the whole parse.
+func ParseCSV(r io.Reader, mapping Mapping, options CSVOptions) ([]*Row, error) {
+	reader := csv.NewReader(r)
+	if options.Delimiter != 0 {
+		reader.Comma = options.Delimiter
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid CSV: %w", err)
+	}
+
+	var header []string
+	firstLine := 1
+	if options.HasHeader {
+		if len(records) == 0 {
+			return nil, errors.New("file has no header")
+		}
+		header = records[0]
+		records = records[1:]
+		firstLine = 2
+	}
+	if len(records) == 0 {
+		return nil, errors.New("file has no rows")
+	}
+	if len(records) > MaxRows {
+		return nil, fmt.Errorf("file has more than %d rows", MaxRows)
+	}
+
+	cols, err := resolveColumns(mapping, header)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]*Row, 0, len(records))
+	for i, record := range records {
+		rows = append(rows, parseRecord(firstLine+i, record, cols, options))
+	}
+	return rows, nil
+}
+
+func resolveColumns(mapping Mapping, header []string) (columns, error) {
+	if strings.TrimSpace(mapping.Date) == "" || strings.TrimSpace(mapping.Symbol) == "" || strings.TrimSpace(mapping.Quantity) == "" {
+		return columns{}, errors.New("mapping must name the date, symbol and quantity columns")
+	}
+
+	var errs []error
+	resolve := func(field, name string) int {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return -1
+		}
+		for i, h := range header {
+			if strings.EqualFold(strings.TrimSpace(h), name) {
+				return i
+			}
+		}
+		if position, err := strconv.Atoi(name); err == nil && position > 0 {
+			return position - 1
+		}
+		errs = append(errs, fmt.Errorf("column %q mapped to %s not found", name, field))
+		return -1
+	}
+
+	cols := columns{
+		date:            resolve("date", mapping.Date),
+		symbol:          resolve("symbol", mapping.Symbol),
+		quantity:        resolve("quantity", mapping.Quantity),
+		price:           resolve("price", mapping.Price),
+		account:         resolve("account", mapping.Account),
+		transactionType: resolve("type", mapping.Type),
+		currency:        resolve("currency", mapping.Currency),
+		assetType:       resolve("assetType", mapping.AssetType),
+		notes:           resolve("notes", mapping.Notes),
+	}
+	return cols, errors.Join(errs...)
+}
+
+func parseRecord(line int, record []string, cols columns, options CSVOptions) *Row {
+	row := &Row{Line: line}
+	field := func(index int) string {
+		if index < 0 || index >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[index])
+	}
+
+	if value := field(cols.date); value == "" {
+		row.Fail("date is required")
+	} else if date, err := parseDate(value, options.DateFormat); err != nil {
+		row.Fail(fmt.Sprintf("invalid date %q", value))
+	} else {
+		row.Date = date
+	}
+
+	row.Symbol = strings.ToUpper(field(cols.symbol))
+	if row.Symbol == "" {
+		row.Fail("symbol is required")
+	}
+
+	if value := field(cols.quantity); value == "" {
+		row.Fail("quantity is required")
+	} else if quantity, err := parseNumber(value, options.DecimalComma); err != nil {
+		row.Fail(fmt.Sprintf("invalid quantity %q", value))
+	} else if quantity == 0 {
+		row.Fail("quantity must not be zero")
+	} else {
+		row.Quantity = quantity
+	}
+
+	if value := field(cols.price); value != "" {
+		price, err := parseNumber(value, options.DecimalComma)
+		if err != nil || price < 0 {
+			row.Fail(fmt.Sprintf("invalid price %q", value))
+		} else {
+			row.Price = price
+		}
+	}
+
+	row.Account = field(cols.account)
+	row.Type = transaction.TransactionType(strings.ToUpper(field(cols.transactionType)))
+	row.Currency = strings.ToUpper(field(cols.currency))
+	row.AssetType = asset.AssetType(strings.ToUpper(field(cols.assetType)))
+	row.Notes = field(cols.notes)
+	return row
+}
+
+func parseDate(value, layout string) (time.Time, error) {
+	if layout != "" {
+		return time.Parse(layout, value)
+	}
+	for _, format := range dateFormats {
+		if date, err := time.Parse(format, value); err == nil {
+			return date, nil
+		}
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return time.Unix(seconds, 0), nil
+	}
+	return time.Time{}, errors.New("unknown date format")
+}
+
+func parseNumber(value string, decimalComma bool) (float64, error) {
+	value = strings.ReplaceAll(value, " ", "")
+	if decimalComma {
+		value = strings.ReplaceAll(value, ".", "")
+		value = strings.ReplaceAll(value, ",", ".")
+	} else {
+		value = strings.ReplaceAll(value, ",", "")
+	}
+	return strconv.ParseFloat(value, 64)
+}
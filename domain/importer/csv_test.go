@@ -0,0 +1,137 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		value        string
+		decimalComma bool
+		want         float64
+		err          bool
+	}{
+		{"1234.56", false, 1234.56, false},
+		{"1,234.56", false, 1234.56, false},
+		{"1 234.56", false, 1234.56, false},
+		{"1.234,56", true, 1234.56, false},
+		{"-0,5", true, -0.5, false},
+		{"12a", false, 0, true},
+	}
+	for _, tt := range tests {
+		got, err := parseNumber(tt.value, tt.decimalComma)
+		if (err != nil) != tt.err || got != tt.want {
+			t.Errorf("parseNumber(%q, %v) = %v, %v, want %v", tt.value, tt.decimalComma, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		layout string
+		want   time.Time
+		err    bool
+	}{
+		{"2024-03-15", "", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), false},
+		{"2024-03-15T10:30:00Z", "", time.Date(2024, 3, 15, 10, 30, 0, 0, time.UTC), false},
+		{"15.03.2024", "", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), false},
+		{"1710460800", "", time.Unix(1710460800, 0), false},
+		{"03/15/2024", "01/02/2006", time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), false},
+		{"03/15/2024", "", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseDate(tt.value, tt.layout)
+		if (err != nil) != tt.err || !got.Equal(tt.want) {
+			t.Errorf("parseDate(%q, %q) = %v, %v, want %v", tt.value, tt.layout, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseCSV(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		mapping Mapping
+		options CSVOptions
+		rows    int
+		first   float64
+		errors  map[int]string
+		err     string
+	}{
+		{
+			name:    "columns by header",
+			data:    "Date,Ticker,Qty,Price\n2024-03-15,btc,0.5,60000\n2024-03-16,eth,2,3000\n",
+			mapping: Mapping{Date: "date", Symbol: "ticker", Quantity: "qty", Price: "price"},
+			options: CSVOptions{HasHeader: true},
+			rows:    2,
+			first:   0.5,
+		},
+		{
+			name:    "columns by position with a decimal comma",
+			data:    "15.03.2024;BTC;0,5\n",
+			mapping: Mapping{Date: "1", Symbol: "2", Quantity: "3"},
+			options: CSVOptions{Delimiter: ';', DecimalComma: true},
+			rows:    1,
+			first:   0.5,
+		},
+		{
+			name:    "line problems are kept on the row",
+			data:    "date,symbol,quantity,price\nyesterday,BTC,1,1\n2024-03-15,,0,-1\n",
+			mapping: Mapping{Date: "date", Symbol: "symbol", Quantity: "quantity", Price: "price"},
+			options: CSVOptions{HasHeader: true},
+			rows:    2,
+			errors:  map[int]string{2: `invalid date "yesterday"`, 3: "symbol is required; quantity must not be zero; invalid price \"-1\""},
+		},
+		{
+			name:    "required mapping",
+			data:    "2024-03-15,BTC,1\n",
+			mapping: Mapping{Date: "1", Symbol: "2"},
+			err:     "mapping must name the date, symbol and quantity columns",
+		},
+		{
+			name:    "unknown column",
+			data:    "date,symbol,quantity\n2024-03-15,BTC,1\n",
+			mapping: Mapping{Date: "date", Symbol: "symbol", Quantity: "amount"},
+			options: CSVOptions{HasHeader: true},
+			err:     `column "amount" mapped to quantity not found`,
+		},
+		{
+			name:    "header only",
+			data:    "date,symbol,quantity\n",
+			mapping: Mapping{Date: "date", Symbol: "symbol", Quantity: "quantity"},
+			options: CSVOptions{HasHeader: true},
+			err:     "file has no rows",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, err := ParseCSV(strings.NewReader(tt.data), tt.mapping, tt.options)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("ParseCSV error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseCSV: %v", err)
+			}
+			if len(rows) != tt.rows {
+				t.Fatalf("ParseCSV returned %d rows, want %d", len(rows), tt.rows)
+			}
+			if tt.first != 0 && rows[0].Quantity != tt.first {
+				t.Errorf("first quantity = %v, want %v", rows[0].Quantity, tt.first)
+			}
+			for _, row := range rows {
+				if got := strings.Join(row.Errors, "; "); got != tt.errors[row.Line] {
+					t.Errorf("line %d errors = %q, want %q", row.Line, got, tt.errors[row.Line])
+				}
+				if row.Valid() && (row.Symbol != strings.ToUpper(row.Symbol) || row.Quantity == 0 || row.Date.IsZero()) {
+					t.Errorf("line %d parsed as %+v", row.Line, row)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,337 @@
+package importer
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type Handler struct {
+	repo                Repository
+	accountRepo         account.Repository
+	definitionRepo      definition.Repository
+	defaultBaseCurrency string
+}
+
+func NewHandler(repo Repository, accountRepo account.Repository, definitionRepo definition.Repository, defaultBaseCurrency string) *Handler {
+	return &Handler{
+		repo:                repo,
+		accountRepo:         accountRepo,
+		definitionRepo:      definitionRepo,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+	}
+}
+
+func (h *Handler) HandleImportCSVCommand(ctx context.Context, command ImportCSVCommand) (*Result, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if len(command.Data) == 0 {
+		return nil, errors.New("file is required")
+	}
+	if command.DefaultType == "" {
+		command.DefaultType = transaction.Buy
+	}
+	if !isImportableType(command.DefaultType) {
+		return nil, errors.New("invalid default type")
+	}
+	if command.DefaultAssetType == "" {
+		command.DefaultAssetType = asset.Other
+	}
+	if !command.DefaultAssetType.IsValid() {
+		return nil, errors.New("invalid default asset type")
+	}
+	currency := strings.ToUpper(strings.TrimSpace(command.Currency))
+	if currency == "" {
+		currency = h.defaultBaseCurrency
+	}
+
+	rows, err := ParseCSV(bytes.NewReader(command.Data), command.Mapping, command.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, row := range rows {
+		if row.Currency == "" {
+			row.Currency = currency
+		}
+		if row.AssetType == "" {
+			row.AssetType = command.DefaultAssetType
+		} else if !row.AssetType.IsValid() {
+			row.Fail(fmt.Sprintf("invalid asset type %q", row.AssetType))
+		}
+		if row.Type == "" {
+			row.Type = command.DefaultType
+			// Exports often mark disposals with a negative quantity instead of a type
+			if row.Quantity < 0 && row.Type == transaction.Buy {
+				row.Type = transaction.Sell
+				row.Quantity = -row.Quantity
+			}
+		} else if !isImportableType(row.Type) {
+			row.Fail(fmt.Sprintf("invalid type %q", row.Type))
+		}
+		if row.Quantity < 0 {
+			row.Fail("quantity must be greater than zero")
+		}
+	}
+
+	if err := h.resolveAccounts(ctx, userID, rows, command.DefaultAccountID); err != nil {
+		return nil, err
+	}
+	if err := h.resolveDefinitions(ctx, rows); err != nil {
+		return nil, err
+	}
+
+	// Rows are posted oldest first so a sale never runs ahead of the purchase it sells
+	ordered := append([]*Row(nil), rows...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Date.Before(ordered[j].Date)
+	})
+
+	committed, err := h.repo.Import(ctx, userID, ordered, "Imported from CSV", !command.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newResult(rows, command.DryRun)
+	result.Committed = committed
+	return result, nil
+}
+
+func (h *Handler) HandleImportStatementCommand(ctx context.Context, command ImportStatementCommand) (*Result, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if len(command.Data) == 0 {
+		return nil, errors.New("file is required")
+	}
+	format := Format(strings.ToUpper(string(command.Format)))
+	switch format {
+	case "":
+		format = DetectFormat(command.FileName, command.Data)
+	case "QFX":
+		format = OFX
+	}
+
+	var statements []*Statement
+	switch format {
+	case OFX:
+		statements, err = ParseOFX(bytes.NewReader(command.Data))
+	case QIF:
+		statements, err = ParseQIF(bytes.NewReader(command.Data), command.Options)
+	default:
+		return nil, errors.New("unknown statement format, expected OFX or QIF")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if command.AccountID != "" && len(statements) > 1 {
+		return nil, errors.New("an account can only be given for a file with a single statement")
+	}
+
+	currency := strings.ToUpper(strings.TrimSpace(command.Currency))
+	if currency == "" {
+		currency = h.defaultBaseCurrency
+	}
+	for _, statement := range statements {
+		if statement.Currency == "" {
+			statement.Currency = currency
+		}
+	}
+
+	if err := h.resolveStatementAccounts(ctx, userID, statements, command.AccountID); err != nil {
+		return nil, err
+	}
+	definitions, err := h.currencyDefinitions(ctx, statements)
+	if err != nil {
+		return nil, err
+	}
+
+	var rows []*Row
+	for _, statement := range statements {
+		statement.buildRows(definitions[statement.Currency])
+		sort.SliceStable(statement.Rows, func(i, j int) bool {
+			return statement.Rows[i].Date.Before(statement.Rows[j].Date)
+		})
+		rows = append(rows, statement.Rows...)
+	}
+
+	committed, err := h.repo.ImportStatements(ctx, userID, statements, "Imported from "+string(format), !command.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	result := newResult(rows, command.DryRun)
+	result.Committed = committed
+	result.Statements = statements
+	return result, nil
+}
+
+// resolveStatementAccounts finds the account of every statement: the given one, the one
+// the statement account was imported into before, or an account of the same name. A new
+// account is prepared for the rest.
+func (h *Handler) resolveStatementAccounts(ctx context.Context, userID uuid.UUID, statements []*Statement, accountID string) error {
+	accounts, err := h.accountRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	byID := make(map[uuid.UUID]*account.Account)
+	byName := make(map[string]*account.Account)
+	for _, a := range accounts {
+		byID[a.ID] = a
+		byName[strings.ToLower(strings.TrimSpace(a.Name))] = a
+	}
+
+	for _, statement := range statements {
+		var existing *account.Account
+		switch {
+		case accountID != "":
+			id, err := uuid.Parse(accountID)
+			if err != nil {
+				return errors.New("invalid account ID")
+			}
+			if existing = byID[id]; existing == nil {
+				return errors.New("account not found")
+			}
+		case statement.AccountKey == "":
+			return errors.New("statement does not name its account, an account is required")
+		default:
+			if id, err := h.repo.GetStatementAccount(ctx, userID, statement.AccountKey); err == nil {
+				existing = byID[id]
+			}
+			if existing == nil {
+				existing = byName[strings.ToLower(statement.AccountName)]
+			}
+		}
+
+		if existing != nil {
+			statement.AccountID = existing.ID
+			statement.AccountType = existing.AccountType
+			continue
+		}
+		statement.NewAccount = account.NewAccount(account.CreateAccountCommand{
+			UserID:      userID.String(),
+			Name:        statement.AccountName,
+			AccountType: statement.AccountType,
+		})
+		statement.AccountID = statement.NewAccount.ID
+	}
+	return nil
+}
+
+// currencyDefinitions resolves the definition of every statement currency by abbreviation.
+func (h *Handler) currencyDefinitions(ctx context.Context, statements []*Statement) (map[string]uuid.UUID, error) {
+	var currencies []string
+	for _, statement := range statements {
+		currencies = append(currencies, statement.Currency)
+	}
+	definitions, err := h.definitionRepo.GetByAbbreviations(ctx, currencies)
+	if err != nil {
+		return nil, err
+	}
+	byCurrency := make(map[string]uuid.UUID)
+	for _, d := range definitions {
+		byCurrency[strings.ToUpper(d.Abbreviation)] = d.ID
+	}
+	return byCurrency, nil
+}
+
+// resolveAccounts matches the account of every row, by ID or by name, against the
+// accounts of the user.
+func (h *Handler) resolveAccounts(ctx context.Context, userID uuid.UUID, rows []*Row, defaultAccountID string) error {
+	accounts, err := h.accountRepo.GetByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	byKey := make(map[string]uuid.UUID)
+	for _, a := range accounts {
+		byKey[a.ID.String()] = a.ID
+		byKey[strings.ToLower(strings.TrimSpace(a.Name))] = a.ID
+	}
+
+	var defaultAccount *uuid.UUID
+	if defaultAccountID != "" {
+		id, ok := byKey[strings.ToLower(defaultAccountID)]
+		if !ok {
+			return errors.New("default account not found")
+		}
+		defaultAccount = &id
+	}
+
+	for _, row := range rows {
+		if row.Account == "" {
+			if defaultAccount == nil {
+				row.Fail("account is required")
+				continue
+			}
+			row.AccountID = *defaultAccount
+			continue
+		}
+		id, ok := byKey[strings.ToLower(row.Account)]
+		if !ok {
+			row.Fail(fmt.Sprintf("unknown account %q", row.Account))
+			continue
+		}
+		row.AccountID = id
+	}
+	return nil
+}
+
+// resolveDefinitions matches the symbol of every row against definition abbreviations.
+func (h *Handler) resolveDefinitions(ctx context.Context, rows []*Row) error {
+	seen := make(map[string]bool)
+	var symbols []string
+	for _, row := range rows {
+		if row.Symbol != "" && !seen[row.Symbol] {
+			seen[row.Symbol] = true
+			symbols = append(symbols, row.Symbol)
+		}
+	}
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	definitions, err := h.definitionRepo.GetByAbbreviations(ctx, symbols)
+	if err != nil {
+		return err
+	}
+	bySymbol := make(map[string]uuid.UUID)
+	for _, d := range definitions {
+		bySymbol[strings.ToUpper(d.Abbreviation)] = d.ID
+	}
+
+	for _, row := range rows {
+		if row.Symbol == "" {
+			continue
+		}
+		id, ok := bySymbol[row.Symbol]
+		if !ok {
+			row.Fail(fmt.Sprintf("unknown symbol %q", row.Symbol))
+			continue
+		}
+		row.DefinitionID = id
+	}
+	return nil
+}
+
+func isImportableType(t transaction.TransactionType) bool {
+	switch t {
+	case transaction.Buy, transaction.Sell, transaction.Deposit, transaction.Withdraw, transaction.Fee:
+		return true
+	default:
+		return false
+	}
+}
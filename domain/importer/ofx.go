@@ -0,0 +1,274 @@
+package importer
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/domain/account"
+)
+
+// ofxAggregates are the OFX aggregates whose elements the parser reads. Elements are
+// attributed to the innermost of these that is open.
+var ofxAggregates = map[string]bool{
+	"STMTRS":       true,
+	"CCSTMTRS":     true,
+	"BANKACCTFROM": true,
+	"CCACCTFROM":   true,
+	"BANKTRANLIST": true,
+	"STMTTRN":      true,
+	"PAYEE":        true,
+	"LEDGERBAL":    true,
+	"AVAILBAL":     true,
+	"CURRENCY":     true,
+	"ORIGCURRENCY": true,
+}
+
+// ParseOFX reads the bank and credit card statements of an OFX or QFX file, in either the
+// SGML based 1.x format, where simple elements are not closed, or the XML based 2.x one.
+func ParseOFX(r io.Reader) ([]*Statement, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	content := string(data)
+	start := strings.Index(strings.ToUpper(content), "<OFX>")
+	if start < 0 {
+		return nil, errors.New("invalid OFX: missing OFX element")
+	}
+	content = content[start:]
+
+	var (
+		statements []*Statement
+		statement  *Statement
+		entry      *Entry
+		open       []string
+		bankID     string
+		number     string
+		line       int
+	)
+
+	for {
+		tagStart := strings.IndexByte(content, '<')
+		if tagStart < 0 {
+			break
+		}
+		tagEnd := strings.IndexByte(content[tagStart:], '>')
+		if tagEnd < 0 {
+			return nil, errors.New("invalid OFX: unterminated element")
+		}
+		tag := strings.ToUpper(strings.TrimSpace(content[tagStart+1 : tagStart+tagEnd]))
+		content = content[tagStart+tagEnd+1:]
+
+		valueEnd := strings.IndexByte(content, '<')
+		if valueEnd < 0 {
+			valueEnd = len(content)
+		}
+		value := html.UnescapeString(strings.TrimSpace(content[:valueEnd]))
+		content = content[valueEnd:]
+
+		switch {
+		case strings.HasPrefix(tag, "?"), strings.HasPrefix(tag, "!"), strings.HasSuffix(tag, "/"):
+		case strings.HasPrefix(tag, "/"):
+			// The closing tag of a simple element in OFX 2.x matches nothing open
+			name := tag[1:]
+			index := len(open) - 1
+			for index >= 0 && open[index] != name {
+				index--
+			}
+			if index < 0 {
+				continue
+			}
+			open = open[:index]
+
+			switch name {
+			case "STMTTRN":
+				if statement != nil && entry != nil {
+					statement.Entries = append(statement.Entries, entry)
+				}
+				entry = nil
+			case "STMTRS", "CCSTMTRS":
+				if statement != nil {
+					statement.identify(bankID, number)
+					statements = append(statements, statement)
+				}
+				statement = nil
+			}
+		case value == "":
+			open = append(open, tag)
+			switch tag {
+			case "STMTRS":
+				statement = &Statement{Format: OFX, AccountType: account.BankAccount}
+				bankID, number = "", ""
+			case "CCSTMTRS":
+				statement = &Statement{Format: OFX, AccountType: account.CreditCard}
+				bankID, number = "", ""
+			case "STMTTRN":
+				line++
+				entry = &Entry{Line: line}
+			}
+		default:
+			if statement == nil {
+				continue
+			}
+			parent := ""
+			for i := len(open) - 1; i >= 0; i-- {
+				if ofxAggregates[open[i]] {
+					parent = open[i]
+					break
+				}
+			}
+
+			switch parent {
+			case "STMTRS", "CCSTMTRS":
+				if tag == "CURDEF" {
+					statement.Currency = strings.ToUpper(value)
+				}
+			case "BANKACCTFROM", "CCACCTFROM":
+				switch tag {
+				case "BANKID":
+					bankID = value
+				case "ACCTID":
+					number = value
+				case "ACCTTYPE":
+					statement.AccountType = ofxAccountType(value)
+				}
+			case "BANKTRANLIST":
+				switch tag {
+				case "DTSTART":
+					statement.StartDate, _ = parseOFXDate(value)
+				case "DTEND":
+					statement.EndDate, _ = parseOFXDate(value)
+				}
+			case "LEDGERBAL":
+				if tag == "BALAMT" {
+					if balance, err := parseOFXAmount(value); err == nil {
+						statement.LedgerBalance = &balance
+					}
+				}
+			case "STMTTRN", "PAYEE":
+				if entry != nil {
+					entry.set(tag, value)
+				}
+			}
+		}
+	}
+
+	if len(statements) == 0 {
+		return nil, errors.New("file has no bank or credit card statement")
+	}
+	for _, s := range statements {
+		for _, e := range s.Entries {
+			if e.Date.IsZero() && len(e.Errors) == 0 {
+				e.Fail("date is required")
+			}
+		}
+		s.assignIDs()
+	}
+	return statements, nil
+}
+
+func (e *Entry) set(tag, value string) {
+	switch tag {
+	case "TRNTYPE":
+		e.Type = strings.ToUpper(value)
+	case "DTPOSTED":
+		date, err := parseOFXDate(value)
+		if err != nil {
+			e.Fail(fmt.Sprintf("invalid date %q", value))
+			return
+		}
+		e.Date = date
+	case "TRNAMT":
+		amount, err := parseOFXAmount(value)
+		if err != nil {
+			e.Fail(fmt.Sprintf("invalid amount %q", value))
+			return
+		}
+		e.Amount = amount
+	case "FITID":
+		e.FITID = value
+	case "NAME":
+		e.Payee = value
+	case "MEMO":
+		e.Memo = value
+	case "CHECKNUM":
+		e.CheckNumber = value
+	}
+}
+
+// identify derives the key and a readable name of the statement account from its number.
+func (s *Statement) identify(bankID, number string) {
+	if number == "" {
+		return
+	}
+	label := "Bank"
+	switch s.AccountType {
+	case account.CreditCard:
+		s.AccountKey = "OFX:CC:" + number
+		label = "Credit Card"
+	case account.CheckingAccount:
+		label = "Checking"
+	case account.SavingsAccount:
+		label = "Savings"
+	}
+	if s.AccountKey == "" {
+		s.AccountKey = "OFX:" + bankID + ":" + number
+	}
+	s.AccountName = label + " " + maskedNumber(number)
+}
+
+func ofxAccountType(value string) account.AccountType {
+	switch strings.ToUpper(value) {
+	case "CHECKING":
+		return account.CheckingAccount
+	case "SAVINGS", "MONEYMRKT", "CD":
+		return account.SavingsAccount
+	case "CREDITLINE":
+		return account.CreditCard
+	default:
+		return account.BankAccount
+	}
+}
+
+// parseOFXDate reads dates like 20240115, 20240115120000 or 20240115120000.000[-5:EST].
+func parseOFXDate(value string) (time.Time, error) {
+	location := time.UTC
+	if open := strings.IndexByte(value, '['); open >= 0 {
+		zone := strings.TrimSuffix(value[open+1:], "]")
+		value = value[:open]
+		if colon := strings.IndexByte(zone, ':'); colon >= 0 {
+			zone = zone[:colon]
+		}
+		hours, err := strconv.ParseFloat(zone, 64)
+		if err != nil {
+			return time.Time{}, err
+		}
+		location = time.FixedZone("", int(hours*3600))
+	}
+	if dot := strings.IndexByte(value, '.'); dot >= 0 {
+		value = value[:dot]
+	}
+
+	var layout string
+	switch len(value) {
+	case 8:
+		layout = "20060102"
+	case 12:
+		layout = "200601021504"
+	case 14:
+		layout = "20060102150405"
+	default:
+		return time.Time{}, errors.New("unknown date format")
+	}
+	return time.ParseInLocation(layout, value, location)
+}
+
+// parseOFXAmount accepts a decimal comma, which some banks send despite the specification.
+func parseOFXAmount(value string) (float64, error) {
+	return parseNumber(value, strings.Contains(value, ",") && !strings.Contains(value, "."))
+}
@@ -0,0 +1,149 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"siyahsensei/wallet-service/domain/account"
+)
+
+const sgmlStatement = `OFXHEADER:100
+DATA:OFXSGML
+VERSION:102
+
+<OFX>
+<BANKMSGSRSV1><STMTTRNRS><STMTRS>
+<CURDEF>usd
+<BANKACCTFROM><BANKID>121000248<ACCTID>000123456789<ACCTTYPE>CHECKING</BANKACCTFROM>
+<BANKTRANLIST><DTSTART>20240101<DTEND>20240131
+<STMTTRN><TRNTYPE>CREDIT<DTPOSTED>20240105120000.000[-5:EST]<TRNAMT>1500.00<FITID>A1<NAME>ACME PAYROLL</STMTTRN>
+<STMTTRN><TRNTYPE>FEE<DTPOSTED>20240110<TRNAMT>-2,50<NAME>Bank &amp; Co<MEMO>Monthly fee</STMTTRN>
+<STMTTRN><TRNTYPE>DEBIT<DTPOSTED>yesterday<TRNAMT>-10</STMTTRN>
+</BANKTRANLIST>
+<LEDGERBAL><BALAMT>2497.50<DTASOF>20240131</LEDGERBAL>
+</STMTRS></STMTTRNRS></BANKMSGSRSV1>
+</OFX>`
+
+const xmlStatement = `<?xml version="1.0" encoding="UTF-8"?>
+<?OFX OFXHEADER="200" VERSION="220"?>
+<OFX>
+  <CREDITCARDMSGSRSV1><CCSTMTTRNRS><CCSTMTRS>
+    <CURDEF>EUR</CURDEF>
+    <CCACCTFROM><ACCTID>4111111111111111</ACCTID></CCACCTFROM>
+    <BANKTRANLIST>
+      <STMTTRN><TRNTYPE>DEBIT</TRNTYPE><DTPOSTED>20240202</DTPOSTED><TRNAMT>-42.10</TRNAMT><NAME>Grocer</NAME></STMTTRN>
+    </BANKTRANLIST>
+  </CCSTMTRS></CCSTMTTRNRS></CREDITCARDMSGSRSV1>
+</OFX>`
+
+func TestParseOFX(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		accountKey  string
+		accountName string
+		accountType account.AccountType
+		currency    string
+		amounts     []float64
+		failed      int
+	}{
+		{"sgml bank statement", sgmlStatement, "OFX:121000248:000123456789", "Checking ****6789", account.CheckingAccount, "USD", []float64{1500, -2.5, -10}, 1},
+		{"xml credit card statement", xmlStatement, "OFX:CC:4111111111111111", "Credit Card ****1111", account.CreditCard, "EUR", []float64{-42.10}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := ParseOFX(strings.NewReader(tt.data))
+			if err != nil {
+				t.Fatalf("ParseOFX: %v", err)
+			}
+			if len(statements) != 1 {
+				t.Fatalf("ParseOFX returned %d statements, want 1", len(statements))
+			}
+			s := statements[0]
+			if s.AccountKey != tt.accountKey || s.AccountName != tt.accountName || s.AccountType != tt.accountType || s.Currency != tt.currency {
+				t.Errorf("statement account %q %q %s in %s, want %q %q %s in %s",
+					s.AccountKey, s.AccountName, s.AccountType, s.Currency, tt.accountKey, tt.accountName, tt.accountType, tt.currency)
+			}
+			if len(s.Entries) != len(tt.amounts) {
+				t.Fatalf("statement has %d entries, want %d", len(s.Entries), len(tt.amounts))
+			}
+			failed := 0
+			for i, e := range s.Entries {
+				if e.Amount != tt.amounts[i] {
+					t.Errorf("entry %d amount = %v, want %v", i, e.Amount, tt.amounts[i])
+				}
+				if e.FITID == "" {
+					t.Errorf("entry %d has no FITID", i)
+				}
+				if len(e.Errors) > 0 {
+					failed++
+				}
+			}
+			if failed != tt.failed {
+				t.Errorf("%d entries failed, want %d", failed, tt.failed)
+			}
+		})
+	}
+}
+
+func TestParseOFXSGMLEntries(t *testing.T) {
+	statements, err := ParseOFX(strings.NewReader(sgmlStatement))
+	if err != nil {
+		t.Fatalf("ParseOFX: %v", err)
+	}
+	s := statements[0]
+
+	if want := time.Date(2024, 1, 5, 17, 0, 0, 0, time.UTC); !s.Entries[0].Date.Equal(want) {
+		t.Errorf("posted at %v, want %v", s.Entries[0].Date, want)
+	}
+	if s.Entries[0].FITID != "A1" {
+		t.Errorf("FITID = %q, want the one in the file", s.Entries[0].FITID)
+	}
+	if got := s.Entries[1].Notes(); got != "Bank & Co - Monthly fee" {
+		t.Errorf("Notes = %q, want the unescaped payee and memo", got)
+	}
+	if s.LedgerBalance == nil || *s.LedgerBalance != 2497.5 {
+		t.Errorf("LedgerBalance = %v, want 2497.5", s.LedgerBalance)
+	}
+}
+
+func TestParseOFXDate(t *testing.T) {
+	tests := []struct {
+		value string
+		want  time.Time
+		err   bool
+	}{
+		{"20240115", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"202401151230", time.Date(2024, 1, 15, 12, 30, 0, 0, time.UTC), false},
+		{"20240115123045", time.Date(2024, 1, 15, 12, 30, 45, 0, time.UTC), false},
+		{"20240115120000.000[-5:EST]", time.Date(2024, 1, 15, 17, 0, 0, 0, time.UTC), false},
+		{"20240115000000[+5.5:IST]", time.Date(2024, 1, 14, 18, 30, 0, 0, time.UTC), false},
+		{"2024-01-15", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseOFXDate(tt.value)
+		if (err != nil) != tt.err || !got.Equal(tt.want) {
+			t.Errorf("parseOFXDate(%q) = %v, %v, want %v", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestParseOFXErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		err  string
+	}{
+		{"not OFX", "date,amount\n", "invalid OFX: missing OFX element"},
+		{"no statement", "<OFX><SIGNONMSGSRSV1></SIGNONMSGSRSV1></OFX>", "file has no bank or credit card statement"},
+		{"unterminated", "<OFX><STMTRS", "invalid OFX: unterminated element"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := ParseOFX(strings.NewReader(tt.data)); err == nil || err.Error() != tt.err {
+				t.Errorf("ParseOFX error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
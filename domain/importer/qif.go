@@ -0,0 +1,213 @@
+package importer
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/domain/account"
+)
+
+// QIFOptions describes how a QIF file writes dates and amounts, which the format leaves
+// to the program that exported it.
+type QIFOptions struct {
+	// DateFormat is a Go time layout; without it dates are read month first, as Quicken writes them
+	DateFormat   string
+	DecimalComma bool
+}
+
+// ParseQIF reads the bank, cash and credit card sections of a QIF file. Investment
+// sections and lists such as categories are skipped.
+func ParseQIF(r io.Reader, options QIFOptions) ([]*Statement, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var (
+		statements  []*Statement
+		statement   *Statement
+		entry       *Entry
+		inAccount   bool
+		accountName string
+		accountType string
+		line        int
+		skipped     bool
+	)
+
+	flush := func() {
+		if statement != nil && entry != nil {
+			if entry.Date.IsZero() && len(entry.Errors) == 0 {
+				entry.Fail("date is required")
+			}
+			statement.Entries = append(statement.Entries, entry)
+		}
+		entry = nil
+	}
+
+	for scanner.Scan() {
+		text := strings.TrimRight(scanner.Text(), "\r")
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		if strings.HasPrefix(text, "!") {
+			flush()
+			header := strings.ToUpper(strings.TrimSpace(text))
+			switch {
+			case header == "!ACCOUNT":
+				inAccount = true
+				statement = nil
+			case strings.HasPrefix(header, "!TYPE:"):
+				inAccount = false
+				sectionType := strings.TrimSpace(strings.TrimPrefix(header, "!TYPE:"))
+				if !isQIFBankType(sectionType) {
+					statement = nil
+					skipped = skipped || sectionType == "INVST"
+					continue
+				}
+				if !isQIFBankType(accountType) {
+					accountType = sectionType
+				}
+				statement = &Statement{Format: QIF, AccountType: qifAccountType(accountType)}
+				if accountName != "" {
+					statement.AccountKey = "QIF:" + strings.ToLower(accountName)
+					statement.AccountName = accountName
+				}
+				statements = append(statements, statement)
+				// The account header describes only the section that follows it
+				accountName, accountType = "", ""
+			}
+			continue
+		}
+
+		code, value := text[0], strings.TrimSpace(text[1:])
+		if inAccount {
+			switch code {
+			case 'N':
+				accountName = value
+			case 'T':
+				accountType = strings.ToUpper(value)
+			}
+			continue
+		}
+		if statement == nil {
+			continue
+		}
+		if code == '^' {
+			flush()
+			continue
+		}
+		if entry == nil {
+			line++
+			entry = &Entry{Line: line}
+		}
+
+		switch code {
+		case 'D':
+			date, err := parseQIFDate(value, options.DateFormat)
+			if err != nil {
+				entry.Fail(fmt.Sprintf("invalid date %q", value))
+				continue
+			}
+			entry.Date = date
+		case 'T', 'U':
+			// U repeats T with more precision in newer exports
+			amount, err := parseNumber(value, options.DecimalComma)
+			if err != nil {
+				entry.Fail(fmt.Sprintf("invalid amount %q", value))
+				continue
+			}
+			entry.Amount = amount
+		case 'P':
+			entry.Payee = value
+		case 'M':
+			entry.Memo = value
+		case 'N':
+			entry.CheckNumber = value
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("invalid QIF: %w", err)
+	}
+	flush()
+
+	if len(statements) == 0 {
+		if skipped {
+			return nil, errors.New("QIF investment accounts are not supported")
+		}
+		return nil, errors.New("file has no bank, cash or credit card section")
+	}
+	for _, s := range statements {
+		s.assignIDs()
+	}
+	return statements, nil
+}
+
+func isQIFBankType(sectionType string) bool {
+	switch sectionType {
+	case "BANK", "CASH", "CCARD", "OTH A", "OTH L":
+		return true
+	default:
+		return false
+	}
+}
+
+func qifAccountType(sectionType string) account.AccountType {
+	switch sectionType {
+	case "BANK":
+		return account.BankAccount
+	case "CCARD":
+		return account.CreditCard
+	default:
+		return account.Other
+	}
+}
+
+// parseQIFDate reads dates like 1/15/2024, 1/15'24 or 15.01.2024. Quicken marks years after
+// 1999 with an apostrophe; other two digit years below 70 are taken to be after 2000 too.
+func parseQIFDate(value, layout string) (time.Time, error) {
+	if layout != "" {
+		return time.Parse(layout, value)
+	}
+
+	apostrophe := strings.Contains(value, "'")
+	dayFirst := strings.Contains(value, ".")
+	parts := strings.FieldsFunc(value, func(r rune) bool {
+		return r == '/' || r == '-' || r == '.' || r == '\'' || r == ' '
+	})
+	if len(parts) != 3 {
+		return time.Time{}, errors.New("unknown date format")
+	}
+	numbers := make([]int, 3)
+	for i, part := range parts {
+		number, err := strconv.Atoi(part)
+		if err != nil {
+			return time.Time{}, errors.New("unknown date format")
+		}
+		numbers[i] = number
+	}
+
+	year, month, day := numbers[2], numbers[0], numbers[1]
+	switch {
+	case len(parts[0]) == 4:
+		year, month, day = numbers[0], numbers[1], numbers[2]
+	case dayFirst:
+		month, day = numbers[1], numbers[0]
+	}
+	if year < 100 {
+		if apostrophe || year < 70 {
+			year += 2000
+		} else {
+			year += 1900
+		}
+	}
+
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	if month < 1 || month > 12 || date.Day() != day {
+		return time.Time{}, errors.New("unknown date format")
+	}
+	return date, nil
+}
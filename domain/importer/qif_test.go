@@ -0,0 +1,101 @@
+package importer
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"siyahsensei/wallet-service/domain/account"
+)
+
+func TestParseQIF(t *testing.T) {
+	tests := []struct {
+		name        string
+		data        string
+		options     QIFOptions
+		accountKey  string
+		accountType account.AccountType
+		amounts     []float64
+		err         string
+	}{
+		{
+			name:        "bank section",
+			data:        "!Type:Bank\r\nD1/15'24\r\nT-1,234.56\r\nPLandlord\r\nMJanuary rent\r\n^\r\nD1/20'24\r\nT2000\r\n^\r\n",
+			accountType: account.BankAccount,
+			amounts:     []float64{-1234.56, 2000},
+		},
+		{
+			name:        "named credit card account",
+			data:        "!Account\nNVisa Gold\nTCCard\n^\n!Type:CCard\nD15.01.2024\nT-12,50\n^\n",
+			options:     QIFOptions{DecimalComma: true},
+			accountKey:  "QIF:visa gold",
+			accountType: account.CreditCard,
+			amounts:     []float64{-12.5},
+		},
+		{
+			name: "investment accounts",
+			data: "!Type:Invst\nD1/15'24\nNBuy\nYACME\n^\n",
+			err:  "QIF investment accounts are not supported",
+		},
+		{
+			name: "lists only",
+			data: "!Type:Cat\nNGroceries\n^\n",
+			err:  "file has no bank, cash or credit card section",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statements, err := ParseQIF(strings.NewReader(tt.data), tt.options)
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("ParseQIF error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseQIF: %v", err)
+			}
+			if len(statements) != 1 {
+				t.Fatalf("ParseQIF returned %d statements, want 1", len(statements))
+			}
+			s := statements[0]
+			if s.AccountKey != tt.accountKey || s.AccountType != tt.accountType {
+				t.Errorf("statement account %q %s, want %q %s", s.AccountKey, s.AccountType, tt.accountKey, tt.accountType)
+			}
+			if len(s.Entries) != len(tt.amounts) {
+				t.Fatalf("statement has %d entries, want %d", len(s.Entries), len(tt.amounts))
+			}
+			for i, e := range s.Entries {
+				if e.Amount != tt.amounts[i] || len(e.Errors) > 0 || e.FITID == "" {
+					t.Errorf("entry %d = %+v, want amount %v", i, e, tt.amounts[i])
+				}
+			}
+		})
+	}
+}
+
+func TestParseQIFDate(t *testing.T) {
+	tests := []struct {
+		value  string
+		layout string
+		want   time.Time
+		err    bool
+	}{
+		{"1/15/2024", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"1/15'24", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"1/15/99", "", time.Date(1999, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"1/15/05", "", time.Date(2005, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"15.01.2024", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"2024-01-15", "", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"15/01/2024", "02/01/2006", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC), false},
+		{"15/01/2024", "", time.Time{}, true},
+		{"2/30/2024", "", time.Time{}, true},
+		{"January 15", "", time.Time{}, true},
+	}
+	for _, tt := range tests {
+		got, err := parseQIFDate(tt.value, tt.layout)
+		if (err != nil) != tt.err || !got.Equal(tt.want) {
+			t.Errorf("parseQIFDate(%q, %q) = %v, %v, want %v", tt.value, tt.layout, got, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package importer
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// Import posts the valid rows in order in one database transaction, finding or
+	// creating the asset of each. A row the ledger rejects gets the error and the rest
+	// carry on. The transaction is committed only when commit is set and no row failed.
+	Import(ctx context.Context, userID uuid.UUID, rows []*Row, notes string, commit bool) (bool, error)
+	// GetStatementAccount returns the account a bank statement account was last imported into.
+	GetStatementAccount(ctx context.Context, userID uuid.UUID, accountKey string) (uuid.UUID, error)
+	// ImportStatements posts the rows of every statement like Import, in the same database
+	// transaction. New accounts are created, the account each statement account went to is
+	// remembered, and rows whose FITID was imported into the account before are skipped.
+	ImportStatements(ctx context.Context, userID uuid.UUID, statements []*Statement, notes string, commit bool) (bool, error)
+}
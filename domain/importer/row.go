@@ -0,0 +1,104 @@
+package importer
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+// Row is one line of an import turned into a ledger entry. The account and definition
+// are resolved before posting, and Errors collects every problem found with the line.
+type Row struct {
+	Line          int                         `json:"line"`
+	Date          time.Time                   `json:"date"`
+	Symbol        string                      `json:"symbol"`
+	Quantity      float64                     `json:"quantity"`
+	Price         float64                     `json:"price"`
+	Currency      string                      `json:"currency"`
+	Account       string                      `json:"account"`
+	Type          transaction.TransactionType `json:"type"`
+	AssetType     asset.AssetType             `json:"assetType"`
+	Notes         string                      `json:"notes"`
+	AccountID     uuid.UUID                   `json:"accountId"`
+	DefinitionID  uuid.UUID                   `json:"definitionId"`
+	AssetID       *uuid.UUID                  `json:"assetId,omitempty"`
+	TransactionID *uuid.UUID                  `json:"transactionId,omitempty"`
+	Errors        []string                    `json:"errors,omitempty"`
+	// FITID identifies a bank statement line; a line already imported into the account is skipped
+	FITID string `json:"fitid,omitempty"`
+	// Opening marks the opening balance of a statement, posted only into a holding without history
+	Opening    bool   `json:"opening,omitempty"`
+	Skipped    bool   `json:"skipped,omitempty"`
+	SkipReason string `json:"skipReason,omitempty"`
+}
+
+func (r *Row) Valid() bool {
+	return len(r.Errors) == 0
+}
+
+func (r *Row) Fail(message string) {
+	r.Errors = append(r.Errors, message)
+}
+
+func (r *Row) Skip(reason string) {
+	r.Skipped = true
+	r.SkipReason = reason
+}
+
+// Transaction builds the ledger entry of the row for the asset it resolved to.
+func (r *Row) Transaction(userID, assetID uuid.UUID, defaultNotes string) *transaction.Transaction {
+	notes := r.Notes
+	if notes == "" {
+		notes = defaultNotes
+	}
+	now := time.Now()
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		UserID:          userID,
+		AssetID:         assetID,
+		Type:            r.Type,
+		Quantity:        r.Quantity,
+		Price:           r.Price,
+		Currency:        r.Currency,
+		Notes:           notes,
+		TransactionDate: r.Date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Result reports an import. In a dry run nothing is saved; otherwise the rows are saved
+// together only when every one of them is valid.
+type Result struct {
+	DryRun    bool   `json:"dryRun"`
+	Committed bool   `json:"committed"`
+	Total     int    `json:"total"`
+	Valid     int    `json:"valid"`
+	Invalid   int    `json:"invalid"`
+	Skipped   int    `json:"skipped"`
+	Rows      []*Row `json:"rows"`
+	// Statements lists the accounts of a bank statement import
+	Statements []*Statement `json:"statements,omitempty"`
+}
+
+func newResult(rows []*Row, dryRun bool) *Result {
+	result := &Result{
+		DryRun: dryRun,
+		Total:  len(rows),
+		Rows:   rows,
+	}
+	for _, row := range rows {
+		switch {
+		case !row.Valid():
+			result.Invalid++
+		case row.Skipped:
+			result.Skipped++
+		default:
+			result.Valid++
+		}
+	}
+	return result
+}
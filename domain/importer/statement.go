@@ -0,0 +1,209 @@
+package importer
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type Format string
+
+const (
+	OFX Format = "OFX"
+	QIF Format = "QIF"
+)
+
+// DetectFormat tells a statement format apart by the file name, falling back to the content.
+func DetectFormat(fileName string, data []byte) Format {
+	name := strings.ToLower(fileName)
+	switch {
+	case strings.HasSuffix(name, ".ofx"), strings.HasSuffix(name, ".qfx"):
+		return OFX
+	case strings.HasSuffix(name, ".qif"):
+		return QIF
+	}
+
+	head := bytes.ToUpper(bytes.TrimSpace(data))
+	switch {
+	case bytes.HasPrefix(head, []byte("OFXHEADER")), bytes.Contains(head, []byte("<OFX>")):
+		return OFX
+	case bytes.HasPrefix(head, []byte("!TYPE")), bytes.HasPrefix(head, []byte("!ACCOUNT")), bytes.HasPrefix(head, []byte("!OPTION")):
+		return QIF
+	}
+	return ""
+}
+
+// Statement is one account section of a bank statement file.
+type Statement struct {
+	Format Format `json:"format"`
+	// AccountKey identifies the account at the bank across imports, empty when the file
+	// does not say which account it belongs to
+	AccountKey    string              `json:"accountKey"`
+	AccountName   string              `json:"accountName"`
+	AccountType   account.AccountType `json:"accountType"`
+	Currency      string              `json:"currency"`
+	StartDate     time.Time           `json:"startDate"`
+	EndDate       time.Time           `json:"endDate"`
+	LedgerBalance *float64            `json:"ledgerBalance,omitempty"`
+	Entries       []*Entry            `json:"-"`
+
+	// Resolved before posting: the account the entries go to, or the one to create for them
+	AccountID  uuid.UUID        `json:"accountId"`
+	NewAccount *account.Account `json:"-"`
+	Rows       []*Row           `json:"-"`
+}
+
+// Entry is one line of a statement, signed as the bank reports it: money in is positive.
+type Entry struct {
+	Line        int
+	FITID       string
+	Type        string
+	Date        time.Time
+	Amount      float64
+	Payee       string
+	Memo        string
+	CheckNumber string
+	Errors      []string
+}
+
+func (e *Entry) Fail(message string) {
+	e.Errors = append(e.Errors, message)
+}
+
+// Notes describes the entry on the ledger.
+func (e *Entry) Notes() string {
+	parts := make([]string, 0, 3)
+	for _, part := range []string{e.Payee, e.Memo} {
+		if part != "" && (len(parts) == 0 || parts[0] != part) {
+			parts = append(parts, part)
+		}
+	}
+	if e.CheckNumber != "" {
+		parts = append(parts, "check "+e.CheckNumber)
+	}
+	return strings.Join(parts, " - ")
+}
+
+// assignIDs gives entries without a FITID a stable one derived from their content, so that
+// re-importing an overlapping file recognizes them. Identical entries on the same day are
+// told apart by their order.
+func (s *Statement) assignIDs() {
+	seen := make(map[string]int)
+	for _, entry := range s.Entries {
+		if entry.FITID != "" {
+			continue
+		}
+		content := strings.Join([]string{
+			entry.Date.Format("2006-01-02"),
+			strconv.FormatFloat(entry.Amount, 'f', -1, 64),
+			entry.Payee,
+			entry.Memo,
+			entry.CheckNumber,
+		}, "|")
+		sum := sha1.Sum([]byte(content))
+		id := hex.EncodeToString(sum[:])
+		seen[id]++
+		entry.FITID = fmt.Sprintf("%s-%s-%d", s.Format, id[:20], seen[id])
+	}
+}
+
+// buildRows turns the entries into rows on the cash holding of the statement currency,
+// preceded by the opening balance when the statement reports one. Money coming in raises
+// the balance of an asset account and lowers what is owed on a liability account.
+func (s *Statement) buildRows(definitionID uuid.UUID) {
+	sign := 1.0
+	if s.AccountType.IsLiability() {
+		sign = -1
+	}
+	label := s.AccountName
+	if label == "" {
+		label = s.AccountKey
+	}
+	newRow := func(line int, date time.Time, delta float64) *Row {
+		row := &Row{
+			Line:         line,
+			Date:         date,
+			Symbol:       s.Currency,
+			Quantity:     math.Abs(delta),
+			Price:        1,
+			Currency:     s.Currency,
+			Account:      label,
+			Type:         transaction.Deposit,
+			AssetType:    asset.Cash,
+			AccountID:    s.AccountID,
+			DefinitionID: definitionID,
+		}
+		if delta < 0 {
+			row.Type = transaction.Withdraw
+		}
+		if definitionID == uuid.Nil {
+			row.Fail(fmt.Sprintf("unknown currency %q", s.Currency))
+		}
+		return row
+	}
+
+	s.Rows = nil
+	if opening, ok := s.openingBalance(); ok && opening*sign > 0 {
+		row := newRow(0, s.firstDate(), opening*sign)
+		row.Opening = true
+		row.Notes = "Opening balance"
+		s.Rows = append(s.Rows, row)
+	}
+	for _, entry := range s.Entries {
+		delta := entry.Amount * sign
+		row := newRow(entry.Line, entry.Date, delta)
+		row.FITID = entry.FITID
+		row.Notes = entry.Notes()
+		row.Errors = append(row.Errors, entry.Errors...)
+		if delta < 0 && sign > 0 && (entry.Type == "FEE" || entry.Type == "SRVCHG") {
+			row.Type = transaction.Fee
+		}
+		if delta == 0 && row.Valid() {
+			row.Skip("amount is zero")
+		}
+		s.Rows = append(s.Rows, row)
+	}
+}
+
+// openingBalance is the balance the statement started with, derived from its closing
+// balance, or false when the statement does not report one.
+func (s *Statement) openingBalance() (float64, bool) {
+	if s.LedgerBalance == nil {
+		return 0, false
+	}
+	opening := *s.LedgerBalance
+	for _, entry := range s.Entries {
+		opening -= entry.Amount
+	}
+	return opening, true
+}
+
+// firstDate is the start of the statement period, or the date of its earliest entry.
+func (s *Statement) firstDate() time.Time {
+	first := s.StartDate
+	for _, entry := range s.Entries {
+		if !entry.Date.IsZero() && (first.IsZero() || entry.Date.Before(first)) {
+			first = entry.Date
+		}
+	}
+	return first
+}
+
+// maskedNumber shortens an account number to its last four digits.
+func maskedNumber(number string) string {
+	if len(number) <= 4 {
+		return number
+	}
+	return "****" + number[len(number)-4:]
+}
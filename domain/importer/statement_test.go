@@ -0,0 +1,143 @@
+package importer
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+func TestDetectFormat(t *testing.T) {
+	tests := []struct {
+		fileName string
+		data     string
+		want     Format
+	}{
+		{"statement.OFX", "", OFX},
+		{"statement.qfx", "", OFX},
+		{"statement.qif", "", QIF},
+		{"download", "OFXHEADER:100\nDATA:OFXSGML", OFX},
+		{"download", `<?xml version="1.0"?><OFX>`, OFX},
+		{"download", "!Type:Bank\nD1/15'24", QIF},
+		{"download.csv", "date,amount", ""},
+	}
+	for _, tt := range tests {
+		if got := DetectFormat(tt.fileName, []byte(tt.data)); got != tt.want {
+			t.Errorf("DetectFormat(%q) = %q, want %q", tt.fileName, got, tt.want)
+		}
+	}
+}
+
+func TestAssignIDs(t *testing.T) {
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	entries := func() []*Entry {
+		return []*Entry{
+			{Date: day, Amount: -5, Payee: "Coffee"},
+			{Date: day, Amount: -5, Payee: "Coffee"},
+			{Date: day, Amount: -5, Payee: "Coffee", FITID: "BANK-1"},
+		}
+	}
+	first := &Statement{Format: QIF, Entries: entries()}
+	again := &Statement{Format: QIF, Entries: entries()}
+	first.assignIDs()
+	again.assignIDs()
+
+	if first.Entries[0].FITID == first.Entries[1].FITID {
+		t.Errorf("identical entries on one day share the FITID %q", first.Entries[0].FITID)
+	}
+	for i := range first.Entries {
+		if first.Entries[i].FITID != again.Entries[i].FITID {
+			t.Errorf("entry %d got %q on one import and %q on the next", i, first.Entries[i].FITID, again.Entries[i].FITID)
+		}
+	}
+	if first.Entries[2].FITID != "BANK-1" {
+		t.Errorf("FITID = %q, want the one from the bank", first.Entries[2].FITID)
+	}
+}
+
+func TestBuildRows(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	balance := func(amount float64) *float64 { return &amount }
+
+	tests := []struct {
+		name        string
+		accountType account.AccountType
+		balance     *float64
+		entries     []*Entry
+		// types lists the row types in order, with the opening balance first when there is one
+		types    []transaction.TransactionType
+		opening  float64
+		skipped  int
+		quantity []float64
+	}{
+		{
+			name:        "bank account",
+			accountType: account.BankAccount,
+			balance:     balance(1100),
+			entries: []*Entry{
+				{Line: 1, Date: jan.AddDate(0, 0, 5), Amount: 200},
+				{Line: 2, Date: jan.AddDate(0, 0, 6), Amount: -50},
+				{Line: 3, Date: jan.AddDate(0, 0, 7), Amount: -2, Type: "FEE"},
+			},
+			types:    []transaction.TransactionType{transaction.Deposit, transaction.Deposit, transaction.Withdraw, transaction.Fee},
+			opening:  952,
+			quantity: []float64{952, 200, 50, 2},
+		},
+		{
+			name:        "credit card spending raises what is owed",
+			accountType: account.CreditCard,
+			balance:     balance(-300),
+			entries: []*Entry{
+				{Line: 1, Date: jan.AddDate(0, 0, 5), Amount: -100},
+				{Line: 2, Date: jan.AddDate(0, 0, 6), Amount: 50, Type: "PAYMENT"},
+			},
+			types:    []transaction.TransactionType{transaction.Deposit, transaction.Deposit, transaction.Withdraw},
+			opening:  250,
+			quantity: []float64{250, 100, 50},
+		},
+		{
+			name:        "no balance and a zero amount",
+			accountType: account.BankAccount,
+			entries:     []*Entry{{Line: 1, Date: jan, Amount: 0}},
+			types:       []transaction.TransactionType{transaction.Deposit},
+			skipped:     1,
+			quantity:    []float64{0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Statement{AccountType: tt.accountType, Currency: "USD", LedgerBalance: tt.balance, Entries: tt.entries}
+			s.buildRows(uuid.New())
+
+			if len(s.Rows) != len(tt.types) {
+				t.Fatalf("buildRows made %d rows, want %d", len(s.Rows), len(tt.types))
+			}
+			skipped := 0
+			for i, row := range s.Rows {
+				if row.Type != tt.types[i] || row.Quantity != tt.quantity[i] {
+					t.Errorf("row %d = %s %v, want %s %v", i, row.Type, row.Quantity, tt.types[i], tt.quantity[i])
+				}
+				if row.Skipped {
+					skipped++
+				}
+			}
+			if skipped != tt.skipped {
+				t.Errorf("%d rows skipped, want %d", skipped, tt.skipped)
+			}
+			if tt.opening > 0 && (!s.Rows[0].Opening || !s.Rows[0].Date.Equal(jan.AddDate(0, 0, 5))) {
+				t.Errorf("opening balance row = %+v, want one dated at the first entry", s.Rows[0])
+			}
+		})
+	}
+}
+
+func TestBuildRowsUnknownCurrency(t *testing.T) {
+	s := &Statement{AccountType: account.BankAccount, Currency: "XYZ", Entries: []*Entry{{Line: 1, Amount: 10}}}
+	s.buildRows(uuid.Nil)
+	if s.Rows[0].Valid() {
+		t.Error("row for an unknown currency is valid")
+	}
+}
@@ -0,0 +1,28 @@
+package income
+
+type CreateIncomeCommand struct {
+	UserID         string     `json:"userId" validate:"required"`
+	AssetID        string     `json:"assetId" validate:"required"`
+	Type           IncomeType `json:"type" validate:"required"`
+	GrossAmount    float64    `json:"grossAmount" validate:"required"`
+	WithholdingTax float64    `json:"withholdingTax"`
+	Currency       string     `json:"currency" validate:"required"`
+	PayDate        int64      `json:"payDate" validate:"required"`
+	Notes          string     `json:"notes"`
+}
+
+type UpdateIncomeCommand struct {
+	ID             string     `json:"id" validate:"required"`
+	UserID         string     `json:"userId" validate:"required"`
+	Type           IncomeType `json:"type" validate:"required"`
+	GrossAmount    float64    `json:"grossAmount" validate:"required"`
+	WithholdingTax float64    `json:"withholdingTax"`
+	Currency       string     `json:"currency" validate:"required"`
+	PayDate        int64      `json:"payDate" validate:"required"`
+	Notes          string     `json:"notes"`
+}
+
+type DeleteIncomeCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
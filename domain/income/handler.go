@@ -0,0 +1,218 @@
+package income
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/fx"
+	"siyahsensei/wallet-service/domain/lot"
+)
+
+type Handler struct {
+	repo                Repository
+	assetRepo           asset.Repository
+	lotRepo             lot.Repository
+	fxService           *fx.Handler
+	defaultBaseCurrency string
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, lotRepo lot.Repository, fxService *fx.Handler, defaultBaseCurrency string) *Handler {
+	return &Handler{
+		repo:                repo,
+		assetRepo:           assetRepo,
+		lotRepo:             lotRepo,
+		fxService:           fxService,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+	}
+}
+
+func (h *Handler) HandleCreateIncomeCommand(ctx context.Context, command CreateIncomeCommand) (*Income, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	if err := validateIncome(command.Type, command.GrossAmount, command.WithholdingTax, command.Currency, command.PayDate); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+
+	income := NewIncome(command)
+	if err := h.repo.Create(ctx, income); err != nil {
+		return nil, err
+	}
+	return h.repo.GetByID(ctx, income.ID)
+}
+
+func (h *Handler) HandleUpdateIncomeCommand(ctx context.Context, command UpdateIncomeCommand) (*Income, error) {
+	income, err := h.ownedIncome(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateIncome(command.Type, command.GrossAmount, command.WithholdingTax, command.Currency, command.PayDate); err != nil {
+		return nil, err
+	}
+
+	income.Update(command)
+	if err := h.repo.Update(ctx, income); err != nil {
+		return nil, err
+	}
+	return income, nil
+}
+
+func (h *Handler) HandleDeleteIncomeCommand(ctx context.Context, command DeleteIncomeCommand) error {
+	income, err := h.ownedIncome(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, income.ID)
+}
+
+func (h *Handler) HandleGetIncomeByIDQuery(ctx context.Context, query GetIncomeByIDQuery) (*Income, error) {
+	return h.ownedIncome(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleFilterIncomeQuery(ctx context.Context, query FilterIncomeQuery) ([]*Income, error) {
+	if _, err := uuid.Parse(query.UserID); err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.AssetID != nil {
+		if _, err := uuid.Parse(*query.AssetID); err != nil {
+			return nil, errors.New("invalid asset ID")
+		}
+	}
+	return h.repo.Filter(ctx, query)
+}
+
+// HandleGetReportQuery aggregates the income of a year by month, type and asset in the
+// base currency. Amounts are converted at the rates known at the end of the year, or
+// now for the current year, and yield on cost uses the cost of the lots still open.
+func (h *Handler) HandleGetReportQuery(ctx context.Context, query GetReportQuery) (*Report, error) {
+	now := time.Now()
+	if query.Year == 0 {
+		query.Year = now.Year()
+	}
+	if query.Year < 1900 || query.Year > now.Year()+1 {
+		return nil, errors.New("invalid year")
+	}
+	base := strings.ToUpper(strings.TrimSpace(query.BaseCurrency))
+	if base == "" {
+		base = h.defaultBaseCurrency
+	}
+
+	from := time.Date(query.Year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(1, 0, 0).Add(-time.Nanosecond)
+	records, err := h.HandleFilterIncomeQuery(ctx, FilterIncomeQuery{
+		UserID: query.UserID,
+		From:   &from,
+		To:     &to,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	ratesAt := to
+	if now.Before(ratesAt) {
+		ratesAt = now
+	}
+	table, err := h.fxService.Table(ctx, ratesAt)
+	if err != nil {
+		return nil, err
+	}
+
+	report := newReport(query.Year, base, ratesAt)
+	for _, record := range records {
+		gross, ok := table.Convert(record.GrossAmount, record.Currency, base)
+		if !ok {
+			report.Unconverted++
+			continue
+		}
+		withholdingTax, _ := table.Convert(record.WithholdingTax, record.Currency, base)
+		report.add(record, gross, withholdingTax)
+	}
+
+	for _, total := range report.ByAsset {
+		lots, err := h.lotRepo.GetByAssetID(ctx, total.AssetID, true)
+		if err != nil {
+			return nil, err
+		}
+		for _, l := range lots {
+			cost, ok := table.Convert(l.RemainingQuantity*l.UnitPrice, l.Currency, base)
+			if ok {
+				total.CostBasis += cost
+			}
+		}
+		if total.CostBasis > 0 {
+			total.YieldOnCost = total.Gross / total.CostBasis * 100
+		}
+	}
+
+	report.sort()
+	return report, nil
+}
+
+func (h *Handler) ownedIncome(ctx context.Context, incomeIDValue, userIDValue string) (*Income, error) {
+	incomeID, err := uuid.Parse(incomeIDValue)
+	if err != nil {
+		return nil, errors.New("invalid income ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	income, err := h.repo.GetByID(ctx, incomeID)
+	if err != nil {
+		return nil, errors.New("income not found")
+	}
+
+	if income.UserID != userID {
+		return nil, errors.New("unauthorized: income does not belong to user")
+	}
+
+	return income, nil
+}
+
+func validateIncome(incomeType IncomeType, grossAmount, withholdingTax float64, currency string, payDate int64) error {
+	if !isValidIncomeType(incomeType) {
+		return errors.New("invalid income type")
+	}
+	if grossAmount <= 0 {
+		return errors.New("gross amount must be greater than zero")
+	}
+	if withholdingTax < 0 || withholdingTax > grossAmount {
+		return errors.New("withholding tax must be between zero and the gross amount")
+	}
+	if strings.TrimSpace(currency) == "" {
+		return errors.New("currency is required")
+	}
+	if payDate <= 0 {
+		return errors.New("pay date is required")
+	}
+	return nil
+}
+
+func isValidIncomeType(t IncomeType) bool {
+	switch t {
+	case Dividend, Interest, Coupon, StakingReward, Rent:
+		return true
+	default:
+		return false
+	}
+}
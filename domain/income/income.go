@@ -0,0 +1,70 @@
+package income
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+type IncomeType string
+
+const (
+	Dividend      IncomeType = "DIVIDEND"
+	Interest      IncomeType = "INTEREST"
+	Coupon        IncomeType = "COUPON"
+	StakingReward IncomeType = "STAKING_REWARD"
+	Rent          IncomeType = "RENT"
+)
+
+// Income is a payment received on an asset. Symbol and AssetType describe the asset
+// and are only filled when income is read.
+type Income struct {
+	ID             uuid.UUID       `json:"id" db:"id"`
+	UserID         uuid.UUID       `json:"userId" db:"user_id"`
+	AssetID        uuid.UUID       `json:"assetId" db:"asset_id"`
+	Type           IncomeType      `json:"type" db:"type"`
+	GrossAmount    float64         `json:"grossAmount" db:"gross_amount"`
+	WithholdingTax float64         `json:"withholdingTax" db:"withholding_tax"`
+	Currency       string          `json:"currency" db:"currency"`
+	PayDate        time.Time       `json:"payDate" db:"pay_date"`
+	Notes          string          `json:"notes" db:"notes"`
+	Symbol         string          `json:"symbol" db:"symbol"`
+	AssetType      asset.AssetType `json:"assetType" db:"asset_type"`
+	CreatedAt      time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+func NewIncome(command CreateIncomeCommand) *Income {
+	now := time.Now()
+	return &Income{
+		ID:             uuid.New(),
+		UserID:         uuid.MustParse(command.UserID),
+		AssetID:        uuid.MustParse(command.AssetID),
+		Type:           command.Type,
+		GrossAmount:    command.GrossAmount,
+		WithholdingTax: command.WithholdingTax,
+		Currency:       strings.ToUpper(strings.TrimSpace(command.Currency)),
+		PayDate:        time.Unix(command.PayDate, 0),
+		Notes:          command.Notes,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func (i *Income) Update(command UpdateIncomeCommand) {
+	i.Type = command.Type
+	i.GrossAmount = command.GrossAmount
+	i.WithholdingTax = command.WithholdingTax
+	i.Currency = strings.ToUpper(strings.TrimSpace(command.Currency))
+	i.PayDate = time.Unix(command.PayDate, 0)
+	i.Notes = command.Notes
+	i.UpdatedAt = time.Now()
+}
+
+// NetAmount is what was received after withholding tax.
+func (i *Income) NetAmount() float64 {
+	return i.GrossAmount - i.WithholdingTax
+}
@@ -0,0 +1,24 @@
+package income
+
+import (
+	"time"
+)
+
+type GetIncomeByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type FilterIncomeQuery struct {
+	UserID  string      `json:"userId" validate:"required"`
+	AssetID *string     `json:"assetId,omitempty"`
+	Type    *IncomeType `json:"type,omitempty"`
+	From    *time.Time  `json:"from,omitempty"`
+	To      *time.Time  `json:"to,omitempty"`
+}
+
+type GetReportQuery struct {
+	UserID       string `json:"userId" validate:"required"`
+	Year         int    `json:"year"`
+	BaseCurrency string `json:"baseCurrency"`
+}
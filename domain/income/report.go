@@ -0,0 +1,109 @@
+package income
+
+import (
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+// Totals adds up gross income, withholding tax and net income in the base currency.
+type Totals struct {
+	Gross          float64 `json:"gross"`
+	WithholdingTax float64 `json:"withholdingTax"`
+	Net            float64 `json:"net"`
+}
+
+func (t *Totals) add(gross, withholdingTax float64) {
+	t.Gross += gross
+	t.WithholdingTax += withholdingTax
+	t.Net += gross - withholdingTax
+}
+
+type MonthTotal struct {
+	Month int `json:"month"`
+	Totals
+}
+
+type TypeTotal struct {
+	Type IncomeType `json:"type"`
+	Totals
+}
+
+// AssetTotal is the income of one asset in the year. YieldOnCost is gross income as a
+// percentage of the cost of what is still held, zero when the cost is unknown.
+type AssetTotal struct {
+	AssetID     uuid.UUID       `json:"assetId"`
+	Symbol      string          `json:"symbol"`
+	AssetType   asset.AssetType `json:"assetType"`
+	CostBasis   float64         `json:"costBasis"`
+	YieldOnCost float64         `json:"yieldOnCost"`
+	Totals
+}
+
+// Report aggregates the income of a year in the base currency. Income that cannot be
+// converted is left out and counted in Unconverted.
+type Report struct {
+	Year         int           `json:"year"`
+	BaseCurrency string        `json:"baseCurrency"`
+	RatesAt      time.Time     `json:"ratesAt"`
+	Unconverted  int           `json:"unconverted"`
+	ByMonth      []*MonthTotal `json:"byMonth"`
+	ByType       []*TypeTotal  `json:"byType"`
+	ByAsset      []*AssetTotal `json:"byAsset"`
+	Totals
+}
+
+func newReport(year int, base string, ratesAt time.Time) *Report {
+	r := &Report{
+		Year:         year,
+		BaseCurrency: base,
+		RatesAt:      ratesAt,
+	}
+	for month := 1; month <= 12; month++ {
+		r.ByMonth = append(r.ByMonth, &MonthTotal{Month: month})
+	}
+	return r
+}
+
+// add books one income record already converted into the base currency.
+func (r *Report) add(i *Income, gross, withholdingTax float64) {
+	r.Totals.add(gross, withholdingTax)
+	r.ByMonth[i.PayDate.Month()-1].add(gross, withholdingTax)
+
+	var byType *TypeTotal
+	for _, t := range r.ByType {
+		if t.Type == i.Type {
+			byType = t
+		}
+	}
+	if byType == nil {
+		byType = &TypeTotal{Type: i.Type}
+		r.ByType = append(r.ByType, byType)
+	}
+	byType.add(gross, withholdingTax)
+
+	var byAsset *AssetTotal
+	for _, a := range r.ByAsset {
+		if a.AssetID == i.AssetID {
+			byAsset = a
+		}
+	}
+	if byAsset == nil {
+		byAsset = &AssetTotal{AssetID: i.AssetID, Symbol: i.Symbol, AssetType: i.AssetType}
+		r.ByAsset = append(r.ByAsset, byAsset)
+	}
+	byAsset.add(gross, withholdingTax)
+}
+
+// sort orders types and assets by gross income, largest first.
+func (r *Report) sort() {
+	sort.SliceStable(r.ByType, func(i, j int) bool {
+		return r.ByType[i].Gross > r.ByType[j].Gross
+	})
+	sort.SliceStable(r.ByAsset, func(i, j int) bool {
+		return r.ByAsset[i].Gross > r.ByAsset[j].Gross
+	})
+}
@@ -0,0 +1,50 @@
+package income
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func TestReportAdd(t *testing.T) {
+	stock, bond := uuid.New(), uuid.New()
+	paid := func(assetID uuid.UUID, incomeType IncomeType, month time.Month) *Income {
+		return &Income{AssetID: assetID, Type: incomeType, PayDate: time.Date(2024, month, 15, 0, 0, 0, 0, time.UTC)}
+	}
+
+	r := newReport(2024, "USD", time.Date(2024, 12, 31, 0, 0, 0, 0, time.UTC))
+	r.add(paid(stock, Dividend, time.March), 100, 15)
+	r.add(paid(stock, Dividend, time.June), 100, 15)
+	r.add(paid(bond, Coupon, time.June), 250, 0)
+	r.sort()
+
+	tests := []struct {
+		name   string
+		totals Totals
+		want   Totals
+	}{
+		{"year", r.Totals, Totals{Gross: 450, WithholdingTax: 30, Net: 420}},
+		{"january", r.ByMonth[0].Totals, Totals{}},
+		{"march", r.ByMonth[2].Totals, Totals{Gross: 100, WithholdingTax: 15, Net: 85}},
+		{"june", r.ByMonth[5].Totals, Totals{Gross: 350, WithholdingTax: 15, Net: 335}},
+		{"largest type first", r.ByType[0].Totals, Totals{Gross: 250, Net: 250}},
+		{"dividends", r.ByType[1].Totals, Totals{Gross: 200, WithholdingTax: 30, Net: 170}},
+		{"largest asset first", r.ByAsset[0].Totals, Totals{Gross: 250, Net: 250}},
+		{"stock", r.ByAsset[1].Totals, Totals{Gross: 200, WithholdingTax: 30, Net: 170}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.totals != tt.want {
+				t.Errorf("totals = %+v, want %+v", tt.totals, tt.want)
+			}
+		})
+	}
+
+	if len(r.ByMonth) != 12 || len(r.ByType) != 2 || len(r.ByAsset) != 2 {
+		t.Errorf("report has %d months, %d types and %d assets, want 12, 2 and 2", len(r.ByMonth), len(r.ByType), len(r.ByAsset))
+	}
+	if r.ByType[0].Type != Coupon || r.ByAsset[0].AssetID != bond {
+		t.Errorf("types and assets are not ordered by gross income")
+	}
+}
@@ -0,0 +1,15 @@
+package income
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, income *Income) error
+	Update(ctx context.Context, income *Income) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Income, error)
+	Filter(ctx context.Context, query FilterIncomeQuery) ([]*Income, error)
+}
@@ -0,0 +1,44 @@
+package loan
+
+type CreateLoanCommand struct {
+	UserID  string `json:"userId" validate:"required"`
+	AssetID string `json:"assetId" validate:"required"`
+	// Principal defaults to the current quantity of the asset
+	Principal        float64 `json:"principal"`
+	AnnualRate       float64 `json:"annualRate"`
+	TermPeriods      int     `json:"termPeriods" validate:"required"`
+	PaymentFrequency int     `json:"paymentFrequency"`
+	Method           Method  `json:"method"`
+	StartDate        int64   `json:"startDate" validate:"required"`
+	FirstPaymentDate int64   `json:"firstPaymentDate"`
+}
+
+type UpdateLoanCommand struct {
+	ID               string  `json:"id" validate:"required"`
+	UserID           string  `json:"userId" validate:"required"`
+	Principal        float64 `json:"principal" validate:"required"`
+	AnnualRate       float64 `json:"annualRate"`
+	TermPeriods      int     `json:"termPeriods" validate:"required"`
+	PaymentFrequency int     `json:"paymentFrequency"`
+	Method           Method  `json:"method"`
+	StartDate        int64   `json:"startDate" validate:"required"`
+	FirstPaymentDate int64   `json:"firstPaymentDate"`
+}
+
+type DeleteLoanCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// RecordPaymentCommand records a payment made on a loan. The principal part reduces
+// the debt asset; with FromAssetID the whole amount is also withdrawn from that asset.
+type RecordPaymentCommand struct {
+	LoanID string  `json:"loanId" validate:"required"`
+	UserID string  `json:"userId" validate:"required"`
+	Amount float64 `json:"amount" validate:"required"`
+	// Interest overrides the interest part computed from the outstanding principal
+	Interest    *float64 `json:"interest,omitempty"`
+	PaidAt      int64    `json:"paidAt" validate:"required"`
+	FromAssetID *string  `json:"fromAssetId,omitempty"`
+	Notes       string   `json:"notes"`
+}
@@ -0,0 +1,239 @@
+package loan
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/definition"
+)
+
+type Handler struct {
+	repo           Repository
+	assetRepo      asset.Repository
+	definitionRepo definition.Repository
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, definitionRepo definition.Repository) *Handler {
+	return &Handler{
+		repo:           repo,
+		assetRepo:      assetRepo,
+		definitionRepo: definitionRepo,
+	}
+}
+
+func (h *Handler) HandleCreateLoanCommand(ctx context.Context, command CreateLoanCommand) (*Loan, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	if err := validateTerms(command.AnnualRate, command.TermPeriods, command.PaymentFrequency, command.Method,
+		command.StartDate, command.FirstPaymentDate); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+	if existingAsset.Type != asset.Debt {
+		return nil, errors.New("asset is not a debt")
+	}
+	if _, err := h.repo.GetByAssetID(ctx, assetID); err == nil {
+		return nil, errors.New("asset already has loan terms")
+	}
+
+	if command.Principal == 0 {
+		command.Principal = existingAsset.Quantity
+	}
+	if command.Principal <= 0 {
+		return nil, errors.New("principal must be greater than zero")
+	}
+
+	existingDefinition, err := h.definitionRepo.GetByID(ctx, existingAsset.DefinitionID)
+	if err != nil {
+		return nil, errors.New("definition not found")
+	}
+
+	loan := NewLoan(command, existingDefinition.Abbreviation)
+	if err := h.repo.Create(ctx, loan); err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+func (h *Handler) HandleUpdateLoanCommand(ctx context.Context, command UpdateLoanCommand) (*Loan, error) {
+	loan, err := h.ownedLoan(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if command.Principal <= 0 {
+		return nil, errors.New("principal must be greater than zero")
+	}
+	if err := validateTerms(command.AnnualRate, command.TermPeriods, command.PaymentFrequency, command.Method,
+		command.StartDate, command.FirstPaymentDate); err != nil {
+		return nil, err
+	}
+
+	loan.Update(command)
+	if err := h.repo.Update(ctx, loan); err != nil {
+		return nil, err
+	}
+	return loan, nil
+}
+
+func (h *Handler) HandleDeleteLoanCommand(ctx context.Context, command DeleteLoanCommand) error {
+	loan, err := h.ownedLoan(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, loan.ID)
+}
+
+func (h *Handler) HandleRecordPaymentCommand(ctx context.Context, command RecordPaymentCommand) (*Payment, error) {
+	loan, err := h.ownedLoan(ctx, command.LoanID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.Interest != nil && *command.Interest < 0 {
+		return nil, errors.New("interest must not be negative")
+	}
+	if command.PaidAt <= 0 {
+		return nil, errors.New("payment date is required")
+	}
+
+	if command.FromAssetID != nil {
+		fromAssetID, err := uuid.Parse(*command.FromAssetID)
+		if err != nil {
+			return nil, errors.New("invalid asset ID")
+		}
+		fromAsset, err := h.assetRepo.GetByID(ctx, fromAssetID)
+		if err != nil {
+			return nil, errors.New("asset not found")
+		}
+		if fromAsset.UserID != loan.UserID {
+			return nil, errors.New("unauthorized: asset does not belong to user")
+		}
+		if fromAsset.ID == loan.AssetID {
+			return nil, errors.New("a loan cannot be paid from itself")
+		}
+	}
+
+	previous, err := h.repo.GetPayments(ctx, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment, err := loan.NewPayment(command, previous)
+	if err != nil {
+		return nil, err
+	}
+	if err := h.repo.RecordPayment(ctx, loan, payment); err != nil {
+		return nil, err
+	}
+	return payment, nil
+}
+
+func (h *Handler) HandleGetLoanByIDQuery(ctx context.Context, query GetLoanByIDQuery) (*Loan, error) {
+	return h.ownedLoan(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserLoansQuery(ctx context.Context, query GetUserLoansQuery) ([]*Loan, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleGetScheduleQuery(ctx context.Context, query GetScheduleQuery) ([]*Installment, error) {
+	loan, err := h.ownedLoan(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return loan.Schedule(), nil
+}
+
+func (h *Handler) HandleGetPaymentsQuery(ctx context.Context, query GetPaymentsQuery) ([]*Payment, error) {
+	loan, err := h.ownedLoan(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return h.repo.GetPayments(ctx, loan.ID)
+}
+
+func (h *Handler) HandleGetStatusQuery(ctx context.Context, query GetStatusQuery) (*Status, error) {
+	loan, err := h.ownedLoan(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if query.AsOf.IsZero() {
+		query.AsOf = time.Now()
+	}
+
+	payments, err := h.repo.GetPayments(ctx, loan.ID)
+	if err != nil {
+		return nil, err
+	}
+	return loan.StatusAt(payments, query.AsOf), nil
+}
+
+func (h *Handler) ownedLoan(ctx context.Context, loanIDValue, userIDValue string) (*Loan, error) {
+	loanID, err := uuid.Parse(loanIDValue)
+	if err != nil {
+		return nil, errors.New("invalid loan ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	loan, err := h.repo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, errors.New("loan not found")
+	}
+
+	if loan.UserID != userID {
+		return nil, errors.New("unauthorized: loan does not belong to user")
+	}
+
+	return loan, nil
+}
+
+func validateTerms(annualRate float64, termPeriods, paymentFrequency int, method Method, startDate, firstPaymentDate int64) error {
+	if annualRate < 0 {
+		return errors.New("annual rate must not be negative")
+	}
+	if termPeriods <= 0 || termPeriods > 1200 {
+		return errors.New("term must be between 1 and 1200 installments")
+	}
+	switch paymentFrequency {
+	case 0, 1, 2, 4, 12:
+	default:
+		return errors.New("payment frequency must be 1, 2, 4 or 12 payments a year")
+	}
+	if method != "" && method != Annuity && method != EqualPrincipal {
+		return errors.New("invalid amortization method")
+	}
+	if startDate <= 0 {
+		return errors.New("start date is required")
+	}
+	if firstPaymentDate != 0 && firstPaymentDate <= startDate {
+		return errors.New("first payment date must be after start date")
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package loan
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// Method decides how each installment is split between principal and interest.
+type Method string
+
+const (
+	// Annuity pays the same installment every period, mostly interest at first.
+	Annuity Method = "ANNUITY"
+	// EqualPrincipal repays the same principal every period with falling interest.
+	EqualPrincipal Method = "EQUAL_PRINCIPAL"
+)
+
+// Loan holds the terms of a DEBT asset that is a loan or mortgage. The loan is repaid
+// in TermPeriods installments, PaymentFrequency times a year, from FirstPaymentDate
+// on. AnnualRate is a nominal percentage.
+type Loan struct {
+	ID               uuid.UUID `json:"id" db:"id"`
+	UserID           uuid.UUID `json:"userId" db:"user_id"`
+	AssetID          uuid.UUID `json:"assetId" db:"asset_id"`
+	Principal        float64   `json:"principal" db:"principal"`
+	Currency         string    `json:"currency" db:"currency"`
+	AnnualRate       float64   `json:"annualRate" db:"annual_rate"`
+	TermPeriods      int       `json:"termPeriods" db:"term_periods"`
+	PaymentFrequency int       `json:"paymentFrequency" db:"payment_frequency"`
+	Method           Method    `json:"method" db:"method"`
+	StartDate        time.Time `json:"startDate" db:"start_date"`
+	FirstPaymentDate time.Time `json:"firstPaymentDate" db:"first_payment_date"`
+	CreatedAt        time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt        time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+func NewLoan(command CreateLoanCommand, currency string) *Loan {
+	now := time.Now()
+	l := &Loan{
+		ID:               uuid.New(),
+		UserID:           uuid.MustParse(command.UserID),
+		AssetID:          uuid.MustParse(command.AssetID),
+		Principal:        command.Principal,
+		Currency:         currency,
+		AnnualRate:       command.AnnualRate,
+		TermPeriods:      command.TermPeriods,
+		PaymentFrequency: command.PaymentFrequency,
+		Method:           command.Method,
+		StartDate:        calendar.TruncateDay(time.Unix(command.StartDate, 0)),
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	l.setDefaults(command.FirstPaymentDate)
+	return l
+}
+
+func (l *Loan) Update(command UpdateLoanCommand) {
+	l.Principal = command.Principal
+	l.AnnualRate = command.AnnualRate
+	l.TermPeriods = command.TermPeriods
+	l.PaymentFrequency = command.PaymentFrequency
+	l.Method = command.Method
+	l.StartDate = calendar.TruncateDay(time.Unix(command.StartDate, 0))
+	l.setDefaults(command.FirstPaymentDate)
+	l.UpdatedAt = time.Now()
+}
+
+// setDefaults fills the method and frequency and, without an explicit first payment
+// date, schedules the first installment one period after the start.
+func (l *Loan) setDefaults(firstPaymentDate int64) {
+	if l.Method == "" {
+		l.Method = Annuity
+	}
+	if l.PaymentFrequency == 0 {
+		l.PaymentFrequency = 12
+	}
+	if firstPaymentDate > 0 {
+		l.FirstPaymentDate = calendar.TruncateDay(time.Unix(firstPaymentDate, 0))
+	} else {
+		l.FirstPaymentDate = calendar.AddMonths(l.StartDate, 12/l.PaymentFrequency)
+	}
+}
+
+// periodRate is the interest rate charged per installment period.
+func (l *Loan) periodRate() float64 {
+	return l.AnnualRate / 100 / float64(l.PaymentFrequency)
+}
@@ -0,0 +1,77 @@
+package loan
+
+import (
+	"errors"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Payment is an actual payment made on a loan, split into the principal it repaid and
+// the interest it covered.
+type Payment struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	LoanID        uuid.UUID  `json:"loanId" db:"loan_id"`
+	UserID        uuid.UUID  `json:"userId" db:"user_id"`
+	PaidAt        time.Time  `json:"paidAt" db:"paid_at"`
+	Amount        float64    `json:"amount" db:"amount"`
+	Principal     float64    `json:"principal" db:"principal"`
+	Interest      float64    `json:"interest" db:"interest"`
+	FromAssetID   *uuid.UUID `json:"fromAssetId,omitempty" db:"from_asset_id"`
+	TransactionID *uuid.UUID `json:"transactionId,omitempty" db:"transaction_id"`
+	Notes         string     `json:"notes" db:"notes"`
+	CreatedAt     time.Time  `json:"createdAt" db:"created_at"`
+}
+
+// NewPayment splits a payment made on the loan. Unless the interest part is given, the
+// payment first covers the interest accrued day by day on the outstanding principal
+// since the previous payment, and the rest repays principal.
+func (l *Loan) NewPayment(command RecordPaymentCommand, previous []*Payment) (*Payment, error) {
+	paidAt := time.Unix(command.PaidAt, 0)
+	outstanding, since := l.outstanding(previous)
+
+	interest := 0.0
+	if command.Interest != nil {
+		interest = *command.Interest
+	} else if days := paidAt.Sub(since).Hours() / 24; days > 0 {
+		interest = outstanding * l.AnnualRate / 100 * math.Floor(days) / 365
+	}
+	interest = math.Min(interest, command.Amount)
+
+	principal := command.Amount - interest
+	if principal > outstanding+1e-9 {
+		return nil, errors.New("payment exceeds the outstanding principal")
+	}
+
+	p := &Payment{
+		ID:        uuid.New(),
+		LoanID:    l.ID,
+		UserID:    l.UserID,
+		PaidAt:    paidAt,
+		Amount:    command.Amount,
+		Principal: principal,
+		Interest:  interest,
+		Notes:     command.Notes,
+		CreatedAt: time.Now(),
+	}
+	if command.FromAssetID != nil {
+		fromAssetID := uuid.MustParse(*command.FromAssetID)
+		p.FromAssetID = &fromAssetID
+	}
+	return p, nil
+}
+
+// outstanding returns the principal still owed after the payments and the date
+// interest has been paid up to.
+func (l *Loan) outstanding(payments []*Payment) (float64, time.Time) {
+	remaining := l.Principal
+	since := l.StartDate
+	for _, p := range payments {
+		remaining -= p.Principal
+		if p.PaidAt.After(since) {
+			since = p.PaidAt
+		}
+	}
+	return remaining, since
+}
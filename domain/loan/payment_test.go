@@ -0,0 +1,50 @@
+package loan
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestNewPayment(t *testing.T) {
+	loan := &Loan{Principal: 10000, AnnualRate: 10, TermPeriods: 12, PaymentFrequency: 12, StartDate: date(2024, 1, 1)}
+	earlier := []*Payment{{PaidAt: date(2024, 1, 31), Principal: 5000}}
+	override := 50.0
+
+	tests := []struct {
+		name      string
+		amount    float64
+		interest  *float64
+		paidAt    time.Time
+		previous  []*Payment
+		principal float64
+		err       bool
+	}{
+		{"interest accrues from the start date", 500, nil, date(2024, 1, 31), nil, 500 - 82.191781, false},
+		{"interest accrues from the last payment on what is left", 500, nil, date(2024, 3, 1), earlier, 500 - 41.095890, false},
+		{"part days do not accrue", 500, nil, date(2024, 1, 31).Add(23 * time.Hour), nil, 500 - 82.191781, false},
+		{"given interest is used as is", 500, &override, date(2024, 1, 31), nil, 450, false},
+		{"a payment smaller than the interest repays nothing", 50, nil, date(2024, 1, 31), nil, 0, false},
+		{"a payment beyond the outstanding principal is refused", 6000, nil, date(2024, 3, 1), earlier, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p, err := loan.NewPayment(RecordPaymentCommand{Amount: tt.amount, Interest: tt.interest, PaidAt: tt.paidAt.Unix()}, tt.previous)
+			if tt.err {
+				if err == nil {
+					t.Fatal("NewPayment succeeded, want an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewPayment: %v", err)
+			}
+			if math.Abs(p.Principal-tt.principal) > 1e-6 {
+				t.Errorf("principal = %v, want %v", p.Principal, tt.principal)
+			}
+			if math.Abs(p.Principal+p.Interest-tt.amount) > 1e-9 {
+				t.Errorf("principal %v and interest %v do not add up to %v", p.Principal, p.Interest, tt.amount)
+			}
+		})
+	}
+}
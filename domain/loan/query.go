@@ -0,0 +1,30 @@
+package loan
+
+import (
+	"time"
+)
+
+type GetLoanByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserLoansQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetScheduleQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetPaymentsQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetStatusQuery struct {
+	ID     string    `json:"id" validate:"required"`
+	UserID string    `json:"userId" validate:"required"`
+	AsOf   time.Time `json:"asOf"`
+}
@@ -0,0 +1,22 @@
+package loan
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, loan *Loan) error
+	Update(ctx context.Context, loan *Loan) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Loan, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) (*Loan, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Loan, error)
+	// GetPayments returns the payments of a loan, oldest first.
+	GetPayments(ctx context.Context, loanID uuid.UUID) ([]*Payment, error)
+	// RecordPayment reduces the debt asset by the principal part, withdraws the amount
+	// from the paying asset when there is one and stores the payment, all in one
+	// database transaction.
+	RecordPayment(ctx context.Context, loan *Loan, payment *Payment) error
+}
@@ -0,0 +1,62 @@
+package loan
+
+import (
+	"math"
+	"time"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// Installment is one scheduled payment of a loan.
+type Installment struct {
+	Number             int       `json:"number"`
+	DueDate            time.Time `json:"dueDate"`
+	Payment            float64   `json:"payment"`
+	Principal          float64   `json:"principal"`
+	Interest           float64   `json:"interest"`
+	RemainingPrincipal float64   `json:"remainingPrincipal"`
+}
+
+// Schedule generates the full amortization schedule of the loan. Interest is charged
+// per period on the principal still outstanding, and the last installment absorbs any
+// rounding so the loan is repaid exactly.
+func (l *Loan) Schedule() []*Installment {
+	rate := l.periodRate()
+	n := l.TermPeriods
+	step := 12 / l.PaymentFrequency
+
+	annuity := l.Principal / float64(n)
+	if l.Method == Annuity && rate > 0 {
+		annuity = l.Principal * rate / (1 - math.Pow(1+rate, -float64(n)))
+	}
+	equalPrincipal := l.Principal / float64(n)
+
+	installments := make([]*Installment, 0, n)
+	remaining := l.Principal
+	for k := 1; k <= n; k++ {
+		interest := remaining * rate
+		principal := equalPrincipal
+		if l.Method == Annuity {
+			principal = annuity - interest
+		}
+		if k == n || principal > remaining {
+			principal = remaining
+		}
+		remaining -= principal
+
+		installments = append(installments, &Installment{
+			Number:             k,
+			DueDate:            calendar.AddMonths(l.FirstPaymentDate, (k-1)*step),
+			Payment:            principal + interest,
+			Principal:          principal,
+			Interest:           interest,
+			RemainingPrincipal: remaining,
+		})
+	}
+	return installments
+}
+
+// MaturityDate is the due date of the last installment.
+func (l *Loan) MaturityDate() time.Time {
+	return calendar.AddMonths(l.FirstPaymentDate, (l.TermPeriods-1)*12/l.PaymentFrequency)
+}
@@ -0,0 +1,90 @@
+package loan
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestSchedule(t *testing.T) {
+	tests := []struct {
+		name          string
+		loan          Loan
+		firstPayment  float64
+		firstInterest float64
+		lastPayment   float64
+		totalInterest float64
+		dueDates      []time.Time
+	}{
+		{
+			name:          "annuity pays the same installment",
+			loan:          Loan{Principal: 12000, AnnualRate: 12, TermPeriods: 12, PaymentFrequency: 12, Method: Annuity, FirstPaymentDate: date(2024, 1, 31)},
+			firstPayment:  1066.185464,
+			firstInterest: 120,
+			lastPayment:   1066.185464,
+			totalInterest: 794.225570,
+			dueDates:      []time.Time{date(2024, 1, 31), date(2024, 2, 29), date(2024, 3, 31)},
+		},
+		{
+			name:          "equal principal pays falling interest",
+			loan:          Loan{Principal: 12000, AnnualRate: 12, TermPeriods: 12, PaymentFrequency: 12, Method: EqualPrincipal, FirstPaymentDate: date(2024, 1, 15)},
+			firstPayment:  1120,
+			firstInterest: 120,
+			lastPayment:   1010,
+			totalInterest: 780,
+			dueDates:      []time.Time{date(2024, 1, 15), date(2024, 2, 15), date(2024, 3, 15)},
+		},
+		{
+			name:          "interest free annuity splits the principal evenly",
+			loan:          Loan{Principal: 1200, TermPeriods: 4, PaymentFrequency: 4, Method: Annuity, FirstPaymentDate: date(2024, 3, 31)},
+			firstPayment:  300,
+			lastPayment:   300,
+			totalInterest: 0,
+			dueDates:      []time.Time{date(2024, 3, 31), date(2024, 6, 30), date(2024, 9, 30), date(2024, 12, 31)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			installments := tt.loan.Schedule()
+			if len(installments) != tt.loan.TermPeriods {
+				t.Fatalf("Schedule returned %d installments, want %d", len(installments), tt.loan.TermPeriods)
+			}
+
+			first, last := installments[0], installments[len(installments)-1]
+			if math.Abs(first.Payment-tt.firstPayment) > 1e-6 || math.Abs(first.Interest-tt.firstInterest) > 1e-6 {
+				t.Errorf("first installment %v with %v interest, want %v with %v", first.Payment, first.Interest, tt.firstPayment, tt.firstInterest)
+			}
+			if math.Abs(last.Payment-tt.lastPayment) > 1e-6 {
+				t.Errorf("last installment = %v, want %v", last.Payment, tt.lastPayment)
+			}
+			if last.RemainingPrincipal != 0 {
+				t.Errorf("remaining principal after the last installment = %v, want 0", last.RemainingPrincipal)
+			}
+
+			var principal, interest float64
+			for i, installment := range installments {
+				principal += installment.Principal
+				interest += installment.Interest
+				if math.Abs(installment.Payment-installment.Principal-installment.Interest) > 1e-9 {
+					t.Errorf("installment %d does not add up: %+v", i+1, installment)
+				}
+				if i < len(tt.dueDates) && !installment.DueDate.Equal(tt.dueDates[i]) {
+					t.Errorf("installment %d due %v, want %v", i+1, installment.DueDate, tt.dueDates[i])
+				}
+			}
+			if math.Abs(principal-tt.loan.Principal) > 1e-6 {
+				t.Errorf("principal repaid = %v, want %v", principal, tt.loan.Principal)
+			}
+			if math.Abs(interest-tt.totalInterest) > 1e-6 {
+				t.Errorf("interest paid = %v, want %v", interest, tt.totalInterest)
+			}
+			if maturity := tt.loan.MaturityDate(); !maturity.Equal(last.DueDate) {
+				t.Errorf("MaturityDate = %v, want the last due date %v", maturity, last.DueDate)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package loan
+
+import (
+	"math"
+	"time"
+)
+
+// Status compares the payments made on a loan with its schedule as of a date.
+// Arrears is what the schedule expected to be paid by then beyond what was paid.
+type Status struct {
+	AsOf                        time.Time    `json:"asOf"`
+	OriginalPrincipal           float64      `json:"originalPrincipal"`
+	PrincipalPaid               float64      `json:"principalPaid"`
+	InterestPaid                float64      `json:"interestPaid"`
+	RemainingPrincipal          float64      `json:"remainingPrincipal"`
+	PaymentsMade                int          `json:"paymentsMade"`
+	InstallmentsDue             int          `json:"installmentsDue"`
+	ScheduledPrincipalToDate    float64      `json:"scheduledPrincipalToDate"`
+	ScheduledInterestToDate     float64      `json:"scheduledInterestToDate"`
+	ScheduledRemainingPrincipal float64      `json:"scheduledRemainingPrincipal"`
+	ScheduledInterestRemaining  float64      `json:"scheduledInterestRemaining"`
+	Arrears                     float64      `json:"arrears"`
+	NextInstallment             *Installment `json:"nextInstallment,omitempty"`
+	MaturityDate                time.Time    `json:"maturityDate"`
+}
+
+// StatusAt reports the loan position as of the given date from the payments made
+// up to then.
+func (l *Loan) StatusAt(payments []*Payment, asOf time.Time) *Status {
+	s := &Status{
+		AsOf:                        asOf,
+		OriginalPrincipal:           l.Principal,
+		ScheduledRemainingPrincipal: l.Principal,
+		MaturityDate:                l.MaturityDate(),
+	}
+
+	for _, p := range payments {
+		if p.PaidAt.After(asOf) {
+			continue
+		}
+		s.PrincipalPaid += p.Principal
+		s.InterestPaid += p.Interest
+		s.PaymentsMade++
+	}
+	s.RemainingPrincipal = l.Principal - s.PrincipalPaid
+
+	for _, installment := range l.Schedule() {
+		if installment.DueDate.After(asOf) {
+			if s.NextInstallment == nil {
+				s.NextInstallment = installment
+			}
+			s.ScheduledInterestRemaining += installment.Interest
+			continue
+		}
+		s.InstallmentsDue++
+		s.ScheduledPrincipalToDate += installment.Principal
+		s.ScheduledInterestToDate += installment.Interest
+		s.ScheduledRemainingPrincipal = installment.RemainingPrincipal
+	}
+
+	due := s.ScheduledPrincipalToDate + s.ScheduledInterestToDate
+	s.Arrears = math.Max(0, due-s.PrincipalPaid-s.InterestPaid)
+	return s
+}
@@ -0,0 +1,96 @@
+package lot
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+type AssetLots struct {
+	AssetID         uuid.UUID             `json:"assetId"`
+	CostBasisMethod asset.CostBasisMethod `json:"costBasisMethod"`
+	CostBasis       CostBasis             `json:"costBasis"`
+	Lots            []*Lot                `json:"lots"`
+}
+
+type Handler struct {
+	repo      Repository
+	assetRepo asset.Repository
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository) *Handler {
+	return &Handler{
+		repo:      repo,
+		assetRepo: assetRepo,
+	}
+}
+
+func (h *Handler) HandleGetAssetLotsQuery(ctx context.Context, query GetAssetLotsQuery) (*AssetLots, error) {
+	existingAsset, err := h.ownedAsset(ctx, query.UserID, query.AssetID)
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := h.repo.GetByAssetID(ctx, existingAsset.ID, query.OpenOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	var open []*Lot
+	for _, l := range lots {
+		if l.RemainingQuantity > 0 {
+			open = append(open, l)
+		}
+	}
+
+	return &AssetLots{
+		AssetID:         existingAsset.ID,
+		CostBasisMethod: existingAsset.CostBasisMethod,
+		CostBasis:       NewCostBasis(open),
+		Lots:            lots,
+	}, nil
+}
+
+func (h *Handler) HandleGetDisposalsQuery(ctx context.Context, query GetDisposalsQuery) ([]*Disposal, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	var assetID *uuid.UUID
+	if query.AssetID != nil {
+		existingAsset, err := h.ownedAsset(ctx, query.UserID, *query.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		assetID = &existingAsset.ID
+	}
+
+	return h.repo.GetDisposals(ctx, userID, assetID, query.From, query.To)
+}
+
+func (h *Handler) ownedAsset(ctx context.Context, userIDValue, assetIDValue string) (*asset.Asset, error) {
+	assetID, err := uuid.Parse(assetIDValue)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+
+	return existingAsset, nil
+}
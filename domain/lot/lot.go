@@ -0,0 +1,149 @@
+package lot
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+// Lot is a quantity of an asset acquired at a single unit price.
+type Lot struct {
+	ID                uuid.UUID  `json:"id" db:"id"`
+	UserID            uuid.UUID  `json:"userId" db:"user_id"`
+	AssetID           uuid.UUID  `json:"assetId" db:"asset_id"`
+	TransactionID     *uuid.UUID `json:"transactionId,omitempty" db:"transaction_id"`
+	Quantity          float64    `json:"quantity" db:"quantity"`
+	RemainingQuantity float64    `json:"remainingQuantity" db:"remaining_quantity"`
+	UnitPrice         float64    `json:"unitPrice" db:"unit_price"`
+	Currency          string     `json:"currency" db:"currency"`
+	AcquiredAt        time.Time  `json:"acquiredAt" db:"acquired_at"`
+	CreatedAt         time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt         time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+// DisposalKind tells a sale, which realizes a gain or loss, from any other reduction.
+type DisposalKind string
+
+const (
+	// Sale disposals were relieved by a SELL and carry its price as proceeds
+	Sale DisposalKind = "SALE"
+	// Reduction disposals were relieved by a withdrawal, fee, negative adjustment or the
+	// outgoing leg of a transfer; the cost leaves the holding without proceeds
+	Reduction DisposalKind = "REDUCTION"
+)
+
+// Disposal is the part of a lot relieved by a transaction that reduced the asset quantity.
+type Disposal struct {
+	ID            uuid.UUID    `json:"id" db:"id"`
+	UserID        uuid.UUID    `json:"userId" db:"user_id"`
+	LotID         uuid.UUID    `json:"lotId" db:"lot_id"`
+	AssetID       uuid.UUID    `json:"assetId" db:"asset_id"`
+	TransactionID uuid.UUID    `json:"transactionId" db:"transaction_id"`
+	Kind          DisposalKind `json:"kind" db:"kind"`
+	Quantity      float64      `json:"quantity" db:"quantity"`
+	UnitCost      float64      `json:"unitCost" db:"unit_cost"`
+	UnitProceeds  float64      `json:"unitProceeds" db:"unit_proceeds"`
+	Currency      string       `json:"currency" db:"currency"`
+	AcquiredAt    time.Time    `json:"acquiredAt" db:"acquired_at"`
+	DisposedAt    time.Time    `json:"disposedAt" db:"disposed_at"`
+	CreatedAt     time.Time    `json:"createdAt" db:"created_at"`
+}
+
+// CostBasis summarises the open lots of an asset.
+type CostBasis struct {
+	Quantity    float64 `json:"quantity"`
+	TotalCost   float64 `json:"totalCost"`
+	AverageCost float64 `json:"averageCost"`
+	Currency    string  `json:"currency"`
+}
+
+func NewLot(t *transaction.Transaction) *Lot {
+	now := time.Now()
+	transactionID := t.ID
+	return &Lot{
+		ID:                uuid.New(),
+		UserID:            t.UserID,
+		AssetID:           t.AssetID,
+		TransactionID:     &transactionID,
+		Quantity:          t.Delta(),
+		RemainingQuantity: t.Delta(),
+		UnitPrice:         t.Price,
+		Currency:          t.Currency,
+		AcquiredAt:        t.TransactionDate,
+		CreatedAt:         now,
+		UpdatedAt:         now,
+	}
+}
+
+// Carry re-opens relieved lots on the asset credited by t, keeping their original
+// unit cost and acquisition date. Used for the receiving leg of a transfer. Any part
+// of t not covered by the disposals is opened as a regular lot.
+func Carry(disposals []*Disposal, t *transaction.Transaction) []*Lot {
+	now := time.Now()
+	transactionID := t.ID
+	lots := make([]*Lot, 0, len(disposals)+1)
+	var covered float64
+	for _, d := range disposals {
+		covered += d.Quantity
+		lots = append(lots, &Lot{
+			ID:                uuid.New(),
+			UserID:            t.UserID,
+			AssetID:           t.AssetID,
+			TransactionID:     &transactionID,
+			Quantity:          d.Quantity,
+			RemainingQuantity: d.Quantity,
+			UnitPrice:         d.UnitCost,
+			Currency:          d.Currency,
+			AcquiredAt:        d.AcquiredAt,
+			CreatedAt:         now,
+			UpdatedAt:         now,
+		})
+	}
+	if uncovered := t.Delta() - covered; uncovered > epsilon {
+		remainder := NewLot(t)
+		remainder.Quantity = uncovered
+		remainder.RemainingQuantity = uncovered
+		lots = append(lots, remainder)
+	}
+	return lots
+}
+
+// Cost returns the cost of the quantity still held in the lot.
+func (l *Lot) Cost() float64 {
+	return l.RemainingQuantity * l.UnitPrice
+}
+
+// Cost returns the cost basis relieved by the disposal.
+func (d *Disposal) Cost() float64 {
+	return d.Quantity * d.UnitCost
+}
+
+// Proceeds returns what the disposed quantity was sold for.
+func (d *Disposal) Proceeds() float64 {
+	return d.Quantity * d.UnitProceeds
+}
+
+// Gain returns the realized gain or loss of the disposal. Only sales realize one.
+func (d *Disposal) Gain() float64 {
+	if d.Kind != Sale {
+		return 0
+	}
+	return d.Proceeds() - d.Cost()
+}
+
+func NewCostBasis(lots []*Lot) CostBasis {
+	var basis CostBasis
+	for _, l := range lots {
+		basis.Quantity += l.RemainingQuantity
+		basis.TotalCost += l.Cost()
+		if basis.Currency == "" {
+			basis.Currency = l.Currency
+		}
+	}
+	if basis.Quantity > 0 {
+		basis.AverageCost = basis.TotalCost / basis.Quantity
+	}
+	return basis
+}
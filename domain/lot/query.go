@@ -0,0 +1,18 @@
+package lot
+
+import (
+	"time"
+)
+
+type GetAssetLotsQuery struct {
+	UserID   string `json:"userId" validate:"required"`
+	AssetID  string `json:"assetId" validate:"required"`
+	OpenOnly bool   `json:"openOnly,omitempty"`
+}
+
+type GetDisposalsQuery struct {
+	UserID  string     `json:"userId" validate:"required"`
+	AssetID *string    `json:"assetId,omitempty"`
+	From    *time.Time `json:"from,omitempty"`
+	To      *time.Time `json:"to,omitempty"`
+}
@@ -0,0 +1,117 @@
+package lot
+
+import (
+	"errors"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+// epsilon absorbs the rounding left over by DECIMAL(20,8) columns.
+const epsilon = 1e-8
+
+// ErrMixedCurrencies is returned when average cost relief meets open lots bought in
+// different currencies, whose costs cannot be averaged without converting them.
+var ErrMixedCurrencies = errors.New("average cost needs every open lot in one currency, choose another cost basis method")
+
+// Relieve takes the quantity removed by t out of the open lots according to method.
+// Only lots acquired by the date of t are relieved, so a backdated disposal never takes
+// from a later purchase. The lots are updated in place and the resulting disposals are
+// returned. Quantity not covered by any open lot produces no disposal.
+func Relieve(open []*Lot, t *transaction.Transaction, method asset.CostBasisMethod) ([]*Disposal, error) {
+	held := make([]*Lot, 0, len(open))
+	for _, l := range open {
+		if !l.AcquiredAt.After(t.TransactionDate) {
+			held = append(held, l)
+		}
+	}
+
+	quantity := math.Abs(t.Delta())
+	if method == asset.AverageCost {
+		return relieveAverage(held, t, quantity)
+	}
+
+	sort.SliceStable(held, func(i, j int) bool {
+		switch method {
+		case asset.LIFO:
+			return held[i].AcquiredAt.After(held[j].AcquiredAt)
+		case asset.HighestCost:
+			return held[i].UnitPrice > held[j].UnitPrice
+		default:
+			return held[i].AcquiredAt.Before(held[j].AcquiredAt)
+		}
+	})
+
+	var disposals []*Disposal
+	for _, l := range held {
+		if quantity <= epsilon {
+			break
+		}
+		if l.RemainingQuantity <= epsilon {
+			continue
+		}
+		taken := math.Min(quantity, l.RemainingQuantity)
+		l.RemainingQuantity -= taken
+		l.UpdatedAt = time.Now()
+		quantity -= taken
+		disposals = append(disposals, newDisposal(l, t, taken, l.UnitPrice))
+	}
+	return disposals, nil
+}
+
+// relieveAverage takes quantity pro rata from every open lot at the weighted average
+// cost, which keeps the average cost of what remains unchanged.
+func relieveAverage(open []*Lot, t *transaction.Transaction, quantity float64) ([]*Disposal, error) {
+	basis := NewCostBasis(open)
+	if basis.Quantity <= epsilon {
+		return nil, nil
+	}
+	for _, l := range open {
+		if l.RemainingQuantity > epsilon && l.Currency != "" && !strings.EqualFold(l.Currency, basis.Currency) {
+			return nil, ErrMixedCurrencies
+		}
+	}
+	share := math.Min(quantity/basis.Quantity, 1)
+
+	var disposals []*Disposal
+	for _, l := range open {
+		if l.RemainingQuantity <= epsilon {
+			continue
+		}
+		taken := l.RemainingQuantity * share
+		l.RemainingQuantity -= taken
+		l.UpdatedAt = time.Now()
+		disposals = append(disposals, newDisposal(l, t, taken, basis.AverageCost))
+	}
+	return disposals, nil
+}
+
+func newDisposal(l *Lot, t *transaction.Transaction, quantity, unitCost float64) *Disposal {
+	kind := Reduction
+	var unitProceeds float64
+	if t.Type == transaction.Sell {
+		kind = Sale
+		unitProceeds = t.Price
+	}
+	return &Disposal{
+		ID:            uuid.New(),
+		UserID:        t.UserID,
+		LotID:         l.ID,
+		AssetID:       l.AssetID,
+		TransactionID: t.ID,
+		Kind:          kind,
+		Quantity:      quantity,
+		UnitCost:      unitCost,
+		UnitProceeds:  unitProceeds,
+		Currency:      l.Currency,
+		AcquiredAt:    l.AcquiredAt,
+		DisposedAt:    t.TransactionDate,
+		CreatedAt:     time.Now(),
+	}
+}
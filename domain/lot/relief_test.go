@@ -0,0 +1,219 @@
+package lot
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+func openLot(quantity, unitPrice float64, acquiredAt time.Time) *Lot {
+	return &Lot{
+		ID:                uuid.New(),
+		Quantity:          quantity,
+		RemainingQuantity: quantity,
+		UnitPrice:         unitPrice,
+		Currency:          "USD",
+		AcquiredAt:        acquiredAt,
+	}
+}
+
+func entry(transactionType transaction.TransactionType, quantity, price float64) *transaction.Transaction {
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		Type:            transactionType,
+		Quantity:        quantity,
+		Price:           price,
+		TransactionDate: time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestRelieve(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		method asset.CostBasisMethod
+		t      *transaction.Transaction
+		// remaining is the quantity left in the lots bought in January, February and March
+		remaining []float64
+		cost      float64
+		kind      DisposalKind
+	}{
+		{"fifo takes the oldest lots first", asset.FIFO, entry(transaction.Sell, 15, 30), []float64{0, 5, 10}, 10*10 + 5*20, Sale},
+		{"lifo takes the newest lots first", asset.LIFO, entry(transaction.Sell, 15, 30), []float64{10, 5, 0}, 10*15 + 5*20, Sale},
+		{"highest cost takes the dearest lots first", asset.HighestCost, entry(transaction.Sell, 15, 30), []float64{10, 0, 5}, 10*20 + 5*15, Sale},
+		{"average cost takes from every lot", asset.AverageCost, entry(transaction.Sell, 15, 30), []float64{5, 5, 5}, 15 * 15, Sale},
+		{"withdrawal is a reduction", asset.FIFO, entry(transaction.Withdraw, 4, 0), []float64{6, 10, 10}, 4 * 10, Reduction},
+		{"fee is a reduction", asset.FIFO, entry(transaction.Fee, 1, 0), []float64{9, 10, 10}, 10, Reduction},
+		{"more than is held relieves every lot", asset.FIFO, entry(transaction.Sell, 40, 30), []float64{0, 0, 0}, 10*10 + 10*20 + 10*15, Sale},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Out of order on purpose, the method decides the order
+			lots := []*Lot{openLot(10, 15, mar), openLot(10, 10, jan), openLot(10, 20, feb)}
+			byMonth := []*Lot{lots[1], lots[2], lots[0]}
+
+			disposals, err := Relieve(lots, tt.t, tt.method)
+			if err != nil {
+				t.Fatalf("Relieve: %v", err)
+			}
+
+			for i, l := range byMonth {
+				if math.Abs(l.RemainingQuantity-tt.remaining[i]) > epsilon {
+					t.Errorf("lot %d remaining = %v, want %v", i, l.RemainingQuantity, tt.remaining[i])
+				}
+			}
+			var cost, quantity float64
+			for _, d := range disposals {
+				cost += d.Cost()
+				quantity += d.Quantity
+				if d.Kind != tt.kind {
+					t.Errorf("disposal kind = %s, want %s", d.Kind, tt.kind)
+				}
+				if d.TransactionID != tt.t.ID || !d.DisposedAt.Equal(tt.t.TransactionDate) {
+					t.Errorf("disposal not tied to the relieving transaction")
+				}
+			}
+			if math.Abs(cost-tt.cost) > 1e-6 {
+				t.Errorf("relieved cost = %v, want %v", cost, tt.cost)
+			}
+			if want := math.Min(tt.t.Quantity, 30); math.Abs(quantity-want) > epsilon {
+				t.Errorf("relieved quantity = %v, want %v", quantity, want)
+			}
+		})
+	}
+}
+
+func TestRelieveBackdated(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	feb := time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)
+	mar := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		method   asset.CostBasisMethod
+		quantity float64
+		date     time.Time
+		// remaining is the quantity left in the lots bought in January, February and March
+		remaining []float64
+	}{
+		{"lifo skips lots bought after the sale", asset.LIFO, 5, feb.AddDate(0, 0, 14), []float64{10, 5, 10}},
+		{"highest cost skips lots bought after the sale", asset.HighestCost, 5, jan.AddDate(0, 0, 14), []float64{5, 10, 10}},
+		{"average cost spreads over the lots held at the sale", asset.AverageCost, 10, feb.AddDate(0, 0, 14), []float64{5, 5, 10}},
+		{"a lot bought on the day of the sale is held", asset.LIFO, 5, mar, []float64{10, 10, 5}},
+		{"nothing held yet", asset.FIFO, 5, jan.AddDate(0, 0, -1), []float64{10, 10, 10}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lots := []*Lot{openLot(10, 10, jan), openLot(10, 20, feb), openLot(10, 15, mar)}
+			sale := entry(transaction.Sell, tt.quantity, 30)
+			sale.TransactionDate = tt.date
+
+			if _, err := Relieve(lots, sale, tt.method); err != nil {
+				t.Fatalf("Relieve: %v", err)
+			}
+			for i, l := range lots {
+				if math.Abs(l.RemainingQuantity-tt.remaining[i]) > epsilon {
+					t.Errorf("lot %d remaining = %v, want %v", i, l.RemainingQuantity, tt.remaining[i])
+				}
+			}
+		})
+	}
+}
+
+func TestRelieveMixedCurrencies(t *testing.T) {
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	inEuros := func(l *Lot) *Lot {
+		l.Currency = "EUR"
+		return l
+	}
+
+	tests := []struct {
+		name   string
+		method asset.CostBasisMethod
+		euros  *Lot
+		err    error
+	}{
+		{"average cost rejects lots in two currencies", asset.AverageCost, inEuros(openLot(10, 9, jan)), ErrMixedCurrencies},
+		{"average cost ignores a lot bought after the sale", asset.AverageCost, inEuros(openLot(10, 9, time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC))), nil},
+		{"average cost ignores a closed lot", asset.AverageCost, inEuros(&Lot{Quantity: 10, UnitPrice: 9, AcquiredAt: jan}), nil},
+		{"fifo relieves each lot at its own cost", asset.FIFO, inEuros(openLot(10, 9, jan)), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dollars := openLot(10, 10, jan)
+			lots := []*Lot{dollars, tt.euros}
+
+			_, err := Relieve(lots, entry(transaction.Sell, 5, 30), tt.method)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Relieve error = %v, want %v", err, tt.err)
+			}
+			if err != nil && (dollars.RemainingQuantity != 10 || tt.euros.RemainingQuantity != 10) {
+				t.Errorf("rejected relief changed the lots")
+			}
+		})
+	}
+}
+
+func TestDisposalGain(t *testing.T) {
+	tests := []struct {
+		name     string
+		disposal Disposal
+		want     float64
+	}{
+		{"sale at a profit", Disposal{Kind: Sale, Quantity: 2, UnitCost: 10, UnitProceeds: 15}, 10},
+		{"sale at a loss", Disposal{Kind: Sale, Quantity: 2, UnitCost: 10, UnitProceeds: 7}, -6},
+		{"reduction realizes nothing", Disposal{Kind: Reduction, Quantity: 2, UnitCost: 10}, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.disposal.Gain(); math.Abs(got-tt.want) > epsilon {
+				t.Errorf("Gain() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCarry(t *testing.T) {
+	acquired := time.Date(2023, 5, 1, 0, 0, 0, 0, time.UTC)
+	disposals := []*Disposal{
+		{Quantity: 3, UnitCost: 10, Currency: "USD", AcquiredAt: acquired},
+		{Quantity: 2, UnitCost: 12, Currency: "USD", AcquiredAt: acquired.AddDate(0, 1, 0)},
+	}
+
+	tests := []struct {
+		name     string
+		quantity float64
+		lots     int
+	}{
+		{"fully covered", 5, 2},
+		{"remainder opened at the transaction price", 6, 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deposit := entry(transaction.Deposit, tt.quantity, 11)
+			lots := Carry(disposals, deposit)
+			if len(lots) != tt.lots {
+				t.Fatalf("Carry returned %d lots, want %d", len(lots), tt.lots)
+			}
+			if lots[0].UnitPrice != 10 || !lots[0].AcquiredAt.Equal(acquired) {
+				t.Errorf("carried lot lost its cost or acquisition date: %+v", lots[0])
+			}
+			var quantity float64
+			for _, l := range lots {
+				quantity += l.RemainingQuantity
+			}
+			if math.Abs(quantity-tt.quantity) > epsilon {
+				t.Errorf("carried quantity = %v, want %v", quantity, tt.quantity)
+			}
+		})
+	}
+}
@@ -0,0 +1,13 @@
+package lot
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	GetByAssetID(ctx context.Context, assetID uuid.UUID, openOnly bool) ([]*Lot, error)
+	GetDisposals(ctx context.Context, userID uuid.UUID, assetID *uuid.UUID, from, to *time.Time) ([]*Disposal, error)
+}
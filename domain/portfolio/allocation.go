@@ -0,0 +1,98 @@
+package portfolio
+
+import (
+	"sort"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+type GroupBy string
+
+const (
+	ByAssetType   GroupBy = "assetType"
+	ByAccountType GroupBy = "accountType"
+	ByAccount     GroupBy = "account"
+	ByDefinition  GroupBy = "definition"
+)
+
+type AllocationGroup struct {
+	Key        string  `json:"key"`
+	Label      string  `json:"label"`
+	Value      float64 `json:"value"`
+	Percentage float64 `json:"percentage"`
+	Holdings   int     `json:"holdings"`
+}
+
+// Allocation splits the gross assets of a portfolio into groups. Liabilities are
+// left out so that the percentages describe what is owned.
+type Allocation struct {
+	BaseCurrency string             `json:"baseCurrency"`
+	GroupBy      GroupBy            `json:"groupBy"`
+	Total        float64            `json:"total"`
+	Unpriced     int                `json:"unpriced"`
+	Groups       []*AllocationGroup `json:"groups"`
+}
+
+func NewAllocation(p *valuation.Portfolio, groupBy GroupBy) *Allocation {
+	allocation := &Allocation{
+		BaseCurrency: p.BaseCurrency,
+		GroupBy:      groupBy,
+	}
+
+	groups := make(map[string]*AllocationGroup)
+	for _, h := range p.Holdings {
+		if h.Liability {
+			continue
+		}
+		if !h.Priced {
+			allocation.Unpriced++
+		}
+
+		key, label := groupKey(h, groupBy)
+		group, ok := groups[key]
+		if !ok {
+			group = &AllocationGroup{
+				Key:   key,
+				Label: label,
+			}
+			groups[key] = group
+			allocation.Groups = append(allocation.Groups, group)
+		}
+		group.Value += h.Value
+		group.Holdings++
+		allocation.Total += h.Value
+	}
+
+	for _, group := range allocation.Groups {
+		if allocation.Total != 0 {
+			group.Percentage = group.Value / allocation.Total * 100
+		}
+	}
+
+	sort.SliceStable(allocation.Groups, func(i, j int) bool {
+		return allocation.Groups[i].Value > allocation.Groups[j].Value
+	})
+	return allocation
+}
+
+func groupKey(h *valuation.Valuation, groupBy GroupBy) (string, string) {
+	switch groupBy {
+	case ByAccountType:
+		return h.AccountType, h.AccountType
+	case ByAccount:
+		return h.AccountID.String(), h.AccountName
+	case ByDefinition:
+		return h.DefinitionID.String(), h.Symbol
+	default:
+		return h.AssetType, h.AssetType
+	}
+}
+
+func isValidGroupBy(groupBy GroupBy) bool {
+	switch groupBy {
+	case ByAssetType, ByAccountType, ByAccount, ByDefinition:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,67 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+func TestNewAllocation(t *testing.T) {
+	brokerage, exchange := uuid.New(), uuid.New()
+	valued := func(accountID uuid.UUID, accountType, assetType string, value float64, priced, liability bool) *valuation.Valuation {
+		return &valuation.Valuation{
+			Holding: valuation.Holding{
+				AccountID:    accountID,
+				AccountName:  accountType,
+				AccountType:  accountType,
+				DefinitionID: uuid.New(),
+				AssetType:    assetType,
+				Liability:    liability,
+			},
+			Value:  value,
+			Priced: priced,
+		}
+	}
+	p := &valuation.Portfolio{
+		BaseCurrency: "USD",
+		Holdings: []*valuation.Valuation{
+			valued(brokerage, "BANK", "STOCK", 600, true, false),
+			valued(brokerage, "BANK", "CASH", 100, true, false),
+			valued(exchange, "CRYPTO_EXCHANGE", "CRYPTOCURRENCY", 300, true, false),
+			valued(exchange, "CRYPTO_EXCHANGE", "CRYPTOCURRENCY", 0, false, false),
+			valued(brokerage, "BANK", "DEBT", 5000, true, true),
+		},
+	}
+
+	tests := []struct {
+		groupBy GroupBy
+		want    map[string]float64
+	}{
+		{ByAssetType, map[string]float64{"STOCK": 60, "CRYPTOCURRENCY": 30, "CASH": 10}},
+		{ByAccountType, map[string]float64{"BANK": 70, "CRYPTO_EXCHANGE": 30}},
+		{ByAccount, map[string]float64{brokerage.String(): 70, exchange.String(): 30}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.groupBy), func(t *testing.T) {
+			allocation := NewAllocation(p, tt.groupBy)
+
+			if allocation.Total != 1000 || allocation.Unpriced != 1 {
+				t.Errorf("total %v with %d unpriced, want 1000 with 1 and no liabilities", allocation.Total, allocation.Unpriced)
+			}
+			if len(allocation.Groups) != len(tt.want) {
+				t.Fatalf("NewAllocation returned %d groups, want %d", len(allocation.Groups), len(tt.want))
+			}
+			for i, g := range allocation.Groups {
+				if math.Abs(g.Percentage-tt.want[g.Key]) > 1e-9 {
+					t.Errorf("group %s = %v%%, want %v%%", g.Key, g.Percentage, tt.want[g.Key])
+				}
+				if i > 0 && g.Value > allocation.Groups[i-1].Value {
+					t.Errorf("group %s is not ordered by value", g.Key)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package portfolio
+
+import (
+	"time"
+)
+
+type TakeSnapshotCommand struct {
+	UserID string    `json:"userId" validate:"required"`
+	Date   time.Time `json:"date"`
+}
+
+type TakeSnapshotsCommand struct {
+	Date time.Time `json:"date"`
+}
+
+type TargetItem struct {
+	// Key is an asset type or a definition ID, depending on GroupBy
+	Key    string  `json:"key" validate:"required"`
+	Weight float64 `json:"weight" validate:"required"`
+}
+
+// SetTargetsCommand replaces all targets of the user.
+type SetTargetsCommand struct {
+	UserID  string       `json:"userId" validate:"required"`
+	GroupBy GroupBy      `json:"groupBy" validate:"required"`
+	Targets []TargetItem `json:"targets"`
+}
@@ -0,0 +1,288 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/calendar"
+	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+// weightTolerance absorbs rounding when target weights are checked to add up to 100
+const weightTolerance = 0.01
+
+type Handler struct {
+	repo             Repository
+	valuationService *valuation.Handler
+	definitionRepo   definition.Repository
+}
+
+func NewHandler(repo Repository, valuationService *valuation.Handler, definitionRepo definition.Repository) *Handler {
+	return &Handler{
+		repo:             repo,
+		valuationService: valuationService,
+		definitionRepo:   definitionRepo,
+	}
+}
+
+func (h *Handler) HandleTakeSnapshotCommand(ctx context.Context, command TakeSnapshotCommand) (*Snapshot, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if command.Date.IsZero() {
+		command.Date = time.Now()
+	}
+
+	return h.snapshot(ctx, userID, command.Date)
+}
+
+// HandleTakeSnapshotsCommand snapshots every user. A failing user does not stop
+// the others; the errors are returned together.
+func (h *Handler) HandleTakeSnapshotsCommand(ctx context.Context, command TakeSnapshotsCommand) (int, error) {
+	if command.Date.IsZero() {
+		command.Date = time.Now()
+	}
+
+	userIDs, err := h.repo.GetUserIDs(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var taken int
+	var errs []error
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return taken, ctx.Err()
+		}
+		if _, err := h.snapshot(ctx, userID, command.Date); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		taken++
+	}
+	return taken, errors.Join(errs...)
+}
+
+func (h *Handler) HandleGetHistoryQuery(ctx context.Context, query GetHistoryQuery) ([]*Snapshot, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.Interval == "" {
+		query.Interval = Daily
+	}
+	if !isValidInterval(query.Interval) {
+		return nil, errors.New("invalid interval")
+	}
+	if query.To.IsZero() {
+		query.To = time.Now()
+	}
+	if query.From.IsZero() {
+		query.From = query.To.AddDate(-1, 0, 0)
+	}
+	if query.To.Before(query.From) {
+		return nil, errors.New("end date must be after start date")
+	}
+
+	snapshots, err := h.repo.GetSnapshots(ctx, userID, calendar.TruncateDay(query.From), calendar.TruncateDay(query.To))
+	if err != nil {
+		return nil, err
+	}
+	return Sample(snapshots, query.Interval), nil
+}
+
+func (h *Handler) HandleGetNetWorthQuery(ctx context.Context, query GetNetWorthQuery) (*NetWorth, error) {
+	p, err := h.valuationService.HandleValuePortfolioQuery(ctx, valuation.ValuePortfolioQuery{
+		UserID:       query.UserID,
+		BaseCurrency: query.BaseCurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewNetWorth(p), nil
+}
+
+func (h *Handler) HandleGetAllocationQuery(ctx context.Context, query GetAllocationQuery) (*Allocation, error) {
+	if query.GroupBy == "" {
+		query.GroupBy = ByAssetType
+	}
+	if !isValidGroupBy(query.GroupBy) {
+		return nil, errors.New("invalid group by")
+	}
+
+	p, err := h.valuationService.HandleValuePortfolioQuery(ctx, valuation.ValuePortfolioQuery{
+		UserID:       query.UserID,
+		BaseCurrency: query.BaseCurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return NewAllocation(p, query.GroupBy), nil
+}
+
+func (h *Handler) HandleSetTargetsCommand(ctx context.Context, command SetTargetsCommand) ([]*Target, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if command.GroupBy != ByAssetType && command.GroupBy != ByDefinition {
+		return nil, errors.New("targets can only be grouped by assetType or definition")
+	}
+
+	seen := make(map[string]bool)
+	var total float64
+	targets := make([]*Target, 0, len(command.Targets))
+	for _, item := range command.Targets {
+		if item.Weight <= 0 || item.Weight > 100 {
+			return nil, errors.New("target weight must be between 0 and 100")
+		}
+
+		switch command.GroupBy {
+		case ByAssetType:
+			item.Key = strings.ToUpper(item.Key)
+			if !asset.AssetType(item.Key).IsValid() {
+				return nil, errors.New("invalid asset type")
+			}
+		case ByDefinition:
+			definitionID, err := uuid.Parse(item.Key)
+			if err != nil {
+				return nil, errors.New("invalid definition ID")
+			}
+			if _, err := h.definitionRepo.GetByID(ctx, definitionID); err != nil {
+				return nil, errors.New("definition not found")
+			}
+			item.Key = definitionID.String()
+		}
+
+		if seen[item.Key] {
+			return nil, errors.New("duplicate target")
+		}
+		seen[item.Key] = true
+		total += item.Weight
+		targets = append(targets, NewTarget(userID, command.GroupBy, item))
+	}
+
+	if len(targets) > 0 && math.Abs(total-100) > weightTolerance {
+		return nil, errors.New("target weights must add up to 100")
+	}
+
+	if err := h.repo.ReplaceTargets(ctx, userID, targets); err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
+
+func (h *Handler) HandleGetTargetsQuery(ctx context.Context, query GetTargetsQuery) ([]*Target, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetTargets(ctx, userID)
+}
+
+func (h *Handler) HandleGetRebalanceQuery(ctx context.Context, query GetRebalanceQuery) (*RebalancePlan, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.Tolerance < 0 {
+		return nil, errors.New("tolerance must not be negative")
+	}
+	if query.Cash < 0 {
+		return nil, errors.New("cash must not be negative")
+	}
+
+	targets, err := h.repo.GetTargets(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(targets) == 0 {
+		return nil, errors.New("no allocation targets set")
+	}
+
+	p, err := h.valuationService.HandleValuePortfolioQuery(ctx, valuation.ValuePortfolioQuery{
+		UserID:       query.UserID,
+		BaseCurrency: query.BaseCurrency,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	unitValues, err := h.targetUnitValues(ctx, p, targets)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRebalancePlan(p, targets, query, unitValues), nil
+}
+
+// targetUnitValues prices target definitions the user does not hold yet so that
+// buys of them can be expressed in quantity.
+func (h *Handler) targetUnitValues(ctx context.Context, p *valuation.Portfolio, targets []*Target) (map[uuid.UUID]UnitValue, error) {
+	held := make(map[uuid.UUID]bool)
+	for _, holding := range p.Holdings {
+		held[holding.DefinitionID] = true
+	}
+
+	var missing []*valuation.Holding
+	for _, t := range targets {
+		if t.GroupBy != ByDefinition {
+			continue
+		}
+		definitionID, err := uuid.Parse(t.Key)
+		if err != nil || held[definitionID] {
+			continue
+		}
+		d, err := h.definitionRepo.GetByID(ctx, definitionID)
+		if err != nil {
+			continue
+		}
+		missing = append(missing, &valuation.Holding{
+			DefinitionID: d.ID,
+			Symbol:       d.Abbreviation,
+			Name:         d.Name,
+			Quantity:     1,
+		})
+	}
+
+	unitValues := make(map[uuid.UUID]UnitValue)
+	if len(missing) == 0 {
+		return unitValues, nil
+	}
+
+	priced, err := h.valuationService.Value(ctx, missing, p.BaseCurrency, p.At)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range priced.Holdings {
+		unitValues[v.DefinitionID] = UnitValue{
+			Symbol: v.Symbol,
+			Value:  v.UnitValue,
+		}
+	}
+	return unitValues, nil
+}
+
+func (h *Handler) snapshot(ctx context.Context, userID uuid.UUID, date time.Time) (*Snapshot, error) {
+	p, err := h.valuationService.HandleValuePortfolioQuery(ctx, valuation.ValuePortfolioQuery{
+		UserID: userID.String(),
+		At:     date,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot := NewSnapshot(userID, date, p)
+	if err := h.repo.SaveSnapshot(ctx, snapshot); err != nil {
+		return nil, err
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,60 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+type AccountNetWorth struct {
+	AccountID   uuid.UUID `json:"accountId"`
+	Name        string    `json:"name"`
+	AccountType string    `json:"accountType"`
+	Assets      float64   `json:"assets"`
+	Liabilities float64   `json:"liabilities"`
+	NetWorth    float64   `json:"netWorth"`
+}
+
+type NetWorth struct {
+	BaseCurrency     string             `json:"baseCurrency"`
+	At               time.Time          `json:"at"`
+	GrossAssets      float64            `json:"grossAssets"`
+	GrossLiabilities float64            `json:"grossLiabilities"`
+	NetWorth         float64            `json:"netWorth"`
+	Unpriced         int                `json:"unpriced"`
+	Accounts         []*AccountNetWorth `json:"accounts"`
+}
+
+func NewNetWorth(p *valuation.Portfolio) *NetWorth {
+	netWorth := &NetWorth{
+		BaseCurrency:     p.BaseCurrency,
+		At:               p.At,
+		GrossAssets:      p.GrossAssets,
+		GrossLiabilities: p.GrossLiabilities,
+		NetWorth:         p.Total,
+		Unpriced:         p.Unpriced,
+	}
+
+	byAccount := make(map[uuid.UUID]*AccountNetWorth)
+	for _, h := range p.Holdings {
+		a, ok := byAccount[h.AccountID]
+		if !ok {
+			a = &AccountNetWorth{
+				AccountID:   h.AccountID,
+				Name:        h.AccountName,
+				AccountType: h.AccountType,
+			}
+			byAccount[h.AccountID] = a
+			netWorth.Accounts = append(netWorth.Accounts, a)
+		}
+		if h.Liability {
+			a.Liabilities += h.Value
+		} else {
+			a.Assets += h.Value
+		}
+		a.NetWorth += h.NetValue()
+	}
+	return netWorth
+}
@@ -0,0 +1,61 @@
+package portfolio
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+func TestNewNetWorth(t *testing.T) {
+	bank, card := uuid.New(), uuid.New()
+	valued := func(accountID uuid.UUID, value float64, liability bool) *valuation.Valuation {
+		return &valuation.Valuation{
+			Holding: valuation.Holding{AccountID: accountID, Liability: liability},
+			Value:   value,
+			Priced:  true,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		holdings []*valuation.Valuation
+		want     map[uuid.UUID]AccountNetWorth
+	}{
+		{
+			name:     "assets only",
+			holdings: []*valuation.Valuation{valued(bank, 1000, false), valued(bank, 500, false)},
+			want:     map[uuid.UUID]AccountNetWorth{bank: {Assets: 1500, NetWorth: 1500}},
+		},
+		{
+			name:     "a liability is subtracted",
+			holdings: []*valuation.Valuation{valued(bank, 1000, false), valued(card, 300, true)},
+			want: map[uuid.UUID]AccountNetWorth{
+				bank: {Assets: 1000, NetWorth: 1000},
+				card: {Liabilities: 300, NetWorth: -300},
+			},
+		},
+		{
+			name:     "assets and liabilities in one account",
+			holdings: []*valuation.Valuation{valued(bank, 1000, false), valued(bank, 1200, true)},
+			want:     map[uuid.UUID]AccountNetWorth{bank: {Assets: 1000, Liabilities: 1200, NetWorth: -200}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			netWorth := NewNetWorth(&valuation.Portfolio{Holdings: tt.holdings})
+
+			if len(netWorth.Accounts) != len(tt.want) {
+				t.Fatalf("NewNetWorth returned %d accounts, want %d", len(netWorth.Accounts), len(tt.want))
+			}
+			for _, a := range netWorth.Accounts {
+				want := tt.want[a.AccountID]
+				if a.Assets != want.Assets || a.Liabilities != want.Liabilities || a.NetWorth != want.NetWorth {
+					t.Errorf("account = %v assets, %v liabilities, %v net, want %v, %v, %v",
+						a.Assets, a.Liabilities, a.NetWorth, want.Assets, want.Liabilities, want.NetWorth)
+				}
+			}
+		})
+	}
+}
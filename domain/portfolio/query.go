@@ -0,0 +1,38 @@
+package portfolio
+
+import (
+	"time"
+)
+
+type GetHistoryQuery struct {
+	UserID   string    `json:"userId" validate:"required"`
+	From     time.Time `json:"from"`
+	To       time.Time `json:"to"`
+	Interval Interval  `json:"interval"`
+}
+
+type GetNetWorthQuery struct {
+	UserID       string `json:"userId" validate:"required"`
+	BaseCurrency string `json:"baseCurrency"`
+}
+
+type GetAllocationQuery struct {
+	UserID       string  `json:"userId" validate:"required"`
+	BaseCurrency string  `json:"baseCurrency"`
+	GroupBy      GroupBy `json:"groupBy"`
+}
+
+type GetTargetsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetRebalanceQuery struct {
+	UserID       string `json:"userId" validate:"required"`
+	BaseCurrency string `json:"baseCurrency"`
+	// Tolerance is the drift, in percentage points, a group may have before it is traded
+	Tolerance float64 `json:"tolerance"`
+	// NoSell only suggests buys; the shortfall is reported as cash required
+	NoSell bool `json:"noSell"`
+	// Cash is new money to invest alongside the rebalance
+	Cash float64 `json:"cash"`
+}
@@ -0,0 +1,252 @@
+package portfolio
+
+import (
+	"math"
+	"sort"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+type TradeAction string
+
+const (
+	Buy  TradeAction = "BUY"
+	Sell TradeAction = "SELL"
+	Hold TradeAction = "HOLD"
+)
+
+// TradeLeg is the part of a suggestion that falls on one definition.
+type TradeLeg struct {
+	DefinitionID uuid.UUID `json:"definitionId"`
+	Symbol       string    `json:"symbol"`
+	Quantity     float64   `json:"quantity"`
+	Amount       float64   `json:"amount"`
+}
+
+type RebalanceSuggestion struct {
+	Key           string      `json:"key"`
+	Label         string      `json:"label"`
+	CurrentValue  float64     `json:"currentValue"`
+	CurrentWeight float64     `json:"currentWeight"`
+	TargetWeight  float64     `json:"targetWeight"`
+	Drift         float64     `json:"drift"`
+	OutOfBand     bool        `json:"outOfBand"`
+	Action        TradeAction `json:"action"`
+	Amount        float64     `json:"amount"`
+	Legs          []*TradeLeg `json:"legs"`
+}
+
+type RebalancePlan struct {
+	BaseCurrency   string  `json:"baseCurrency"`
+	GroupBy        GroupBy `json:"groupBy"`
+	Tolerance      float64 `json:"tolerance"`
+	NoSell         bool    `json:"noSell"`
+	Total          float64 `json:"total"`
+	Cash           float64 `json:"cash"`
+	NeedsRebalance bool    `json:"needsRebalance"`
+	TotalBuys      float64 `json:"totalBuys"`
+	TotalSells     float64 `json:"totalSells"`
+	// NetCashFlow is cash plus sells minus buys; negative means more money is needed
+	NetCashFlow float64                `json:"netCashFlow"`
+	Unpriced    int                    `json:"unpriced"`
+	Suggestions []*RebalanceSuggestion `json:"suggestions"`
+}
+
+// UnitValue is the base currency value of one unit of a definition.
+type UnitValue struct {
+	Symbol string
+	Value  float64
+}
+
+// NewRebalancePlan compares the current allocation with the targets. Groups
+// drifting further than the tolerance are traded back to target; with new cash,
+// underweight groups are topped up even inside the band. In no-sell mode only
+// buys are suggested: with cash they are scaled to fit it, without cash they are
+// sized so that every group reaches its target without selling the overweight ones.
+// unitValues prices target definitions that are not held yet.
+func NewRebalancePlan(p *valuation.Portfolio, targets []*Target, query GetRebalanceQuery, unitValues map[uuid.UUID]UnitValue) *RebalancePlan {
+	groupBy := ByAssetType
+	if len(targets) > 0 {
+		groupBy = targets[0].GroupBy
+	}
+	allocation := NewAllocation(p, groupBy)
+
+	plan := &RebalancePlan{
+		BaseCurrency: p.BaseCurrency,
+		GroupBy:      groupBy,
+		Tolerance:    query.Tolerance,
+		NoSell:       query.NoSell,
+		Total:        allocation.Total,
+		Cash:         query.Cash,
+		Unpriced:     allocation.Unpriced,
+	}
+
+	weights := make(map[string]float64)
+	for _, t := range targets {
+		weights[t.Key] = t.Weight
+	}
+
+	byKey := make(map[string]*RebalanceSuggestion)
+	for _, g := range allocation.Groups {
+		s := &RebalanceSuggestion{
+			Key:           g.Key,
+			Label:         g.Label,
+			CurrentValue:  g.Value,
+			CurrentWeight: g.Percentage,
+			TargetWeight:  weights[g.Key],
+		}
+		byKey[g.Key] = s
+		plan.Suggestions = append(plan.Suggestions, s)
+	}
+	for _, t := range targets {
+		if _, ok := byKey[t.Key]; ok {
+			continue
+		}
+		label := t.Key
+		if id, err := uuid.Parse(t.Key); err == nil {
+			if unit, ok := unitValues[id]; ok {
+				label = unit.Symbol
+			}
+		}
+		s := &RebalanceSuggestion{
+			Key:          t.Key,
+			Label:        label,
+			TargetWeight: t.Weight,
+		}
+		byKey[t.Key] = s
+		plan.Suggestions = append(plan.Suggestions, s)
+	}
+
+	for _, s := range plan.Suggestions {
+		s.Drift = s.CurrentWeight - s.TargetWeight
+		s.OutOfBand = math.Abs(s.Drift) > plan.Tolerance
+		if s.OutOfBand {
+			plan.NeedsRebalance = true
+		}
+	}
+
+	newTotal := plan.Total + plan.Cash
+	if plan.NoSell && plan.Cash == 0 && plan.NeedsRebalance {
+		// Without selling, the portfolio has to grow until no targeted group is overweight
+		for _, s := range plan.Suggestions {
+			if s.TargetWeight > 0 {
+				newTotal = math.Max(newTotal, s.CurrentValue/(s.TargetWeight/100))
+			}
+		}
+	}
+
+	for _, s := range plan.Suggestions {
+		diff := s.TargetWeight/100*newTotal - s.CurrentValue
+		switch {
+		case diff > 0 && (s.OutOfBand || plan.Cash > 0 || (plan.NoSell && plan.NeedsRebalance)):
+			s.Action = Buy
+			s.Amount = diff
+		case diff < 0 && s.OutOfBand && !plan.NoSell:
+			s.Action = Sell
+			s.Amount = -diff
+		default:
+			s.Action = Hold
+		}
+	}
+
+	if plan.NoSell && plan.Cash > 0 {
+		var buys float64
+		for _, s := range plan.Suggestions {
+			if s.Action == Buy {
+				buys += s.Amount
+			}
+		}
+		if buys > plan.Cash {
+			scale := plan.Cash / buys
+			for _, s := range plan.Suggestions {
+				if s.Action == Buy {
+					s.Amount *= scale
+				}
+			}
+		}
+	}
+
+	for _, s := range plan.Suggestions {
+		switch s.Action {
+		case Buy:
+			plan.TotalBuys += s.Amount
+		case Sell:
+			plan.TotalSells += s.Amount
+		}
+		if s.Action != Hold {
+			s.Legs = legs(p.Holdings, groupBy, s, unitValues)
+		}
+	}
+	plan.NetCashFlow = plan.Cash + plan.TotalSells - plan.TotalBuys
+
+	sort.SliceStable(plan.Suggestions, func(i, j int) bool {
+		return math.Abs(plan.Suggestions[i].Drift) > math.Abs(plan.Suggestions[j].Drift)
+	})
+	return plan
+}
+
+// legs spreads a group trade over the definitions held in the group in
+// proportion to their value, or onto the target definition itself.
+func legs(holdings []*valuation.Valuation, groupBy GroupBy, s *RebalanceSuggestion, unitValues map[uuid.UUID]UnitValue) []*TradeLeg {
+	type position struct {
+		leg       *TradeLeg
+		value     float64
+		quantity  float64
+		unitValue float64
+	}
+
+	var positions []*position
+	byDefinition := make(map[uuid.UUID]*position)
+	for _, h := range holdings {
+		if h.Liability || !h.Priced || h.UnitValue <= 0 {
+			continue
+		}
+		if key, _ := groupKey(h, groupBy); key != s.Key {
+			continue
+		}
+		pos, ok := byDefinition[h.DefinitionID]
+		if !ok {
+			pos = &position{
+				leg:       &TradeLeg{DefinitionID: h.DefinitionID, Symbol: h.Symbol},
+				unitValue: h.UnitValue,
+			}
+			byDefinition[h.DefinitionID] = pos
+			positions = append(positions, pos)
+		}
+		pos.value += h.Value
+		pos.quantity += h.Quantity
+	}
+
+	if len(positions) == 0 && groupBy == ByDefinition {
+		if id, err := uuid.Parse(s.Key); err == nil {
+			if unit, ok := unitValues[id]; ok && unit.Value > 0 {
+				positions = append(positions, &position{
+					leg:       &TradeLeg{DefinitionID: id, Symbol: unit.Symbol},
+					unitValue: unit.Value,
+				})
+			}
+		}
+	}
+
+	var groupValue float64
+	for _, pos := range positions {
+		groupValue += pos.value
+	}
+
+	var result []*TradeLeg
+	for _, pos := range positions {
+		share := 1 / float64(len(positions))
+		if groupValue > 0 {
+			share = pos.value / groupValue
+		}
+		pos.leg.Amount = s.Amount * share
+		pos.leg.Quantity = pos.leg.Amount / pos.unitValue
+		if s.Action == Sell && pos.leg.Quantity > pos.quantity {
+			pos.leg.Quantity = pos.quantity
+		}
+		result = append(result, pos.leg)
+	}
+	return result
+}
@@ -0,0 +1,145 @@
+package portfolio
+
+import (
+	"math"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+func holding(assetType string, quantity, unitValue float64) *valuation.Valuation {
+	return &valuation.Valuation{
+		Holding: valuation.Holding{
+			AssetID:      uuid.New(),
+			DefinitionID: uuid.New(),
+			AssetType:    assetType,
+			Symbol:       assetType,
+			Quantity:     quantity,
+		},
+		UnitValue: unitValue,
+		Value:     quantity * unitValue,
+		Priced:    true,
+	}
+}
+
+func TestNewRebalancePlan(t *testing.T) {
+	type want struct {
+		action TradeAction
+		amount float64
+	}
+
+	tests := []struct {
+		name           string
+		query          GetRebalanceQuery
+		needsRebalance bool
+		stock, bond    want
+		netCashFlow    float64
+	}{
+		{
+			name:           "drift beyond the band is traded back",
+			query:          GetRebalanceQuery{Tolerance: 5},
+			needsRebalance: true,
+			stock:          want{Sell, 10},
+			bond:           want{Buy, 10},
+		},
+		{
+			name:  "drift inside the band is held",
+			query: GetRebalanceQuery{Tolerance: 15},
+			stock: want{Hold, 0},
+			bond:  want{Hold, 0},
+		},
+		{
+			name:        "new cash tops up an underweight group inside the band",
+			query:       GetRebalanceQuery{Tolerance: 15, Cash: 20},
+			stock:       want{Hold, 0},
+			bond:        want{Buy, 20},
+			netCashFlow: 0,
+		},
+		{
+			name:           "no sell without cash grows the portfolio to the targets",
+			query:          GetRebalanceQuery{Tolerance: 5, NoSell: true},
+			needsRebalance: true,
+			stock:          want{Hold, 0},
+			bond:           want{Buy, 20},
+			netCashFlow:    -20,
+		},
+		{
+			name:           "no sell with cash scales the buys to fit it",
+			query:          GetRebalanceQuery{Tolerance: 5, NoSell: true, Cash: 10},
+			needsRebalance: true,
+			stock:          want{Hold, 0},
+			bond:           want{Buy, 10},
+			netCashFlow:    0,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &valuation.Portfolio{
+				BaseCurrency: "USD",
+				Total:        100,
+				Holdings:     []*valuation.Valuation{holding("STOCK", 6, 10), holding("BOND", 4, 10)},
+			}
+			targets := []*Target{
+				{GroupBy: ByAssetType, Key: "STOCK", Weight: 50},
+				{GroupBy: ByAssetType, Key: "BOND", Weight: 50},
+			}
+
+			plan := NewRebalancePlan(p, targets, tt.query, nil)
+
+			if plan.NeedsRebalance != tt.needsRebalance {
+				t.Errorf("NeedsRebalance = %v, want %v", plan.NeedsRebalance, tt.needsRebalance)
+			}
+			if math.Abs(plan.NetCashFlow-tt.netCashFlow) > 1e-9 {
+				t.Errorf("NetCashFlow = %v, want %v", plan.NetCashFlow, tt.netCashFlow)
+			}
+			for _, s := range plan.Suggestions {
+				w := tt.stock
+				if s.Key == "BOND" {
+					w = tt.bond
+				}
+				if s.Action != w.action || math.Abs(s.Amount-w.amount) > 1e-9 {
+					t.Errorf("%s = %s %v, want %s %v", s.Key, s.Action, s.Amount, w.action, w.amount)
+				}
+				var legs float64
+				for _, leg := range s.Legs {
+					legs += leg.Amount
+					if math.Abs(leg.Quantity*10-leg.Amount) > 1e-9 {
+						t.Errorf("%s leg quantity %v does not match amount %v", s.Key, leg.Quantity, leg.Amount)
+					}
+				}
+				if math.Abs(legs-s.Amount) > 1e-9 {
+					t.Errorf("%s legs add up to %v, want %v", s.Key, legs, s.Amount)
+				}
+			}
+		})
+	}
+}
+
+func TestNewRebalancePlanUnheldTarget(t *testing.T) {
+	held := holding("STOCK", 10, 10)
+	unheld := uuid.New()
+	p := &valuation.Portfolio{BaseCurrency: "USD", Total: 100, Holdings: []*valuation.Valuation{held}}
+	targets := []*Target{
+		{GroupBy: ByDefinition, Key: held.DefinitionID.String(), Weight: 50},
+		{GroupBy: ByDefinition, Key: unheld.String(), Weight: 50},
+	}
+	unitValues := map[uuid.UUID]UnitValue{unheld: {Symbol: "GOLD", Value: 25}}
+
+	plan := NewRebalancePlan(p, targets, GetRebalanceQuery{Tolerance: 5}, unitValues)
+
+	for _, s := range plan.Suggestions {
+		if s.Key != unheld.String() {
+			continue
+		}
+		if s.Label != "GOLD" || s.Action != Buy || s.Amount != 50 {
+			t.Fatalf("unheld target = %s %s %v, want GOLD BUY 50", s.Label, s.Action, s.Amount)
+		}
+		if len(s.Legs) != 1 || s.Legs[0].DefinitionID != unheld || s.Legs[0].Quantity != 2 {
+			t.Fatalf("unheld target legs = %+v, want 2 units of the target definition", s.Legs)
+		}
+		return
+	}
+	t.Fatal("no suggestion for the unheld target")
+}
@@ -0,0 +1,18 @@
+package portfolio
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// SaveSnapshot stores the snapshot, replacing any earlier one of the same user and day.
+	SaveSnapshot(ctx context.Context, snapshot *Snapshot) error
+	GetSnapshots(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*Snapshot, error)
+	GetUserIDs(ctx context.Context) ([]uuid.UUID, error)
+	// ReplaceTargets swaps every target of the user for the given ones.
+	ReplaceTargets(ctx context.Context, userID uuid.UUID, targets []*Target) error
+	GetTargets(ctx context.Context, userID uuid.UUID) ([]*Target, error)
+}
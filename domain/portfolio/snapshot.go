@@ -0,0 +1,112 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+	"siyahsensei/wallet-service/domain/valuation"
+)
+
+type Interval string
+
+const (
+	Daily   Interval = "day"
+	Weekly  Interval = "week"
+	Monthly Interval = "month"
+)
+
+type AccountTotal struct {
+	AccountID   uuid.UUID `json:"accountId"`
+	Name        string    `json:"name"`
+	AccountType string    `json:"accountType"`
+	Value       float64   `json:"value"`
+}
+
+type Snapshot struct {
+	ID           uuid.UUID          `json:"id"`
+	UserID       uuid.UUID          `json:"userId"`
+	SnapshotDate time.Time          `json:"snapshotDate"`
+	BaseCurrency string             `json:"baseCurrency"`
+	TotalValue   float64            `json:"totalValue"`
+	Unpriced     int                `json:"unpriced"`
+	ByAccount    []AccountTotal     `json:"byAccount"`
+	ByAssetType  map[string]float64 `json:"byAssetType"`
+	CreatedAt    time.Time          `json:"createdAt"`
+}
+
+func NewSnapshot(userID uuid.UUID, date time.Time, p *valuation.Portfolio) *Snapshot {
+	snapshot := &Snapshot{
+		ID:           uuid.New(),
+		UserID:       userID,
+		SnapshotDate: calendar.TruncateDay(date),
+		BaseCurrency: p.BaseCurrency,
+		TotalValue:   p.Total,
+		Unpriced:     p.Unpriced,
+		ByAssetType:  make(map[string]float64),
+		CreatedAt:    time.Now(),
+	}
+
+	accountIndex := make(map[uuid.UUID]int)
+	for _, h := range p.Holdings {
+		snapshot.ByAssetType[h.AssetType] += h.NetValue()
+
+		i, ok := accountIndex[h.AccountID]
+		if !ok {
+			i = len(snapshot.ByAccount)
+			accountIndex[h.AccountID] = i
+			snapshot.ByAccount = append(snapshot.ByAccount, AccountTotal{
+				AccountID:   h.AccountID,
+				Name:        h.AccountName,
+				AccountType: h.AccountType,
+			})
+		}
+		snapshot.ByAccount[i].Value += h.NetValue()
+	}
+	return snapshot
+}
+
+// Sample keeps the last snapshot of every interval bucket. Snapshots must be
+// ordered by date.
+func Sample(snapshots []*Snapshot, interval Interval) []*Snapshot {
+	if interval == Daily || interval == "" {
+		return snapshots
+	}
+
+	var sampled []*Snapshot
+	var lastBucket time.Time
+	for _, s := range snapshots {
+		bucket := bucketStart(s.SnapshotDate, interval)
+		if len(sampled) > 0 && bucket.Equal(lastBucket) {
+			sampled[len(sampled)-1] = s
+			continue
+		}
+		sampled = append(sampled, s)
+		lastBucket = bucket
+	}
+	return sampled
+}
+
+func bucketStart(date time.Time, interval Interval) time.Time {
+	day := calendar.TruncateDay(date)
+	switch interval {
+	case Weekly:
+		// Weeks start on Monday
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case Monthly:
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return day
+	}
+}
+
+func isValidInterval(interval Interval) bool {
+	switch interval {
+	case Daily, Weekly, Monthly:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,61 @@
+package portfolio
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSample(t *testing.T) {
+	var snapshots []*Snapshot
+	// Every day from Wednesday January 24 to Saturday March 2, 2024
+	for day := time.Date(2024, 1, 24, 0, 0, 0, 0, time.UTC); !day.After(time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)); day = day.AddDate(0, 0, 1) {
+		snapshots = append(snapshots, &Snapshot{SnapshotDate: day})
+	}
+
+	tests := []struct {
+		interval Interval
+		want     []string
+	}{
+		{Monthly, []string{"2024-01-31", "2024-02-29", "2024-03-02"}},
+		{Weekly, []string{"2024-01-28", "2024-02-04", "2024-02-11", "2024-02-18", "2024-02-25", "2024-03-02"}},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.interval), func(t *testing.T) {
+			sampled := Sample(snapshots, tt.interval)
+			if len(sampled) != len(tt.want) {
+				t.Fatalf("Sample kept %d snapshots, want %d", len(sampled), len(tt.want))
+			}
+			for i, s := range sampled {
+				if got := s.SnapshotDate.Format(time.DateOnly); got != tt.want[i] {
+					t.Errorf("snapshot %d = %s, want %s", i, got, tt.want[i])
+				}
+			}
+		})
+	}
+
+	if sampled := Sample(snapshots, Daily); len(sampled) != len(snapshots) {
+		t.Errorf("daily sampling kept %d snapshots, want all %d", len(sampled), len(snapshots))
+	}
+}
+
+func TestBucketStart(t *testing.T) {
+	tests := []struct {
+		name     string
+		date     time.Time
+		interval Interval
+		want     string
+	}{
+		{"monday starts its own week", time.Date(2024, 2, 5, 10, 0, 0, 0, time.UTC), Weekly, "2024-02-05"},
+		{"sunday ends the week", time.Date(2024, 2, 11, 23, 0, 0, 0, time.UTC), Weekly, "2024-02-05"},
+		{"week across a month", time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), Weekly, "2024-02-26"},
+		{"month", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), Monthly, "2024-02-01"},
+		{"day", time.Date(2024, 2, 29, 15, 0, 0, 0, time.UTC), Daily, "2024-02-29"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := bucketStart(tt.date, tt.interval).Format(time.DateOnly); got != tt.want {
+				t.Errorf("bucketStart = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
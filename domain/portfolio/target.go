@@ -0,0 +1,31 @@
+package portfolio
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Target is the share of gross assets, in percent, a user wants one group to hold.
+type Target struct {
+	ID        uuid.UUID `json:"id" db:"id"`
+	UserID    uuid.UUID `json:"userId" db:"user_id"`
+	GroupBy   GroupBy   `json:"groupBy" db:"group_by"`
+	Key       string    `json:"key" db:"key"`
+	Weight    float64   `json:"weight" db:"weight"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+func NewTarget(userID uuid.UUID, groupBy GroupBy, item TargetItem) *Target {
+	now := time.Now()
+	return &Target{
+		ID:        uuid.New(),
+		UserID:    userID,
+		GroupBy:   groupBy,
+		Key:       item.Key,
+		Weight:    item.Weight,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+}
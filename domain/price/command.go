@@ -0,0 +1,13 @@
+package price
+
+type PriceItem struct {
+	DefinitionID  string  `json:"definitionId" validate:"required"`
+	QuoteCurrency string  `json:"quoteCurrency" validate:"required"`
+	PricedAt      int64   `json:"pricedAt" validate:"required"`
+	Price         float64 `json:"price" validate:"required"`
+	Source        string  `json:"source"`
+}
+
+type RecordPricesCommand struct {
+	Prices []PriceItem `json:"prices" validate:"required"`
+}
@@ -0,0 +1,141 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/definition"
+)
+
+type Handler struct {
+	repo           Repository
+	definitionRepo definition.Repository
+	listeners      []Listener
+}
+
+func NewHandler(repo Repository, definitionRepo definition.Repository) *Handler {
+	return &Handler{
+		repo:           repo,
+		definitionRepo: definitionRepo,
+	}
+}
+
+// OnRecorded registers a listener for the prices recorded through the handler.
+func (h *Handler) OnRecorded(listener Listener) {
+	h.listeners = append(h.listeners, listener)
+}
+
+func (h *Handler) HandleRecordPricesCommand(ctx context.Context, command RecordPricesCommand) ([]*Price, error) {
+	if len(command.Prices) == 0 {
+		return nil, errors.New("at least one price is required")
+	}
+
+	known := make(map[uuid.UUID]bool)
+	// A price repeated within the batch overwrites the earlier one, as it would in storage
+	type key struct {
+		definitionID  uuid.UUID
+		quoteCurrency string
+		pricedAt      int64
+		source        string
+	}
+	positions := make(map[key]int)
+	prices := make([]*Price, 0, len(command.Prices))
+	for _, item := range command.Prices {
+		definitionID, err := uuid.Parse(item.DefinitionID)
+		if err != nil {
+			return nil, errors.New("invalid definition ID")
+		}
+		if strings.TrimSpace(item.QuoteCurrency) == "" {
+			return nil, errors.New("quote currency is required")
+		}
+		if item.Price <= 0 {
+			return nil, errors.New("price must be positive")
+		}
+		if item.PricedAt <= 0 {
+			return nil, errors.New("priced at is required")
+		}
+		if !known[definitionID] {
+			if _, err := h.definitionRepo.GetByID(ctx, definitionID); err != nil {
+				return nil, errors.New("definition not found")
+			}
+			known[definitionID] = true
+		}
+
+		p := NewPrice(item)
+		k := key{definitionID, p.QuoteCurrency, item.PricedAt, p.Source}
+		if i, ok := positions[k]; ok {
+			prices[i] = p
+			continue
+		}
+		positions[k] = len(prices)
+		prices = append(prices, p)
+	}
+
+	if err := h.repo.BulkUpsert(ctx, prices); err != nil {
+		return nil, err
+	}
+	for _, listener := range h.listeners {
+		listener(prices)
+	}
+	return prices, nil
+}
+
+func (h *Handler) HandleGetLatestPriceQuery(ctx context.Context, query GetLatestPriceQuery) (*Price, error) {
+	definitionID, err := uuid.Parse(query.DefinitionID)
+	if err != nil {
+		return nil, errors.New("invalid definition ID")
+	}
+	if query.QuoteCurrency == "" {
+		return nil, errors.New("quote currency is required")
+	}
+
+	p, err := h.repo.GetLatest(ctx, definitionID, strings.ToUpper(query.QuoteCurrency))
+	if err != nil {
+		return nil, errors.New("price not found")
+	}
+	return p, nil
+}
+
+func (h *Handler) HandleGetPriceAtQuery(ctx context.Context, query GetPriceAtQuery) (*Price, error) {
+	definitionID, err := uuid.Parse(query.DefinitionID)
+	if err != nil {
+		return nil, errors.New("invalid definition ID")
+	}
+	if query.QuoteCurrency == "" {
+		return nil, errors.New("quote currency is required")
+	}
+	if query.At.IsZero() {
+		query.At = time.Now()
+	}
+
+	p, err := h.repo.GetAt(ctx, definitionID, strings.ToUpper(query.QuoteCurrency), query.At)
+	if err != nil {
+		return nil, errors.New("price not found")
+	}
+	return p, nil
+}
+
+func (h *Handler) HandleGetPriceHistoryQuery(ctx context.Context, query GetPriceHistoryQuery) ([]*Price, error) {
+	if _, err := uuid.Parse(query.DefinitionID); err != nil {
+		return nil, errors.New("invalid definition ID")
+	}
+	if query.QuoteCurrency == "" {
+		return nil, errors.New("quote currency is required")
+	}
+	if query.From != nil && query.To != nil && query.To.Before(*query.From) {
+		return nil, errors.New("end date must be after start date")
+	}
+	if query.Limit <= 0 {
+		query.Limit = 100
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+	query.QuoteCurrency = strings.ToUpper(query.QuoteCurrency)
+
+	return h.repo.GetHistory(ctx, query)
+}
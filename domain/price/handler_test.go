@@ -0,0 +1,96 @@
+package price
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/definition"
+)
+
+type fakeDefinitions struct {
+	definition.Repository
+	known map[uuid.UUID]bool
+}
+
+func (f *fakeDefinitions) GetByID(ctx context.Context, id uuid.UUID) (*definition.Definition, error) {
+	if !f.known[id] {
+		return nil, errors.New("definition not found")
+	}
+	return &definition.Definition{ID: id}, nil
+}
+
+type fakePrices struct {
+	Repository
+	upserted []*Price
+}
+
+func (f *fakePrices) BulkUpsert(ctx context.Context, prices []*Price) error {
+	f.upserted = append(f.upserted, prices...)
+	return nil
+}
+
+func TestHandleRecordPricesCommand(t *testing.T) {
+	btc, eth := uuid.New(), uuid.New()
+	item := func(definitionID uuid.UUID, currency string, pricedAt int64, price float64, source string) PriceItem {
+		return PriceItem{DefinitionID: definitionID.String(), QuoteCurrency: currency, PricedAt: pricedAt, Price: price, Source: source}
+	}
+
+	tests := []struct {
+		name   string
+		items  []PriceItem
+		prices []float64
+		err    string
+	}{
+		{
+			name:   "distinct prices are all recorded",
+			items:  []PriceItem{item(btc, "USD", 100, 60000, "manual"), item(btc, "USD", 100, 60100, "feed"), item(btc, "EUR", 100, 55000, "manual"), item(btc, "USD", 200, 61000, "manual")},
+			prices: []float64{60000, 60100, 55000, 61000},
+		},
+		{
+			name:   "a repeated price overwrites the earlier one",
+			items:  []PriceItem{item(btc, "usd", 100, 60000, "manual"), item(eth, "USD", 100, 3000, "manual"), item(btc, "USD", 100, 60500, "manual")},
+			prices: []float64{60500, 3000},
+		},
+		{name: "empty batch", err: "at least one price is required"},
+		{name: "zero price", items: []PriceItem{item(btc, "USD", 100, 0, "")}, err: "price must be positive"},
+		{name: "negative price", items: []PriceItem{item(btc, "USD", 100, -1, "")}, err: "price must be positive"},
+		{name: "missing currency", items: []PriceItem{item(btc, " ", 100, 60000, "")}, err: "quote currency is required"},
+		{name: "missing time", items: []PriceItem{item(btc, "USD", 0, 60000, "")}, err: "priced at is required"},
+		{name: "invalid definition", items: []PriceItem{{DefinitionID: "BTC", QuoteCurrency: "USD", PricedAt: 100, Price: 1}}, err: "invalid definition ID"},
+		{name: "unknown definition", items: []PriceItem{item(btc, "USD", 100, 60000, ""), item(uuid.New(), "USD", 100, 1, "")}, err: "definition not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakePrices{}
+			h := NewHandler(repo, &fakeDefinitions{known: map[uuid.UUID]bool{btc: true, eth: true}})
+			var heard []*Price
+			h.OnRecorded(func(prices []*Price) { heard = prices })
+
+			prices, err := h.HandleRecordPricesCommand(context.Background(), RecordPricesCommand{Prices: tt.items})
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("HandleRecordPricesCommand error = %v, want %q", err, tt.err)
+				}
+				if len(repo.upserted) != 0 || heard != nil {
+					t.Errorf("a rejected batch stored %d prices", len(repo.upserted))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("HandleRecordPricesCommand: %v", err)
+			}
+
+			if len(prices) != len(tt.prices) || len(repo.upserted) != len(tt.prices) || len(heard) != len(tt.prices) {
+				t.Fatalf("recorded %d, stored %d and announced %d prices, want %d", len(prices), len(repo.upserted), len(heard), len(tt.prices))
+			}
+			for i, p := range repo.upserted {
+				if p.Price != tt.prices[i] {
+					t.Errorf("price %d = %v, want %v", i, p.Price, tt.prices[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package price
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Price struct {
+	ID            uuid.UUID `json:"id" db:"id"`
+	DefinitionID  uuid.UUID `json:"definitionId" db:"definition_id"`
+	QuoteCurrency string    `json:"quoteCurrency" db:"quote_currency"`
+	PricedAt      time.Time `json:"pricedAt" db:"priced_at"`
+	Price         float64   `json:"price" db:"price"`
+	Source        string    `json:"source" db:"source"`
+	CreatedAt     time.Time `json:"createdAt" db:"created_at"`
+}
+
+// Listener is told about prices right after they are recorded.
+type Listener func(prices []*Price)
+
+func NewPrice(item PriceItem) *Price {
+	return &Price{
+		ID:            uuid.New(),
+		DefinitionID:  uuid.MustParse(item.DefinitionID),
+		QuoteCurrency: strings.ToUpper(item.QuoteCurrency),
+		PricedAt:      time.Unix(item.PricedAt, 0),
+		Price:         item.Price,
+		Source:        item.Source,
+		CreatedAt:     time.Now(),
+	}
+}
@@ -0,0 +1,21 @@
+package price
+
+import (
+	"context"
+	"time"
+)
+
+// Quote is a price reported by a provider for a definition abbreviation.
+type Quote struct {
+	Abbreviation  string
+	QuoteCurrency string
+	Price         float64
+	PricedAt      time.Time
+}
+
+// PriceProvider fetches current quotes for a set of definition abbreviations.
+// Abbreviations the provider does not know are left out of the result.
+type PriceProvider interface {
+	Name() string
+	FetchQuotes(ctx context.Context, abbreviations []string) ([]Quote, error)
+}
@@ -0,0 +1,25 @@
+package price
+
+import (
+	"time"
+)
+
+type GetLatestPriceQuery struct {
+	DefinitionID  string `json:"definitionId" validate:"required"`
+	QuoteCurrency string `json:"quoteCurrency" validate:"required"`
+}
+
+type GetPriceAtQuery struct {
+	DefinitionID  string    `json:"definitionId" validate:"required"`
+	QuoteCurrency string    `json:"quoteCurrency" validate:"required"`
+	At            time.Time `json:"at" validate:"required"`
+}
+
+type GetPriceHistoryQuery struct {
+	DefinitionID  string     `json:"definitionId" validate:"required"`
+	QuoteCurrency string     `json:"quoteCurrency" validate:"required"`
+	From          *time.Time `json:"from,omitempty"`
+	To            *time.Time `json:"to,omitempty"`
+	Limit         int        `json:"limit,omitempty"`
+	Offset        int        `json:"offset,omitempty"`
+}
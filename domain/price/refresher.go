@@ -0,0 +1,111 @@
+package price
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/definition"
+)
+
+const refreshBatchSize = 500
+
+// Refresher pulls quotes for every known definition from a provider and
+// records them in the price history.
+type Refresher struct {
+	repo           Repository
+	definitionRepo definition.Repository
+	provider       PriceProvider
+	listeners      []Listener
+}
+
+func NewRefresher(repo Repository, definitionRepo definition.Repository, provider PriceProvider) *Refresher {
+	return &Refresher{
+		repo:           repo,
+		definitionRepo: definitionRepo,
+		provider:       provider,
+	}
+}
+
+// OnRecorded registers a listener for each batch of refreshed prices.
+func (r *Refresher) OnRecorded(listener Listener) {
+	r.listeners = append(r.listeners, listener)
+}
+
+// Refresh fetches and stores one round of quotes and returns the recorded prices.
+func (r *Refresher) Refresh(ctx context.Context) ([]*Price, error) {
+	var recorded []*Price
+	for offset := 0; ; offset += refreshBatchSize {
+		definitions, err := r.definitionRepo.GetAll(ctx, refreshBatchSize, offset)
+		if err != nil {
+			return recorded, err
+		}
+		if len(definitions) == 0 {
+			break
+		}
+
+		prices, err := r.refreshBatch(ctx, definitions)
+		if err != nil {
+			return recorded, err
+		}
+		recorded = append(recorded, prices...)
+
+		if len(definitions) < refreshBatchSize {
+			break
+		}
+	}
+	return recorded, nil
+}
+
+func (r *Refresher) refreshBatch(ctx context.Context, definitions []*definition.Definition) ([]*Price, error) {
+	byAbbreviation := make(map[string][]uuid.UUID)
+	var abbreviations []string
+	for _, d := range definitions {
+		key := strings.ToUpper(d.Abbreviation)
+		if _, ok := byAbbreviation[key]; !ok {
+			abbreviations = append(abbreviations, d.Abbreviation)
+		}
+		byAbbreviation[key] = append(byAbbreviation[key], d.ID)
+	}
+
+	quotes, err := r.provider.FetchQuotes(ctx, abbreviations)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	var prices []*Price
+	for _, q := range quotes {
+		if q.Price <= 0 || q.QuoteCurrency == "" {
+			continue
+		}
+		pricedAt := q.PricedAt
+		if pricedAt.IsZero() {
+			pricedAt = now
+		}
+		for _, definitionID := range byAbbreviation[strings.ToUpper(q.Abbreviation)] {
+			prices = append(prices, &Price{
+				ID:            uuid.New(),
+				DefinitionID:  definitionID,
+				QuoteCurrency: strings.ToUpper(q.QuoteCurrency),
+				PricedAt:      pricedAt,
+				Price:         q.Price,
+				Source:        r.provider.Name(),
+				CreatedAt:     now,
+			})
+		}
+	}
+
+	if len(prices) == 0 {
+		return nil, nil
+	}
+	if err := r.repo.BulkUpsert(ctx, prices); err != nil {
+		return nil, err
+	}
+	for _, listener := range r.listeners {
+		listener(prices)
+	}
+	return prices, nil
+}
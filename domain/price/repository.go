@@ -0,0 +1,17 @@
+package price
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	BulkUpsert(ctx context.Context, prices []*Price) error
+	GetLatest(ctx context.Context, definitionID uuid.UUID, quoteCurrency string) (*Price, error)
+	GetAt(ctx context.Context, definitionID uuid.UUID, quoteCurrency string, at time.Time) (*Price, error)
+	GetHistory(ctx context.Context, query GetPriceHistoryQuery) ([]*Price, error)
+	// GetLatestForDefinitions returns, per definition and quote currency, the last price at or before at.
+	GetLatestForDefinitions(ctx context.Context, definitionIDs []uuid.UUID, at time.Time) ([]*Price, error)
+}
@@ -0,0 +1,57 @@
+package realestate
+
+type CreatePropertyCommand struct {
+	UserID  string `json:"userId" validate:"required"`
+	AssetID string `json:"assetId" validate:"required"`
+	// Currency defaults to the base currency
+	Currency       string  `json:"currency"`
+	PurchasePrice  float64 `json:"purchasePrice" validate:"required"`
+	PurchaseCosts  float64 `json:"purchaseCosts"`
+	PurchaseDate   int64   `json:"purchaseDate" validate:"required"`
+	MortgageLoanID *string `json:"mortgageLoanId,omitempty"`
+}
+
+type UpdatePropertyCommand struct {
+	ID             string  `json:"id" validate:"required"`
+	UserID         string  `json:"userId" validate:"required"`
+	Currency       string  `json:"currency" validate:"required"`
+	PurchasePrice  float64 `json:"purchasePrice" validate:"required"`
+	PurchaseCosts  float64 `json:"purchaseCosts"`
+	PurchaseDate   int64   `json:"purchaseDate" validate:"required"`
+	MortgageLoanID *string `json:"mortgageLoanId,omitempty"`
+}
+
+type DeletePropertyCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type AddAppraisalCommand struct {
+	PropertyID  string  `json:"propertyId" validate:"required"`
+	UserID      string  `json:"userId" validate:"required"`
+	Value       float64 `json:"value" validate:"required"`
+	AppraisedAt int64   `json:"appraisedAt" validate:"required"`
+	Source      string  `json:"source"`
+	Notes       string  `json:"notes"`
+}
+
+type DeleteAppraisalCommand struct {
+	PropertyID  string `json:"propertyId" validate:"required"`
+	AppraisalID string `json:"appraisalId" validate:"required"`
+	UserID      string `json:"userId" validate:"required"`
+}
+
+type AddExpenseCommand struct {
+	PropertyID string          `json:"propertyId" validate:"required"`
+	UserID     string          `json:"userId" validate:"required"`
+	Category   ExpenseCategory `json:"category" validate:"required"`
+	Amount     float64         `json:"amount" validate:"required"`
+	PaidAt     int64           `json:"paidAt" validate:"required"`
+	Notes      string          `json:"notes"`
+}
+
+type DeleteExpenseCommand struct {
+	PropertyID string `json:"propertyId" validate:"required"`
+	ExpenseID  string `json:"expenseId" validate:"required"`
+	UserID     string `json:"userId" validate:"required"`
+}
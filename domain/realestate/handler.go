@@ -0,0 +1,360 @@
+package realestate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/fx"
+	"siyahsensei/wallet-service/domain/income"
+	"siyahsensei/wallet-service/domain/loan"
+)
+
+type Handler struct {
+	repo                Repository
+	assetRepo           asset.Repository
+	accountRepo         account.Repository
+	loanRepo            loan.Repository
+	incomeRepo          income.Repository
+	fxService           *fx.Handler
+	defaultBaseCurrency string
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, accountRepo account.Repository, loanRepo loan.Repository,
+	incomeRepo income.Repository, fxService *fx.Handler, defaultBaseCurrency string) *Handler {
+	return &Handler{
+		repo:                repo,
+		assetRepo:           assetRepo,
+		accountRepo:         accountRepo,
+		loanRepo:            loanRepo,
+		incomeRepo:          incomeRepo,
+		fxService:           fxService,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+	}
+}
+
+func (h *Handler) HandleCreatePropertyCommand(ctx context.Context, command CreatePropertyCommand) (*Property, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	if strings.TrimSpace(command.Currency) == "" {
+		command.Currency = h.defaultBaseCurrency
+	}
+	if err := validatePurchase(command.PurchasePrice, command.PurchaseCosts, command.PurchaseDate); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+	if existingAsset.Type != asset.RealEstate {
+		existingAccount, err := h.accountRepo.GetByID(ctx, existingAsset.AccountID)
+		if err != nil || existingAccount.AccountType != account.Home {
+			return nil, errors.New("asset is not real estate")
+		}
+	}
+	if _, err := h.repo.GetByAssetID(ctx, assetID); err == nil {
+		return nil, errors.New("asset already has property details")
+	}
+
+	mortgageLoanID, err := h.mortgageLoanID(ctx, command.MortgageLoanID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	property := NewProperty(command, mortgageLoanID)
+	if err := h.repo.Create(ctx, property); err != nil {
+		return nil, err
+	}
+	return property, nil
+}
+
+func (h *Handler) HandleUpdatePropertyCommand(ctx context.Context, command UpdatePropertyCommand) (*Property, error) {
+	property, err := h.ownedProperty(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(command.Currency) == "" {
+		return nil, errors.New("currency is required")
+	}
+	if err := validatePurchase(command.PurchasePrice, command.PurchaseCosts, command.PurchaseDate); err != nil {
+		return nil, err
+	}
+
+	mortgageLoanID, err := h.mortgageLoanID(ctx, command.MortgageLoanID, property.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	property.Update(command, mortgageLoanID)
+	if err := h.repo.Update(ctx, property); err != nil {
+		return nil, err
+	}
+	return property, nil
+}
+
+func (h *Handler) HandleDeletePropertyCommand(ctx context.Context, command DeletePropertyCommand) error {
+	property, err := h.ownedProperty(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, property.ID)
+}
+
+func (h *Handler) HandleGetPropertyByIDQuery(ctx context.Context, query GetPropertyByIDQuery) (*Property, error) {
+	return h.ownedProperty(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserPropertiesQuery(ctx context.Context, query GetUserPropertiesQuery) ([]*Property, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleAddAppraisalCommand(ctx context.Context, command AddAppraisalCommand) (*Appraisal, error) {
+	property, err := h.ownedProperty(ctx, command.PropertyID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if command.Value <= 0 {
+		return nil, errors.New("value must be greater than zero")
+	}
+	if command.AppraisedAt <= 0 {
+		return nil, errors.New("appraisal date is required")
+	}
+
+	appraisal := NewAppraisal(property, command)
+	if err := h.repo.AddAppraisal(ctx, appraisal); err != nil {
+		return nil, err
+	}
+	return appraisal, nil
+}
+
+func (h *Handler) HandleDeleteAppraisalCommand(ctx context.Context, command DeleteAppraisalCommand) error {
+	property, err := h.ownedProperty(ctx, command.PropertyID, command.UserID)
+	if err != nil {
+		return err
+	}
+	appraisalID, err := uuid.Parse(command.AppraisalID)
+	if err != nil {
+		return errors.New("invalid appraisal ID")
+	}
+
+	appraisal, err := h.repo.GetAppraisal(ctx, appraisalID)
+	if err != nil || appraisal.PropertyID != property.ID {
+		return errors.New("appraisal not found")
+	}
+	return h.repo.DeleteAppraisal(ctx, appraisal.ID)
+}
+
+func (h *Handler) HandleGetAppraisalsQuery(ctx context.Context, query GetAppraisalsQuery) ([]*Appraisal, error) {
+	property, err := h.ownedProperty(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return h.repo.GetAppraisals(ctx, property.ID)
+}
+
+func (h *Handler) HandleAddExpenseCommand(ctx context.Context, command AddExpenseCommand) (*Expense, error) {
+	property, err := h.ownedProperty(ctx, command.PropertyID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if !command.Category.IsValid() {
+		return nil, errors.New("invalid expense category")
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.PaidAt <= 0 {
+		return nil, errors.New("payment date is required")
+	}
+
+	expense := NewExpense(property, command)
+	if err := h.repo.AddExpense(ctx, expense); err != nil {
+		return nil, err
+	}
+	return expense, nil
+}
+
+func (h *Handler) HandleDeleteExpenseCommand(ctx context.Context, command DeleteExpenseCommand) error {
+	property, err := h.ownedProperty(ctx, command.PropertyID, command.UserID)
+	if err != nil {
+		return err
+	}
+	expenseID, err := uuid.Parse(command.ExpenseID)
+	if err != nil {
+		return errors.New("invalid expense ID")
+	}
+
+	expense, err := h.repo.GetExpense(ctx, expenseID)
+	if err != nil || expense.PropertyID != property.ID {
+		return errors.New("expense not found")
+	}
+	return h.repo.DeleteExpense(ctx, expense.ID)
+}
+
+func (h *Handler) HandleGetExpensesQuery(ctx context.Context, query GetExpensesQuery) ([]*Expense, error) {
+	property, err := h.ownedProperty(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	expenses, err := h.repo.GetExpenses(ctx, property.ID)
+	if err != nil || (query.From == nil && query.To == nil) {
+		return expenses, err
+	}
+
+	var filtered []*Expense
+	for _, e := range expenses {
+		if query.From != nil && e.PaidAt.Before(*query.From) {
+			continue
+		}
+		if query.To != nil && e.PaidAt.After(*query.To) {
+			continue
+		}
+		filtered = append(filtered, e)
+	}
+	return filtered, nil
+}
+
+// HandleGetPerformanceQuery values a property as of a date and derives its equity,
+// appreciation and rental yield. Rent is the RENT income recorded on the asset over the
+// trailing year, converted into the property currency at the rates known at the date.
+func (h *Handler) HandleGetPerformanceQuery(ctx context.Context, query GetPerformanceQuery) (*Performance, error) {
+	property, err := h.ownedProperty(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if query.AsOf.IsZero() {
+		query.AsOf = time.Now()
+	}
+
+	appraisals, err := h.repo.GetAppraisals(ctx, property.ID)
+	if err != nil {
+		return nil, err
+	}
+	expenses, err := h.repo.GetExpenses(ctx, property.ID)
+	if err != nil {
+		return nil, err
+	}
+	perf := newPerformance(property, appraisals, expenses, query.AsOf)
+
+	table, err := h.fxService.Table(ctx, query.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	assetID := property.AssetID.String()
+	rent := income.Rent
+	from := query.AsOf.AddDate(-1, 0, 0).Add(time.Nanosecond)
+	records, err := h.incomeRepo.Filter(ctx, income.FilterIncomeQuery{
+		UserID:  property.UserID.String(),
+		AssetID: &assetID,
+		Type:    &rent,
+		From:    &from,
+		To:      &query.AsOf,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		gross, ok := table.Convert(record.GrossAmount, record.Currency, property.Currency)
+		if !ok {
+			perf.UnconvertedRent++
+			continue
+		}
+		perf.AnnualRent += gross
+	}
+
+	if property.MortgageLoanID != nil {
+		mortgage, err := h.loanRepo.GetByID(ctx, *property.MortgageLoanID)
+		if err != nil {
+			return nil, errors.New("loan not found")
+		}
+		payments, err := h.loanRepo.GetPayments(ctx, mortgage.ID)
+		if err != nil {
+			return nil, err
+		}
+		remaining := mortgage.StatusAt(payments, query.AsOf).RemainingPrincipal
+		balance, ok := table.Convert(remaining, mortgage.Currency, property.Currency)
+		if !ok {
+			return nil, errors.New("mortgage currency cannot be converted into the property currency")
+		}
+		perf.MortgageBalance = balance
+	}
+
+	perf.finish()
+	return perf, nil
+}
+
+// mortgageLoanID checks that the linked loan, when there is one, belongs to the user.
+func (h *Handler) mortgageLoanID(ctx context.Context, value *string, userID uuid.UUID) (*uuid.UUID, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	loanID, err := uuid.Parse(*value)
+	if err != nil {
+		return nil, errors.New("invalid loan ID")
+	}
+	mortgage, err := h.loanRepo.GetByID(ctx, loanID)
+	if err != nil {
+		return nil, errors.New("loan not found")
+	}
+	if mortgage.UserID != userID {
+		return nil, errors.New("unauthorized: loan does not belong to user")
+	}
+	return &loanID, nil
+}
+
+func (h *Handler) ownedProperty(ctx context.Context, propertyIDValue, userIDValue string) (*Property, error) {
+	propertyID, err := uuid.Parse(propertyIDValue)
+	if err != nil {
+		return nil, errors.New("invalid property ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	property, err := h.repo.GetByID(ctx, propertyID)
+	if err != nil {
+		return nil, errors.New("property not found")
+	}
+
+	if property.UserID != userID {
+		return nil, errors.New("unauthorized: property does not belong to user")
+	}
+
+	return property, nil
+}
+
+func validatePurchase(purchasePrice, purchaseCosts float64, purchaseDate int64) error {
+	if purchasePrice <= 0 {
+		return errors.New("purchase price must be greater than zero")
+	}
+	if purchaseCosts < 0 {
+		return errors.New("purchase costs must not be negative")
+	}
+	if purchaseDate <= 0 {
+		return errors.New("purchase date is required")
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package realestate
+
+import (
+	"math"
+	"time"
+)
+
+type ValueSource string
+
+const (
+	SourceAppraisal     ValueSource = "APPRAISAL"
+	SourcePurchasePrice ValueSource = "PURCHASE_PRICE"
+)
+
+// Performance reports the value, equity and rental yield of a property as of a date, in
+// the property currency. Rent and expenses cover the twelve months up to the date, and
+// percentages are zero when what they relate to is unknown.
+type Performance struct {
+	AsOf          time.Time   `json:"asOf"`
+	Currency      string      `json:"currency"`
+	PurchasePrice float64     `json:"purchasePrice"`
+	PurchaseCosts float64     `json:"purchaseCosts"`
+	CostBasis     float64     `json:"costBasis"`
+	CurrentValue  float64     `json:"currentValue"`
+	ValueSource   ValueSource `json:"valueSource"`
+	ValuedAt      time.Time   `json:"valuedAt"`
+	YearsHeld     float64     `json:"yearsHeld"`
+	// Appreciation is the change of the value against the purchase price
+	Appreciation           float64 `json:"appreciation"`
+	AppreciationPercent    float64 `json:"appreciationPercent"`
+	AnnualizedAppreciation float64 `json:"annualizedAppreciation"`
+	MortgageBalance        float64 `json:"mortgageBalance"`
+	Equity                 float64 `json:"equity"`
+	LoanToValue            float64 `json:"loanToValue"`
+	AnnualRent             float64 `json:"annualRent"`
+	AnnualExpenses         float64 `json:"annualExpenses"`
+	NetOperatingIncome     float64 `json:"netOperatingIncome"`
+	GrossYield             float64 `json:"grossYield"`
+	NetYield               float64 `json:"netYield"`
+	NetYieldOnCost         float64 `json:"netYieldOnCost"`
+	// UnconvertedRent counts rent records whose currency could not be converted
+	UnconvertedRent int `json:"unconvertedRent"`
+}
+
+// newPerformance values the property with the latest appraisal up to asOf, falling back
+// to the purchase price, and adds up the expenses of the trailing year.
+func newPerformance(p *Property, appraisals []*Appraisal, expenses []*Expense, asOf time.Time) *Performance {
+	perf := &Performance{
+		AsOf:          asOf,
+		Currency:      p.Currency,
+		PurchasePrice: p.PurchasePrice,
+		PurchaseCosts: p.PurchaseCosts,
+		CostBasis:     p.CostBasis(),
+		CurrentValue:  p.PurchasePrice,
+		ValueSource:   SourcePurchasePrice,
+		ValuedAt:      p.PurchaseDate,
+	}
+
+	for _, a := range appraisals {
+		if a.AppraisedAt.After(asOf) || a.AppraisedAt.Before(perf.ValuedAt) {
+			continue
+		}
+		perf.CurrentValue = a.Value
+		perf.ValueSource = SourceAppraisal
+		perf.ValuedAt = a.AppraisedAt
+	}
+
+	yearStart := asOf.AddDate(-1, 0, 0)
+	for _, e := range expenses {
+		if e.PaidAt.After(yearStart) && !e.PaidAt.After(asOf) {
+			perf.AnnualExpenses += e.Amount
+		}
+	}
+
+	if held := asOf.Sub(p.PurchaseDate).Hours() / 24 / 365; held > 0 {
+		perf.YearsHeld = held
+	}
+	return perf
+}
+
+// finish derives appreciation, equity and yields once rent and the mortgage are known.
+func (perf *Performance) finish() {
+	perf.Appreciation = perf.CurrentValue - perf.PurchasePrice
+	if perf.PurchasePrice > 0 {
+		perf.AppreciationPercent = perf.Appreciation / perf.PurchasePrice * 100
+		if perf.YearsHeld >= 1 && perf.CurrentValue > 0 {
+			perf.AnnualizedAppreciation = (math.Pow(perf.CurrentValue/perf.PurchasePrice, 1/perf.YearsHeld) - 1) * 100
+		}
+	}
+
+	perf.Equity = perf.CurrentValue - perf.MortgageBalance
+	perf.NetOperatingIncome = perf.AnnualRent - perf.AnnualExpenses
+	if perf.CurrentValue > 0 {
+		perf.LoanToValue = perf.MortgageBalance / perf.CurrentValue * 100
+		perf.GrossYield = perf.AnnualRent / perf.CurrentValue * 100
+		perf.NetYield = perf.NetOperatingIncome / perf.CurrentValue * 100
+	}
+	if perf.CostBasis > 0 {
+		perf.NetYieldOnCost = perf.NetOperatingIncome / perf.CostBasis * 100
+	}
+}
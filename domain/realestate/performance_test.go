@@ -0,0 +1,107 @@
+package realestate
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestNewPerformance(t *testing.T) {
+	p := &Property{Currency: "EUR", PurchasePrice: 400000, PurchaseCosts: 20000, PurchaseDate: date(2020, 1, 1)}
+	appraisals := []*Appraisal{
+		{Value: 450000, AppraisedAt: date(2022, 1, 1)},
+		{Value: 500000, AppraisedAt: date(2023, 6, 1)},
+		{Value: 600000, AppraisedAt: date(2025, 1, 1)},
+	}
+	expenses := []*Expense{
+		{Amount: 1000, PaidAt: date(2022, 12, 15)},
+		{Amount: 2000, PaidAt: date(2023, 3, 1)},
+		{Amount: 3000, PaidAt: date(2023, 12, 31)},
+		{Amount: 500, PaidAt: date(2024, 2, 1)},
+	}
+
+	tests := []struct {
+		name     string
+		asOf     time.Time
+		value    float64
+		source   ValueSource
+		valuedAt time.Time
+		expenses float64
+	}{
+		{"before any appraisal", date(2021, 6, 1), 400000, SourcePurchasePrice, date(2020, 1, 1), 0},
+		{"latest appraisal up to the date", date(2024, 1, 1), 500000, SourceAppraisal, date(2023, 6, 1), 5000},
+		{"on the appraisal date", date(2022, 1, 1), 450000, SourceAppraisal, date(2022, 1, 1), 0},
+		{"expenses of the trailing year only", date(2024, 3, 1), 500000, SourceAppraisal, date(2023, 6, 1), 3500},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perf := newPerformance(p, appraisals, expenses, tt.asOf)
+
+			if perf.CurrentValue != tt.value || perf.ValueSource != tt.source || !perf.ValuedAt.Equal(tt.valuedAt) {
+				t.Errorf("valued at %v from %s on %v, want %v from %s on %v",
+					perf.CurrentValue, perf.ValueSource, perf.ValuedAt, tt.value, tt.source, tt.valuedAt)
+			}
+			if perf.AnnualExpenses != tt.expenses {
+				t.Errorf("AnnualExpenses = %v, want %v", perf.AnnualExpenses, tt.expenses)
+			}
+			if perf.CostBasis != 420000 {
+				t.Errorf("CostBasis = %v, want the price plus the purchase costs", perf.CostBasis)
+			}
+		})
+	}
+}
+
+func TestPerformanceFinish(t *testing.T) {
+	tests := []struct {
+		name string
+		perf Performance
+		want Performance
+	}{
+		{
+			name: "rented with a mortgage",
+			perf: Performance{PurchasePrice: 400000, CostBasis: 420000, CurrentValue: 500000, YearsHeld: 1461.0 / 365, MortgageBalance: 200000, AnnualRent: 24000, AnnualExpenses: 5000},
+			want: Performance{
+				Appreciation:           100000,
+				AppreciationPercent:    25,
+				AnnualizedAppreciation: 5.733089,
+				Equity:                 300000,
+				LoanToValue:            40,
+				NetOperatingIncome:     19000,
+				GrossYield:             4.8,
+				NetYield:               3.8,
+				NetYieldOnCost:         4.523810,
+			},
+		},
+		{
+			name: "held under a year is not annualized",
+			perf: Performance{PurchasePrice: 400000, CostBasis: 400000, CurrentValue: 420000, YearsHeld: 0.5},
+			want: Performance{Appreciation: 20000, AppreciationPercent: 5, Equity: 420000},
+		},
+		{
+			name: "unknown value",
+			perf: Performance{MortgageBalance: 1000, AnnualRent: 100},
+			want: Performance{Equity: -1000, NetOperatingIncome: 100},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perf := tt.perf
+			perf.finish()
+
+			got := []float64{perf.Appreciation, perf.AppreciationPercent, perf.AnnualizedAppreciation, perf.Equity,
+				perf.LoanToValue, perf.NetOperatingIncome, perf.GrossYield, perf.NetYield, perf.NetYieldOnCost}
+			want := []float64{tt.want.Appreciation, tt.want.AppreciationPercent, tt.want.AnnualizedAppreciation, tt.want.Equity,
+				tt.want.LoanToValue, tt.want.NetOperatingIncome, tt.want.GrossYield, tt.want.NetYield, tt.want.NetYieldOnCost}
+			for i := range got {
+				if math.Abs(got[i]-want[i]) > 1e-6 {
+					t.Errorf("finish() = %v, want %v", got, want)
+					break
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package realestate
+
+import (
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Property holds the purchase details of a REAL_ESTATE asset, or of an asset kept in a
+// HOME account. Amounts are in Currency, and MortgageLoanID links the loan that
+// financed it.
+type Property struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	UserID         uuid.UUID  `json:"userId" db:"user_id"`
+	AssetID        uuid.UUID  `json:"assetId" db:"asset_id"`
+	Currency       string     `json:"currency" db:"currency"`
+	PurchasePrice  float64    `json:"purchasePrice" db:"purchase_price"`
+	PurchaseCosts  float64    `json:"purchaseCosts" db:"purchase_costs"`
+	PurchaseDate   time.Time  `json:"purchaseDate" db:"purchase_date"`
+	MortgageLoanID *uuid.UUID `json:"mortgageLoanId,omitempty" db:"mortgage_loan_id"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func NewProperty(command CreatePropertyCommand, mortgageLoanID *uuid.UUID) *Property {
+	now := time.Now()
+	return &Property{
+		ID:             uuid.New(),
+		UserID:         uuid.MustParse(command.UserID),
+		AssetID:        uuid.MustParse(command.AssetID),
+		Currency:       strings.ToUpper(command.Currency),
+		PurchasePrice:  command.PurchasePrice,
+		PurchaseCosts:  command.PurchaseCosts,
+		PurchaseDate:   time.Unix(command.PurchaseDate, 0),
+		MortgageLoanID: mortgageLoanID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+func (p *Property) Update(command UpdatePropertyCommand, mortgageLoanID *uuid.UUID) {
+	p.Currency = strings.ToUpper(command.Currency)
+	p.PurchasePrice = command.PurchasePrice
+	p.PurchaseCosts = command.PurchaseCosts
+	p.PurchaseDate = time.Unix(command.PurchaseDate, 0)
+	p.MortgageLoanID = mortgageLoanID
+	p.UpdatedAt = time.Now()
+}
+
+// CostBasis is what acquiring the property cost, including taxes and fees.
+func (p *Property) CostBasis() float64 {
+	return p.PurchasePrice + p.PurchaseCosts
+}
+
+// Appraisal is the estimated market value of a property on a date.
+type Appraisal struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	PropertyID  uuid.UUID `json:"propertyId" db:"property_id"`
+	UserID      uuid.UUID `json:"userId" db:"user_id"`
+	Value       float64   `json:"value" db:"value"`
+	AppraisedAt time.Time `json:"appraisedAt" db:"appraised_at"`
+	// Source says who estimated the value, such as a bank, an agent or the owner
+	Source    string    `json:"source" db:"source"`
+	Notes     string    `json:"notes" db:"notes"`
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+func NewAppraisal(p *Property, command AddAppraisalCommand) *Appraisal {
+	return &Appraisal{
+		ID:          uuid.New(),
+		PropertyID:  p.ID,
+		UserID:      p.UserID,
+		Value:       command.Value,
+		AppraisedAt: time.Unix(command.AppraisedAt, 0),
+		Source:      command.Source,
+		Notes:       command.Notes,
+		CreatedAt:   time.Now(),
+	}
+}
+
+type ExpenseCategory string
+
+const (
+	Maintenance ExpenseCategory = "MAINTENANCE"
+	PropertyTax ExpenseCategory = "PROPERTY_TAX"
+	Insurance   ExpenseCategory = "INSURANCE"
+	Management  ExpenseCategory = "MANAGEMENT"
+	Utilities   ExpenseCategory = "UTILITIES"
+	HOA         ExpenseCategory = "HOA"
+	Other       ExpenseCategory = "OTHER"
+)
+
+func (c ExpenseCategory) IsValid() bool {
+	switch c {
+	case Maintenance, PropertyTax, Insurance, Management, Utilities, HOA, Other:
+		return true
+	default:
+		return false
+	}
+}
+
+// Expense is a running cost of a property in the property currency. Mortgage interest
+// is not an expense here since it is tracked on the loan.
+type Expense struct {
+	ID         uuid.UUID       `json:"id" db:"id"`
+	PropertyID uuid.UUID       `json:"propertyId" db:"property_id"`
+	UserID     uuid.UUID       `json:"userId" db:"user_id"`
+	Category   ExpenseCategory `json:"category" db:"category"`
+	Amount     float64         `json:"amount" db:"amount"`
+	PaidAt     time.Time       `json:"paidAt" db:"paid_at"`
+	Notes      string          `json:"notes" db:"notes"`
+	CreatedAt  time.Time       `json:"createdAt" db:"created_at"`
+}
+
+func NewExpense(p *Property, command AddExpenseCommand) *Expense {
+	return &Expense{
+		ID:         uuid.New(),
+		PropertyID: p.ID,
+		UserID:     p.UserID,
+		Category:   command.Category,
+		Amount:     command.Amount,
+		PaidAt:     time.Unix(command.PaidAt, 0),
+		Notes:      command.Notes,
+		CreatedAt:  time.Now(),
+	}
+}
@@ -0,0 +1,32 @@
+package realestate
+
+import (
+	"time"
+)
+
+type GetPropertyByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserPropertiesQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetAppraisalsQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetExpensesQuery struct {
+	ID     string     `json:"id" validate:"required"`
+	UserID string     `json:"userId" validate:"required"`
+	From   *time.Time `json:"from,omitempty"`
+	To     *time.Time `json:"to,omitempty"`
+}
+
+type GetPerformanceQuery struct {
+	ID     string    `json:"id" validate:"required"`
+	UserID string    `json:"userId" validate:"required"`
+	AsOf   time.Time `json:"asOf"`
+}
@@ -0,0 +1,26 @@
+package realestate
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, p *Property) error
+	Update(ctx context.Context, p *Property) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Property, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) (*Property, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Property, error)
+	AddAppraisal(ctx context.Context, a *Appraisal) error
+	GetAppraisal(ctx context.Context, id uuid.UUID) (*Appraisal, error)
+	DeleteAppraisal(ctx context.Context, id uuid.UUID) error
+	// GetAppraisals returns the appraisals of a property, oldest first.
+	GetAppraisals(ctx context.Context, propertyID uuid.UUID) ([]*Appraisal, error)
+	AddExpense(ctx context.Context, e *Expense) error
+	GetExpense(ctx context.Context, id uuid.UUID) (*Expense, error)
+	DeleteExpense(ctx context.Context, id uuid.UUID) error
+	// GetExpenses returns the expenses of a property, oldest first.
+	GetExpenses(ctx context.Context, propertyID uuid.UUID) ([]*Expense, error)
+}
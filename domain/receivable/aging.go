@@ -0,0 +1,66 @@
+package receivable
+
+import (
+	"time"
+)
+
+// AgingBucket adds up the outstanding amounts whose due date passed between MinDays and
+// MaxDays ago. The last bucket has no upper bound.
+type AgingBucket struct {
+	Label       string  `json:"label"`
+	MinDays     int     `json:"minDays"`
+	MaxDays     *int    `json:"maxDays,omitempty"`
+	Count       int     `json:"count"`
+	Outstanding float64 `json:"outstanding"`
+}
+
+func (b *AgingBucket) add(outstanding float64) {
+	b.Count++
+	b.Outstanding += outstanding
+}
+
+// Aging groups what is still owed by how long it is past due, in the base currency.
+// Receivables that are not overdue yet are kept apart in Current, and those whose currency
+// cannot be converted are counted in Unconverted.
+type Aging struct {
+	AsOf             time.Time      `json:"asOf"`
+	BaseCurrency     string         `json:"baseCurrency"`
+	Current          *AgingBucket   `json:"current"`
+	Buckets          []*AgingBucket `json:"buckets"`
+	TotalOverdue     float64        `json:"totalOverdue"`
+	TotalOutstanding float64        `json:"totalOutstanding"`
+	Unconverted      int            `json:"unconverted"`
+}
+
+func newAging(asOf time.Time, base string) *Aging {
+	bound := func(days int) *int { return &days }
+	return &Aging{
+		AsOf:         asOf,
+		BaseCurrency: base,
+		Current:      &AgingBucket{Label: "current"},
+		Buckets: []*AgingBucket{
+			{Label: "0-30", MinDays: 1, MaxDays: bound(30)},
+			{Label: "31-60", MinDays: 31, MaxDays: bound(60)},
+			{Label: "61-90", MinDays: 61, MaxDays: bound(90)},
+			{Label: "90+", MinDays: 91},
+		},
+	}
+}
+
+// add books the outstanding amount of a receivable already converted into the base currency.
+func (a *Aging) add(r *Receivable, outstanding float64) {
+	a.TotalOutstanding += outstanding
+
+	days := r.DaysOverdue(a.AsOf)
+	if days <= 0 {
+		a.Current.add(outstanding)
+		return
+	}
+	a.TotalOverdue += outstanding
+	for _, bucket := range a.Buckets {
+		if bucket.MaxDays == nil || days <= *bucket.MaxDays {
+			bucket.add(outstanding)
+			return
+		}
+	}
+}
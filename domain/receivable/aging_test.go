@@ -0,0 +1,76 @@
+package receivable
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAgingAdd(t *testing.T) {
+	asOf := time.Date(2024, 6, 30, 18, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		daysOverdue int
+		bucket      string
+	}{
+		{"due later", -5, "current"},
+		{"due today", 0, "current"},
+		{"a day late", 1, "0-30"},
+		{"upper bound of the first bucket", 30, "0-30"},
+		{"lower bound of the second bucket", 31, "31-60"},
+		{"upper bound of the third bucket", 90, "61-90"},
+		{"past every bound", 91, "90+"},
+		{"far past due", 400, "90+"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &Receivable{DueDate: time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC).AddDate(0, 0, -tt.daysOverdue)}
+			a := newAging(asOf, "USD")
+
+			a.add(r, 100)
+
+			for _, b := range append([]*AgingBucket{a.Current}, a.Buckets...) {
+				want := 0
+				if b.Label == tt.bucket {
+					want = 1
+				}
+				if b.Count != want || b.Outstanding != float64(want)*100 {
+					t.Errorf("bucket %s holds %d for %v, want %d", b.Label, b.Count, b.Outstanding, want)
+				}
+			}
+			overdue := 0.0
+			if tt.bucket != "current" {
+				overdue = 100
+			}
+			if a.TotalOverdue != overdue || a.TotalOutstanding != 100 {
+				t.Errorf("overdue %v of %v outstanding, want %v of 100", a.TotalOverdue, a.TotalOutstanding, overdue)
+			}
+		})
+	}
+}
+
+func TestStatusAt(t *testing.T) {
+	due := time.Date(2024, 6, 30, 0, 0, 0, 0, time.UTC)
+	writtenOff := due.AddDate(0, 1, 0)
+
+	tests := []struct {
+		name       string
+		receivable Receivable
+		asOf       time.Time
+		want       Status
+	}{
+		{"nothing repaid before the due date", Receivable{Amount: 100, DueDate: due}, due, Open},
+		{"partly repaid before the due date", Receivable{Amount: 100, Repaid: 40, DueDate: due}, due, PartiallyPaid},
+		{"outstanding the day after the due date", Receivable{Amount: 100, Repaid: 40, DueDate: due}, due.AddDate(0, 0, 1), Overdue},
+		{"repaid in full", Receivable{Amount: 100, Repaid: 100, DueDate: due}, due.AddDate(0, 0, 10), Paid},
+		{"repaid and written off in part", Receivable{Amount: 100, Repaid: 60, WrittenOff: 40, DueDate: due}, due.AddDate(0, 0, 10), Paid},
+		{"written off", Receivable{Amount: 100, WrittenOff: 100, WrittenOffAt: &writtenOff, DueDate: due}, due.AddDate(0, 0, 10), WrittenOff},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.receivable.StatusAt(tt.asOf); got != tt.want {
+				t.Errorf("StatusAt = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package receivable
+
+type CreateReceivableCommand struct {
+	UserID              string `json:"userId" validate:"required"`
+	AssetID             string `json:"assetId" validate:"required"`
+	CounterpartyName    string `json:"counterpartyName" validate:"required"`
+	CounterpartyContact string `json:"counterpartyContact"`
+	// Amount defaults to the current quantity of the asset
+	Amount    float64 `json:"amount"`
+	IssueDate int64   `json:"issueDate"`
+	DueDate   int64   `json:"dueDate" validate:"required"`
+}
+
+type UpdateReceivableCommand struct {
+	ID                  string  `json:"id" validate:"required"`
+	UserID              string  `json:"userId" validate:"required"`
+	CounterpartyName    string  `json:"counterpartyName" validate:"required"`
+	CounterpartyContact string  `json:"counterpartyContact"`
+	Amount              float64 `json:"amount" validate:"required"`
+	IssueDate           int64   `json:"issueDate"`
+	DueDate             int64   `json:"dueDate" validate:"required"`
+}
+
+type DeleteReceivableCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// RecordRepaymentCommand records money received back. The amount reduces the receivable
+// asset; with ToAssetID it is also deposited into that asset.
+type RecordRepaymentCommand struct {
+	ReceivableID string  `json:"receivableId" validate:"required"`
+	UserID       string  `json:"userId" validate:"required"`
+	Amount       float64 `json:"amount" validate:"required"`
+	RepaidAt     int64   `json:"repaidAt" validate:"required"`
+	ToAssetID    *string `json:"toAssetId,omitempty"`
+	Notes        string  `json:"notes"`
+}
+
+// WriteOffCommand gives up on what is still outstanding on a receivable.
+type WriteOffCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+	Date   int64  `json:"date"`
+	Notes  string `json:"notes"`
+}
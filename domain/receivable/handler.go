@@ -0,0 +1,305 @@
+package receivable
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/fx"
+)
+
+type Handler struct {
+	repo                Repository
+	assetRepo           asset.Repository
+	definitionRepo      definition.Repository
+	fxService           *fx.Handler
+	defaultBaseCurrency string
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, definitionRepo definition.Repository, fxService *fx.Handler, defaultBaseCurrency string) *Handler {
+	return &Handler{
+		repo:                repo,
+		assetRepo:           assetRepo,
+		definitionRepo:      definitionRepo,
+		fxService:           fxService,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+	}
+}
+
+func (h *Handler) HandleCreateReceivableCommand(ctx context.Context, command CreateReceivableCommand) (*Receivable, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	if err := validateTerms(command.CounterpartyName, command.IssueDate, command.DueDate); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+	if existingAsset.Type != asset.Receivable {
+		return nil, errors.New("asset is not a receivable")
+	}
+	if _, err := h.repo.GetByAssetID(ctx, assetID); err == nil {
+		return nil, errors.New("asset already has receivable terms")
+	}
+
+	if command.Amount == 0 {
+		command.Amount = existingAsset.Quantity
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+
+	existingDefinition, err := h.definitionRepo.GetByID(ctx, existingAsset.DefinitionID)
+	if err != nil {
+		return nil, errors.New("definition not found")
+	}
+
+	receivable := NewReceivable(command, existingDefinition.Abbreviation)
+	if err := h.repo.Create(ctx, receivable); err != nil {
+		return nil, err
+	}
+	return receivable, nil
+}
+
+func (h *Handler) HandleUpdateReceivableCommand(ctx context.Context, command UpdateReceivableCommand) (*Receivable, error) {
+	receivable, err := h.ownedReceivable(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTerms(command.CounterpartyName, command.IssueDate, command.DueDate); err != nil {
+		return nil, err
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.Amount < receivable.Repaid+receivable.WrittenOff {
+		return nil, errors.New("amount must not be less than what was already settled")
+	}
+
+	receivable.Update(command)
+	if err := h.repo.Update(ctx, receivable); err != nil {
+		return nil, err
+	}
+	return receivable, nil
+}
+
+func (h *Handler) HandleDeleteReceivableCommand(ctx context.Context, command DeleteReceivableCommand) error {
+	receivable, err := h.ownedReceivable(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, receivable.ID)
+}
+
+func (h *Handler) HandleRecordRepaymentCommand(ctx context.Context, command RecordRepaymentCommand) (*Repayment, error) {
+	receivable, err := h.ownedReceivable(ctx, command.ReceivableID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if receivable.WrittenOffAt != nil {
+		return nil, errors.New("receivable is written off")
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.Amount > receivable.Outstanding() {
+		return nil, errors.New("repayment exceeds the outstanding amount")
+	}
+	if command.RepaidAt <= 0 {
+		return nil, errors.New("repayment date is required")
+	}
+
+	var toAssetID *uuid.UUID
+	if command.ToAssetID != nil {
+		id, err := uuid.Parse(*command.ToAssetID)
+		if err != nil {
+			return nil, errors.New("invalid asset ID")
+		}
+		toAsset, err := h.assetRepo.GetByID(ctx, id)
+		if err != nil {
+			return nil, errors.New("asset not found")
+		}
+		if toAsset.UserID != receivable.UserID {
+			return nil, errors.New("unauthorized: asset does not belong to user")
+		}
+		if toAsset.ID == receivable.AssetID {
+			return nil, errors.New("a receivable cannot be repaid into itself")
+		}
+		toAssetID = &id
+	}
+
+	repayment := NewRepayment(receivable, command, toAssetID)
+	if err := h.repo.RecordRepayment(ctx, receivable, repayment); err != nil {
+		return nil, err
+	}
+	return repayment, nil
+}
+
+func (h *Handler) HandleWriteOffCommand(ctx context.Context, command WriteOffCommand) (*Receivable, error) {
+	receivable, err := h.ownedReceivable(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if receivable.IsSettled() {
+		return nil, errors.New("receivable is already settled")
+	}
+
+	date := time.Now()
+	if command.Date > 0 {
+		date = time.Unix(command.Date, 0)
+	}
+	if err := h.repo.WriteOff(ctx, receivable, date, command.Notes); err != nil {
+		return nil, err
+	}
+	return h.repo.GetByID(ctx, receivable.ID)
+}
+
+func (h *Handler) HandleGetReceivableByIDQuery(ctx context.Context, query GetReceivableByIDQuery) (*Receivable, error) {
+	return h.ownedReceivable(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserReceivablesQuery(ctx context.Context, query GetUserReceivablesQuery) ([]*Receivable, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.Status != nil && !query.Status.IsValid() {
+		return nil, errors.New("invalid status")
+	}
+	if query.AsOf.IsZero() {
+		query.AsOf = time.Now()
+	}
+
+	receivables, err := h.repo.GetByUserID(ctx, userID)
+	if err != nil || query.Status == nil {
+		return receivables, err
+	}
+
+	var filtered []*Receivable
+	for _, r := range receivables {
+		if r.StatusAt(query.AsOf) == *query.Status {
+			filtered = append(filtered, r)
+		}
+	}
+	return filtered, nil
+}
+
+// HandleGetOverdueQuery lists the receivables past their due date with something still
+// outstanding, longest overdue first.
+func (h *Handler) HandleGetOverdueQuery(ctx context.Context, query GetOverdueQuery) ([]*Receivable, error) {
+	status := Overdue
+	overdue, err := h.HandleGetUserReceivablesQuery(ctx, GetUserReceivablesQuery{
+		UserID: query.UserID,
+		Status: &status,
+		AsOf:   query.AsOf,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(overdue, func(i, j int) bool {
+		return overdue[i].DueDate.Before(overdue[j].DueDate)
+	})
+	return overdue, nil
+}
+
+func (h *Handler) HandleGetRepaymentsQuery(ctx context.Context, query GetRepaymentsQuery) ([]*Repayment, error) {
+	receivable, err := h.ownedReceivable(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return h.repo.GetRepayments(ctx, receivable.ID)
+}
+
+// HandleGetAgingQuery groups the outstanding receivables by days past due. Amounts are
+// converted into the base currency at the rates known as of the report date.
+func (h *Handler) HandleGetAgingQuery(ctx context.Context, query GetAgingQuery) (*Aging, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if query.AsOf.IsZero() {
+		query.AsOf = time.Now()
+	}
+	base := strings.ToUpper(strings.TrimSpace(query.BaseCurrency))
+	if base == "" {
+		base = h.defaultBaseCurrency
+	}
+
+	receivables, err := h.repo.GetByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	table, err := h.fxService.Table(ctx, query.AsOf)
+	if err != nil {
+		return nil, err
+	}
+
+	aging := newAging(query.AsOf, base)
+	for _, r := range receivables {
+		if r.IsSettled() {
+			continue
+		}
+		outstanding, ok := table.Convert(r.Outstanding(), r.Currency, base)
+		if !ok {
+			aging.Unconverted++
+			continue
+		}
+		aging.add(r, outstanding)
+	}
+	return aging, nil
+}
+
+func (h *Handler) ownedReceivable(ctx context.Context, receivableIDValue, userIDValue string) (*Receivable, error) {
+	receivableID, err := uuid.Parse(receivableIDValue)
+	if err != nil {
+		return nil, errors.New("invalid receivable ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	receivable, err := h.repo.GetByID(ctx, receivableID)
+	if err != nil {
+		return nil, errors.New("receivable not found")
+	}
+
+	if receivable.UserID != userID {
+		return nil, errors.New("unauthorized: receivable does not belong to user")
+	}
+
+	return receivable, nil
+}
+
+func validateTerms(counterpartyName string, issueDate, dueDate int64) error {
+	if strings.TrimSpace(counterpartyName) == "" {
+		return errors.New("counterparty name is required")
+	}
+	if dueDate <= 0 {
+		return errors.New("due date is required")
+	}
+	if issueDate > 0 && dueDate < issueDate {
+		return errors.New("due date must not be before issue date")
+	}
+	return nil
+}
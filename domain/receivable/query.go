@@ -0,0 +1,33 @@
+package receivable
+
+import (
+	"time"
+)
+
+type GetReceivableByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserReceivablesQuery struct {
+	UserID string  `json:"userId" validate:"required"`
+	Status *Status `json:"status,omitempty"`
+	// AsOf is the date the status is derived at, now when zero
+	AsOf time.Time `json:"asOf"`
+}
+
+type GetOverdueQuery struct {
+	UserID string    `json:"userId" validate:"required"`
+	AsOf   time.Time `json:"asOf"`
+}
+
+type GetRepaymentsQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetAgingQuery struct {
+	UserID       string    `json:"userId" validate:"required"`
+	AsOf         time.Time `json:"asOf"`
+	BaseCurrency string    `json:"baseCurrency"`
+}
@@ -0,0 +1,112 @@
+package receivable
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+type Status string
+
+const (
+	Open          Status = "OPEN"
+	PartiallyPaid Status = "PARTIALLY_PAID"
+	Overdue       Status = "OVERDUE"
+	Paid          Status = "PAID"
+	WrittenOff    Status = "WRITTEN_OFF"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case Open, PartiallyPaid, Overdue, Paid, WrittenOff:
+		return true
+	default:
+		return false
+	}
+}
+
+// Receivable holds the terms of a RECEIVABLE asset: who owes the money, how much and
+// until when. Repaid and WrittenOff add up what has been settled so far.
+type Receivable struct {
+	ID                  uuid.UUID  `json:"id" db:"id"`
+	UserID              uuid.UUID  `json:"userId" db:"user_id"`
+	AssetID             uuid.UUID  `json:"assetId" db:"asset_id"`
+	CounterpartyName    string     `json:"counterpartyName" db:"counterparty_name"`
+	CounterpartyContact string     `json:"counterpartyContact" db:"counterparty_contact"`
+	Amount              float64    `json:"amount" db:"amount"`
+	Currency            string     `json:"currency" db:"currency"`
+	IssueDate           time.Time  `json:"issueDate" db:"issue_date"`
+	DueDate             time.Time  `json:"dueDate" db:"due_date"`
+	Repaid              float64    `json:"repaid" db:"repaid"`
+	WrittenOff          float64    `json:"writtenOff" db:"written_off"`
+	WrittenOffAt        *time.Time `json:"writtenOffAt,omitempty" db:"written_off_at"`
+	CreatedAt           time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt           time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func NewReceivable(command CreateReceivableCommand, currency string) *Receivable {
+	now := time.Now()
+	issueDate := now
+	if command.IssueDate > 0 {
+		issueDate = time.Unix(command.IssueDate, 0)
+	}
+	return &Receivable{
+		ID:                  uuid.New(),
+		UserID:              uuid.MustParse(command.UserID),
+		AssetID:             uuid.MustParse(command.AssetID),
+		CounterpartyName:    command.CounterpartyName,
+		CounterpartyContact: command.CounterpartyContact,
+		Amount:              command.Amount,
+		Currency:            currency,
+		IssueDate:           calendar.TruncateDay(issueDate),
+		DueDate:             calendar.TruncateDay(time.Unix(command.DueDate, 0)),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+}
+
+func (r *Receivable) Update(command UpdateReceivableCommand) {
+	r.CounterpartyName = command.CounterpartyName
+	r.CounterpartyContact = command.CounterpartyContact
+	r.Amount = command.Amount
+	if command.IssueDate > 0 {
+		r.IssueDate = calendar.TruncateDay(time.Unix(command.IssueDate, 0))
+	}
+	r.DueDate = calendar.TruncateDay(time.Unix(command.DueDate, 0))
+	r.UpdatedAt = time.Now()
+}
+
+// Outstanding is what is still owed after repayments and write-offs.
+func (r *Receivable) Outstanding() float64 {
+	return math.Max(0, r.Amount-r.Repaid-r.WrittenOff)
+}
+
+// StatusAt derives the status as of a date. A receivable becomes overdue the day after
+// its due date while anything is still outstanding.
+func (r *Receivable) StatusAt(asOf time.Time) Status {
+	switch {
+	case r.WrittenOffAt != nil:
+		return WrittenOff
+	case r.Outstanding() <= 0:
+		return Paid
+	case r.DaysOverdue(asOf) > 0:
+		return Overdue
+	case r.Repaid > 0:
+		return PartiallyPaid
+	default:
+		return Open
+	}
+}
+
+// DaysOverdue counts the whole days since the due date, negative before it.
+func (r *Receivable) DaysOverdue(asOf time.Time) int {
+	return calendar.Days(r.DueDate, asOf)
+}
+
+// IsSettled reports whether nothing more is expected on the receivable.
+func (r *Receivable) IsSettled() bool {
+	return r.WrittenOffAt != nil || r.Outstanding() <= 0
+}
@@ -0,0 +1,34 @@
+package receivable
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Repayment is money received back on a receivable, optionally deposited into another
+// asset such as a cash account.
+type Repayment struct {
+	ID            uuid.UUID  `json:"id" db:"id"`
+	ReceivableID  uuid.UUID  `json:"receivableId" db:"receivable_id"`
+	UserID        uuid.UUID  `json:"userId" db:"user_id"`
+	RepaidAt      time.Time  `json:"repaidAt" db:"repaid_at"`
+	Amount        float64    `json:"amount" db:"amount"`
+	ToAssetID     *uuid.UUID `json:"toAssetId,omitempty" db:"to_asset_id"`
+	TransactionID *uuid.UUID `json:"transactionId,omitempty" db:"transaction_id"`
+	Notes         string     `json:"notes" db:"notes"`
+	CreatedAt     time.Time  `json:"createdAt" db:"created_at"`
+}
+
+func NewRepayment(r *Receivable, command RecordRepaymentCommand, toAssetID *uuid.UUID) *Repayment {
+	return &Repayment{
+		ID:           uuid.New(),
+		ReceivableID: r.ID,
+		UserID:       r.UserID,
+		RepaidAt:     time.Unix(command.RepaidAt, 0),
+		Amount:       command.Amount,
+		ToAssetID:    toAssetID,
+		Notes:        command.Notes,
+		CreatedAt:    time.Now(),
+	}
+}
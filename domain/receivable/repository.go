@@ -0,0 +1,26 @@
+package receivable
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, r *Receivable) error
+	Update(ctx context.Context, r *Receivable) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Receivable, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) (*Receivable, error)
+	// GetByUserID returns the receivables of a user, soonest due first.
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Receivable, error)
+	GetRepayments(ctx context.Context, receivableID uuid.UUID) ([]*Repayment, error)
+	// RecordRepayment posts the repayment to the ledger, saves it and adds it to what
+	// was repaid in one database transaction. It fails when the receivable was written
+	// off or the amount exceeds what is still outstanding.
+	RecordRepayment(ctx context.Context, r *Receivable, repayment *Repayment) error
+	// WriteOff withdraws what is still outstanding from the receivable asset and marks
+	// the receivable written off as of date.
+	WriteOff(ctx context.Context, r *Receivable, date time.Time, notes string) error
+}
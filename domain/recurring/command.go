@@ -0,0 +1,56 @@
+package recurring
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type CreateRuleCommand struct {
+	UserID          string                      `json:"userId" validate:"required"`
+	AccountID       string                      `json:"accountId" validate:"required"`
+	DefinitionID    string                      `json:"definitionId" validate:"required"`
+	Name            string                      `json:"name" validate:"required"`
+	AssetType       asset.AssetType             `json:"assetType" validate:"required"`
+	TransactionType transaction.TransactionType `json:"transactionType" validate:"required"`
+	Amount          float64                     `json:"amount" validate:"required"`
+	Price           float64                     `json:"price"`
+	Currency        string                      `json:"currency"`
+	Frequency       Frequency                   `json:"frequency" validate:"required"`
+	Interval        int                         `json:"interval"`
+	DayOfMonth      int                         `json:"dayOfMonth"`
+	DayOfWeek       int                         `json:"dayOfWeek"`
+	MonthOfYear     int                         `json:"monthOfYear"`
+	StartDate       int64                       `json:"startDate" validate:"required"`
+	EndDate         *int64                      `json:"endDate,omitempty"`
+	Notes           string                      `json:"notes"`
+}
+
+type UpdateRuleCommand struct {
+	ID          string    `json:"id" validate:"required"`
+	UserID      string    `json:"userId" validate:"required"`
+	Name        string    `json:"name" validate:"required"`
+	Amount      float64   `json:"amount" validate:"required"`
+	Price       float64   `json:"price"`
+	Currency    string    `json:"currency"`
+	Frequency   Frequency `json:"frequency" validate:"required"`
+	Interval    int       `json:"interval"`
+	DayOfMonth  int       `json:"dayOfMonth"`
+	DayOfWeek   int       `json:"dayOfWeek"`
+	MonthOfYear int       `json:"monthOfYear"`
+	EndDate     *int64    `json:"endDate,omitempty"`
+	Active      bool      `json:"active"`
+	Notes       string    `json:"notes"`
+}
+
+type DeleteRuleCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// MaterialiseDueCommand posts every occurrence that is due by Now, including the
+// ones missed while the service was down.
+type MaterialiseDueCommand struct {
+	Now time.Time `json:"now"`
+}
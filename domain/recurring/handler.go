@@ -0,0 +1,283 @@
+package recurring
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/definition"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type Handler struct {
+	repo           Repository
+	accountRepo    account.Repository
+	definitionRepo definition.Repository
+}
+
+func NewHandler(repo Repository, accountRepo account.Repository, definitionRepo definition.Repository) *Handler {
+	return &Handler{
+		repo:           repo,
+		accountRepo:    accountRepo,
+		definitionRepo: definitionRepo,
+	}
+}
+
+func (h *Handler) HandleCreateRuleCommand(ctx context.Context, command CreateRuleCommand) (*Rule, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	accountID, err := uuid.Parse(command.AccountID)
+	if err != nil {
+		return nil, errors.New("invalid account ID")
+	}
+	definitionID, err := uuid.Parse(command.DefinitionID)
+	if err != nil {
+		return nil, errors.New("invalid definition ID")
+	}
+	if strings.TrimSpace(command.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if command.AssetType == "" {
+		command.AssetType = asset.Cash
+	}
+	if !command.AssetType.IsValid() {
+		return nil, errors.New("invalid asset type")
+	}
+	if !isValidTransactionType(command.TransactionType) {
+		return nil, errors.New("invalid transaction type")
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.StartDate <= 0 {
+		return nil, errors.New("start date is required")
+	}
+	if command.EndDate != nil && *command.EndDate < command.StartDate {
+		return nil, errors.New("end date must be after start date")
+	}
+	if err := validateSchedule(command.Frequency, command.Interval, command.DayOfMonth, command.DayOfWeek, command.MonthOfYear); err != nil {
+		return nil, err
+	}
+
+	existingAccount, err := h.accountRepo.GetByID(ctx, accountID)
+	if err != nil {
+		return nil, errors.New("account not found")
+	}
+	if existingAccount.UserID != userID {
+		return nil, errors.New("unauthorized: account does not belong to user")
+	}
+	if _, err := h.definitionRepo.GetByID(ctx, definitionID); err != nil {
+		return nil, errors.New("definition not found")
+	}
+
+	rule := NewRule(command)
+	if err := h.repo.Create(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (h *Handler) HandleUpdateRuleCommand(ctx context.Context, command UpdateRuleCommand) (*Rule, error) {
+	rule, err := h.ownedRule(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if strings.TrimSpace(command.Name) == "" {
+		return nil, errors.New("name is required")
+	}
+	if command.Amount <= 0 {
+		return nil, errors.New("amount must be greater than zero")
+	}
+	if command.EndDate != nil && *command.EndDate < rule.StartDate.Unix() {
+		return nil, errors.New("end date must be after start date")
+	}
+	if err := validateSchedule(command.Frequency, command.Interval, command.DayOfMonth, command.DayOfWeek, command.MonthOfYear); err != nil {
+		return nil, err
+	}
+
+	rule.Update(command)
+	if err := h.repo.Update(ctx, rule); err != nil {
+		return nil, err
+	}
+	return rule, nil
+}
+
+func (h *Handler) HandleDeleteRuleCommand(ctx context.Context, command DeleteRuleCommand) error {
+	rule, err := h.ownedRule(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, rule.ID)
+}
+
+func (h *Handler) HandleGetRuleByIDQuery(ctx context.Context, query GetRuleByIDQuery) (*Rule, error) {
+	return h.ownedRule(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserRulesQuery(ctx context.Context, query GetUserRulesQuery) ([]*Rule, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleGetOccurrencesQuery(ctx context.Context, query GetOccurrencesQuery) ([]*Occurrence, error) {
+	rule, err := h.ownedRule(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return h.repo.GetOccurrences(ctx, rule.ID)
+}
+
+func (h *Handler) HandlePreviewQuery(ctx context.Context, query PreviewQuery) ([]*UpcomingOccurrence, error) {
+	if query.Until.IsZero() {
+		query.Until = time.Now().AddDate(0, 3, 0)
+	}
+	if query.Count <= 0 || query.Count > 1000 {
+		query.Count = 100
+	}
+
+	var rules []*Rule
+	if query.RuleID != nil {
+		rule, err := h.ownedRule(ctx, *query.RuleID, query.UserID)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	} else {
+		userID, err := uuid.Parse(query.UserID)
+		if err != nil {
+			return nil, errors.New("invalid user ID")
+		}
+		rules, err = h.repo.GetByUserID(ctx, userID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var upcoming []*UpcomingOccurrence
+	for _, rule := range rules {
+		if !rule.Active {
+			continue
+		}
+		for _, date := range rule.Upcoming(query.Count, query.Until) {
+			upcoming = append(upcoming, &UpcomingOccurrence{
+				RuleID:          rule.ID,
+				Name:            rule.Name,
+				Date:            date,
+				TransactionType: rule.TransactionType,
+				Amount:          rule.Amount,
+			})
+		}
+	}
+
+	sort.SliceStable(upcoming, func(i, j int) bool {
+		return upcoming[i].Date.Before(upcoming[j].Date)
+	})
+	if len(upcoming) > query.Count {
+		upcoming = upcoming[:query.Count]
+	}
+	return upcoming, nil
+}
+
+// HandleMaterialiseDueCommand posts every due occurrence, oldest first per rule, and
+// returns how many were handled. A rule that fails is left for the next run.
+func (h *Handler) HandleMaterialiseDueCommand(ctx context.Context, command MaterialiseDueCommand) (int, error) {
+	if command.Now.IsZero() {
+		command.Now = time.Now()
+	}
+
+	rules, err := h.repo.GetDue(ctx, command.Now)
+	if err != nil {
+		return 0, err
+	}
+
+	var handled int
+	var errs []error
+	for _, rule := range rules {
+		for rule.NextRunAt != nil && !rule.NextRunAt.After(command.Now) {
+			if ctx.Err() != nil {
+				return handled, ctx.Err()
+			}
+
+			occurrence := *rule.NextRunAt
+			rule.LastRunAt = &occurrence
+			rule.NextRunAt = rule.Next(occurrence)
+			rule.UpdatedAt = time.Now()
+
+			if _, err := h.repo.Materialise(ctx, rule, occurrence); err != nil {
+				errs = append(errs, err)
+				break
+			}
+			handled++
+		}
+	}
+	return handled, errors.Join(errs...)
+}
+
+func (h *Handler) ownedRule(ctx context.Context, ruleIDValue, userIDValue string) (*Rule, error) {
+	ruleID, err := uuid.Parse(ruleIDValue)
+	if err != nil {
+		return nil, errors.New("invalid rule ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	rule, err := h.repo.GetByID(ctx, ruleID)
+	if err != nil {
+		return nil, errors.New("recurring rule not found")
+	}
+
+	if rule.UserID != userID {
+		return nil, errors.New("unauthorized: recurring rule does not belong to user")
+	}
+
+	return rule, nil
+}
+
+func validateSchedule(frequency Frequency, interval, dayOfMonth, dayOfWeek, monthOfYear int) error {
+	if interval < 0 {
+		return errors.New("interval must not be negative")
+	}
+	switch frequency {
+	case Weekly:
+		if dayOfWeek < 0 || dayOfWeek > 6 {
+			return errors.New("day of week must be between 0 (Sunday) and 6")
+		}
+	case Monthly:
+		if dayOfMonth < 1 || dayOfMonth > 31 {
+			return errors.New("day of month must be between 1 and 31")
+		}
+	case Yearly:
+		if monthOfYear < 1 || monthOfYear > 12 {
+			return errors.New("month of year must be between 1 and 12")
+		}
+		if dayOfMonth < 1 || dayOfMonth > 31 {
+			return errors.New("day of month must be between 1 and 31")
+		}
+	default:
+		return errors.New("invalid frequency")
+	}
+	return nil
+}
+
+func isValidTransactionType(t transaction.TransactionType) bool {
+	switch t {
+	case transaction.Buy, transaction.Sell, transaction.Deposit, transaction.Withdraw, transaction.Fee:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,27 @@
+package recurring
+
+import (
+	"time"
+)
+
+type GetRuleByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserRulesQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetOccurrencesQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type PreviewQuery struct {
+	UserID string `json:"userId" validate:"required"`
+	// RuleID limits the preview to one rule
+	RuleID *string   `json:"ruleId,omitempty"`
+	Until  time.Time `json:"until"`
+	Count  int       `json:"count"`
+}
@@ -0,0 +1,166 @@
+package recurring
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type Frequency string
+
+const (
+	Weekly  Frequency = "WEEKLY"
+	Monthly Frequency = "MONTHLY"
+	Yearly  Frequency = "YEARLY"
+)
+
+type OccurrenceStatus string
+
+const (
+	Posted  OccurrenceStatus = "POSTED"
+	Skipped OccurrenceStatus = "SKIPPED"
+)
+
+// Rule posts Amount of a definition into an account on a schedule: every Interval
+// weeks on DayOfWeek, every Interval months on DayOfMonth, or every Interval years
+// on MonthOfYear/DayOfMonth. Days past the end of a month fall on its last day.
+type Rule struct {
+	ID              uuid.UUID                   `json:"id" db:"id"`
+	UserID          uuid.UUID                   `json:"userId" db:"user_id"`
+	AccountID       uuid.UUID                   `json:"accountId" db:"account_id"`
+	DefinitionID    uuid.UUID                   `json:"definitionId" db:"definition_id"`
+	Name            string                      `json:"name" db:"name"`
+	AssetType       asset.AssetType             `json:"assetType" db:"asset_type"`
+	TransactionType transaction.TransactionType `json:"transactionType" db:"transaction_type"`
+	Amount          float64                     `json:"amount" db:"amount"`
+	Price           float64                     `json:"price" db:"price"`
+	Currency        string                      `json:"currency" db:"currency"`
+	Frequency       Frequency                   `json:"frequency" db:"frequency"`
+	Interval        int                         `json:"interval" db:"frequency_interval"`
+	DayOfMonth      int                         `json:"dayOfMonth" db:"day_of_month"`
+	DayOfWeek       int                         `json:"dayOfWeek" db:"day_of_week"`
+	MonthOfYear     int                         `json:"monthOfYear" db:"month_of_year"`
+	StartDate       time.Time                   `json:"startDate" db:"start_date"`
+	EndDate         *time.Time                  `json:"endDate,omitempty" db:"end_date"`
+	NextRunAt       *time.Time                  `json:"nextRunAt,omitempty" db:"next_run_at"`
+	LastRunAt       *time.Time                  `json:"lastRunAt,omitempty" db:"last_run_at"`
+	Active          bool                        `json:"active" db:"active"`
+	Notes           string                      `json:"notes" db:"notes"`
+	CreatedAt       time.Time                   `json:"createdAt" db:"created_at"`
+	UpdatedAt       time.Time                   `json:"updatedAt" db:"updated_at"`
+}
+
+func NewRule(command CreateRuleCommand) *Rule {
+	now := time.Now()
+	rule := &Rule{
+		ID:              uuid.New(),
+		UserID:          uuid.MustParse(command.UserID),
+		AccountID:       uuid.MustParse(command.AccountID),
+		DefinitionID:    uuid.MustParse(command.DefinitionID),
+		Name:            command.Name,
+		AssetType:       command.AssetType,
+		TransactionType: command.TransactionType,
+		Amount:          command.Amount,
+		Price:           command.Price,
+		Currency:        command.Currency,
+		Frequency:       command.Frequency,
+		Interval:        command.Interval,
+		DayOfMonth:      command.DayOfMonth,
+		DayOfWeek:       command.DayOfWeek,
+		MonthOfYear:     command.MonthOfYear,
+		StartDate:       time.Unix(command.StartDate, 0),
+		Active:          true,
+		Notes:           command.Notes,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+	if rule.Interval == 0 {
+		rule.Interval = 1
+	}
+	if command.EndDate != nil {
+		endDate := time.Unix(*command.EndDate, 0)
+		rule.EndDate = &endDate
+	}
+	rule.NextRunAt = rule.first()
+	return rule
+}
+
+func (r *Rule) Update(command UpdateRuleCommand) {
+	wasActive := r.Active
+
+	r.Name = command.Name
+	r.Amount = command.Amount
+	r.Price = command.Price
+	r.Currency = command.Currency
+	r.Frequency = command.Frequency
+	r.Interval = command.Interval
+	if r.Interval == 0 {
+		r.Interval = 1
+	}
+	r.DayOfMonth = command.DayOfMonth
+	r.DayOfWeek = command.DayOfWeek
+	r.MonthOfYear = command.MonthOfYear
+	r.EndDate = nil
+	if command.EndDate != nil {
+		endDate := time.Unix(*command.EndDate, 0)
+		r.EndDate = &endDate
+	}
+	r.Active = command.Active
+	r.Notes = command.Notes
+	r.UpdatedAt = time.Now()
+
+	// The schedule may have changed, so continue after the last handled occurrence.
+	// A paused rule that is resumed does not catch up on what it skipped while paused.
+	switch {
+	case !wasActive && r.Active:
+		r.NextRunAt = r.Next(time.Now())
+	case r.LastRunAt != nil:
+		r.NextRunAt = r.Next(*r.LastRunAt)
+	default:
+		r.NextRunAt = r.first()
+	}
+}
+
+// Transaction builds the ledger entry of one occurrence for the asset the rule posts into.
+func (r *Rule) Transaction(assetID uuid.UUID, occurrence time.Time) *transaction.Transaction {
+	notes := r.Name
+	if r.Notes != "" {
+		notes = r.Name + ": " + r.Notes
+	}
+
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		UserID:          r.UserID,
+		AssetID:         assetID,
+		Type:            r.TransactionType,
+		Quantity:        r.Amount,
+		Price:           r.Price,
+		Currency:        r.Currency,
+		Notes:           notes,
+		TransactionDate: occurrence,
+		CreatedAt:       time.Now(),
+		UpdatedAt:       time.Now(),
+	}
+}
+
+type Occurrence struct {
+	ID             uuid.UUID        `json:"id" db:"id"`
+	RuleID         uuid.UUID        `json:"ruleId" db:"rule_id"`
+	OccurrenceDate time.Time        `json:"occurrenceDate" db:"occurrence_date"`
+	TransactionID  *uuid.UUID       `json:"transactionId,omitempty" db:"transaction_id"`
+	Status         OccurrenceStatus `json:"status" db:"status"`
+	Error          string           `json:"error" db:"error"`
+	CreatedAt      time.Time        `json:"createdAt" db:"created_at"`
+}
+
+// UpcomingOccurrence is a scheduled posting that has not happened yet.
+type UpcomingOccurrence struct {
+	RuleID          uuid.UUID                   `json:"ruleId"`
+	Name            string                      `json:"name"`
+	Date            time.Time                   `json:"date"`
+	TransactionType transaction.TransactionType `json:"transactionType"`
+	Amount          float64                     `json:"amount"`
+}
@@ -0,0 +1,23 @@
+package recurring
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, rule *Rule) error
+	Update(ctx context.Context, rule *Rule) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Rule, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Rule, error)
+	GetOccurrences(ctx context.Context, ruleID uuid.UUID) ([]*Occurrence, error)
+	// GetDue returns the active rules whose next occurrence is at or before now.
+	GetDue(ctx context.Context, now time.Time) ([]*Rule, error)
+	// Materialise posts the occurrence to the ledger and saves the advanced schedule of the
+	// rule in one database transaction. An occurrence that was already handled is not posted
+	// again, and one the ledger rejects is recorded as skipped.
+	Materialise(ctx context.Context, rule *Rule, occurrence time.Time) (*Occurrence, error)
+}
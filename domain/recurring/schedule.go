@@ -0,0 +1,62 @@
+package recurring
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// maxOccurrences bounds schedule walks so a malformed rule can never loop forever
+const maxOccurrences = 100000
+
+// Next returns the first occurrence strictly after the given time, or nil once the
+// schedule has ended.
+func (r *Rule) Next(after time.Time) *time.Time {
+	for k := 0; k < maxOccurrences; k++ {
+		occurrence := r.nth(k)
+		if r.EndDate != nil && occurrence.After(*r.EndDate) {
+			return nil
+		}
+		if occurrence.After(after) {
+			return &occurrence
+		}
+	}
+	return nil
+}
+
+// Upcoming lists at most count occurrences from the next pending one up to until.
+func (r *Rule) Upcoming(count int, until time.Time) []time.Time {
+	var dates []time.Time
+	next := r.NextRunAt
+	for next != nil && len(dates) < count && !next.After(until) {
+		dates = append(dates, *next)
+		next = r.Next(*next)
+	}
+	return dates
+}
+
+func (r *Rule) first() *time.Time {
+	return r.Next(calendar.TruncateDay(r.StartDate).Add(-time.Nanosecond))
+}
+
+// nth returns the k-th occurrence of the schedule counting from zero.
+func (r *Rule) nth(k int) time.Time {
+	start := calendar.TruncateDay(r.StartDate)
+	switch r.Frequency {
+	case Weekly:
+		offset := (r.DayOfWeek - int(start.Weekday()) + 7) % 7
+		return start.AddDate(0, 0, offset+7*r.Interval*k)
+	case Yearly:
+		shift := 0
+		if calendar.MonthDay(start.Year(), time.Month(r.MonthOfYear), r.DayOfMonth).Before(start) {
+			shift = 1
+		}
+		return calendar.MonthDay(start.Year()+shift+k*r.Interval, time.Month(r.MonthOfYear), r.DayOfMonth)
+	default:
+		shift := 0
+		if calendar.MonthDay(start.Year(), start.Month(), r.DayOfMonth).Before(start) {
+			shift = 1
+		}
+		return calendar.MonthDay(start.Year(), start.Month()+time.Month(shift+k*r.Interval), r.DayOfMonth)
+	}
+}
@@ -0,0 +1,79 @@
+package recurring
+
+import (
+	"testing"
+	"time"
+)
+
+func day(year int, month time.Month, d int) time.Time {
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
+
+func ptr(t time.Time) *time.Time {
+	return &t
+}
+
+func TestRuleNext(t *testing.T) {
+	// A Monday
+	start := day(2024, 1, 15)
+	end := day(2024, 3, 1)
+
+	tests := []struct {
+		name  string
+		rule  Rule
+		after time.Time
+		want  *time.Time
+	}{
+		{"monthly on the start month", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 20, StartDate: start}, start, ptr(day(2024, 1, 20))},
+		{"monthly day already passed in the start month", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 10, StartDate: start}, start, ptr(day(2024, 2, 10))},
+		{"monthly day clamped to a short month", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 31, StartDate: start}, day(2024, 1, 31), ptr(day(2024, 2, 29))},
+		{"monthly clamping does not drift", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 31, StartDate: start}, day(2024, 2, 29), ptr(day(2024, 3, 31))},
+		{"quarterly", Rule{Frequency: Monthly, Interval: 3, DayOfMonth: 15, StartDate: start}, start, ptr(day(2024, 4, 15))},
+		{"weekly on a later weekday", Rule{Frequency: Weekly, Interval: 1, DayOfWeek: int(time.Friday), StartDate: start}, start, ptr(day(2024, 1, 19))},
+		{"weekly on the start weekday", Rule{Frequency: Weekly, Interval: 1, DayOfWeek: int(time.Monday), StartDate: start}, start, ptr(day(2024, 1, 22))},
+		{"fortnightly", Rule{Frequency: Weekly, Interval: 2, DayOfWeek: int(time.Friday), StartDate: start}, day(2024, 1, 19), ptr(day(2024, 2, 2))},
+		{"yearly", Rule{Frequency: Yearly, Interval: 1, MonthOfYear: 6, DayOfMonth: 1, StartDate: start}, start, ptr(day(2024, 6, 1))},
+		{"yearly leap day in a common year", Rule{Frequency: Yearly, Interval: 1, MonthOfYear: 2, DayOfMonth: 29, StartDate: day(2024, 3, 1)}, day(2024, 3, 1), ptr(day(2025, 2, 28))},
+		{"ended", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 15, StartDate: start, EndDate: &end}, day(2024, 2, 15), nil},
+		{"last occurrence on the end date", Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 1, StartDate: start, EndDate: &end}, day(2024, 2, 1), ptr(day(2024, 3, 1))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.rule.Next(tt.after)
+			switch {
+			case got == nil && tt.want == nil:
+			case got == nil || tt.want == nil || !got.Equal(*tt.want):
+				t.Errorf("Next(%s) = %v, want %v", tt.after.Format(time.DateOnly), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRuleUpcoming(t *testing.T) {
+	next := day(2024, 1, 31)
+	rule := Rule{Frequency: Monthly, Interval: 1, DayOfMonth: 31, StartDate: day(2024, 1, 1), NextRunAt: &next}
+
+	tests := []struct {
+		name  string
+		count int
+		until time.Time
+		want  []time.Time
+	}{
+		{"limited by count", 2, day(2025, 1, 1), []time.Time{day(2024, 1, 31), day(2024, 2, 29)}},
+		{"limited by date", 10, day(2024, 4, 1), []time.Time{day(2024, 1, 31), day(2024, 2, 29), day(2024, 3, 31)}},
+		{"nothing before the next run", 10, day(2024, 1, 30), nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rule.Upcoming(tt.count, tt.until)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Upcoming returned %v, want %v", got, tt.want)
+			}
+			for i := range got {
+				if !got[i].Equal(tt.want[i]) {
+					t.Errorf("occurrence %d = %v, want %v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package termdeposit
+
+import (
+	"math"
+	"time"
+
+	"siyahsensei/wallet-service/domain/calendar"
+)
+
+// daysPerYear is the day count basis, actual days over a 365-day year
+const daysPerYear = 365.0
+
+// Accrual reports the interest a deposit has earned up to AsOf and what it pays at
+// maturity. Interest figures are gross, withholding tax is taken from them.
+type Accrual struct {
+	AsOf                   time.Time `json:"asOf"`
+	ElapsedDays            int       `json:"elapsedDays"`
+	TermDays               int       `json:"termDays"`
+	DaysToMaturity         int       `json:"daysToMaturity"`
+	Principal              float64   `json:"principal"`
+	AccruedInterest        float64   `json:"accruedInterest"`
+	AccruedWithholdingTax  float64   `json:"accruedWithholdingTax"`
+	AccruedNetInterest     float64   `json:"accruedNetInterest"`
+	CurrentValue           float64   `json:"currentValue"`
+	ExpectedInterest       float64   `json:"expectedInterest"`
+	ExpectedWithholdingTax float64   `json:"expectedWithholdingTax"`
+	ExpectedNetInterest    float64   `json:"expectedNetInterest"`
+	ExpectedPayout         float64   `json:"expectedPayout"`
+	EffectiveAnnualRate    float64   `json:"effectiveAnnualRate"`
+}
+
+// AccrualAt reports the accrued and expected interest of the current term as of the
+// given time. Nothing accrues before the start date or after the maturity date.
+func (d *TermDeposit) AccrualAt(asOf time.Time) *Accrual {
+	termDays := calendar.Days(d.StartDate, d.MaturityDate)
+	elapsed := calendar.Days(d.StartDate, asOf)
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	if elapsed > termDays {
+		elapsed = termDays
+	}
+
+	a := &Accrual{
+		AsOf:             asOf,
+		ElapsedDays:      elapsed,
+		TermDays:         termDays,
+		DaysToMaturity:   termDays - elapsed,
+		Principal:        d.Principal,
+		AccruedInterest:  d.interest(elapsed),
+		ExpectedInterest: d.interest(termDays),
+	}
+	a.AccruedWithholdingTax = a.AccruedInterest * d.WithholdingTaxRate / 100
+	a.AccruedNetInterest = a.AccruedInterest - a.AccruedWithholdingTax
+	a.CurrentValue = d.Principal + a.AccruedNetInterest
+	a.ExpectedWithholdingTax = a.ExpectedInterest * d.WithholdingTaxRate / 100
+	a.ExpectedNetInterest = a.ExpectedInterest - a.ExpectedWithholdingTax
+	a.ExpectedPayout = d.Principal + a.ExpectedNetInterest
+	a.EffectiveAnnualRate = d.effectiveAnnualRate()
+	return a
+}
+
+// interest is the gross interest the principal earns over the given number of days.
+func (d *TermDeposit) interest(elapsedDays int) float64 {
+	rate := d.AnnualRate / 100
+	years := float64(elapsedDays) / daysPerYear
+	n := d.Compounding.periodsPerYear()
+	if n == 0 {
+		return d.Principal * rate * years
+	}
+	return d.Principal * (math.Pow(1+rate/n, n*years) - 1)
+}
+
+func (d *TermDeposit) effectiveAnnualRate() float64 {
+	rate := d.AnnualRate / 100
+	n := d.Compounding.periodsPerYear()
+	if n == 0 {
+		return d.AnnualRate
+	}
+	return (math.Pow(1+rate/n, n) - 1) * 100
+}
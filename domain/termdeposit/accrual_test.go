@@ -0,0 +1,152 @@
+package termdeposit
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func deposit(compounding Compounding) *TermDeposit {
+	return &TermDeposit{
+		Principal:          10000,
+		Currency:           "USD",
+		AnnualRate:         10,
+		Compounding:        compounding,
+		StartDate:          time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		MaturityDate:       time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		WithholdingTaxRate: 15,
+		Status:             Active,
+	}
+}
+
+func TestAccrualExpected(t *testing.T) {
+	tests := []struct {
+		compounding Compounding
+		interest    float64
+		rate        float64
+	}{
+		{Simple, 1000, 10},
+		{Annually, 1000, 10},
+		{Quarterly, 1038.1289, 10.381289},
+		{Monthly, 1047.1307, 10.471307},
+		{Daily, 1051.5578, 10.515578},
+	}
+	for _, tt := range tests {
+		t.Run(string(tt.compounding), func(t *testing.T) {
+			d := deposit(tt.compounding)
+			a := d.AccrualAt(d.StartDate)
+
+			if a.TermDays != 365 {
+				t.Errorf("TermDays = %d, want 365", a.TermDays)
+			}
+			if math.Abs(a.ExpectedInterest-tt.interest) > 1e-4 {
+				t.Errorf("ExpectedInterest = %v, want %v", a.ExpectedInterest, tt.interest)
+			}
+			tax := tt.interest * 0.15
+			if math.Abs(a.ExpectedWithholdingTax-tax) > 1e-4 {
+				t.Errorf("ExpectedWithholdingTax = %v, want %v", a.ExpectedWithholdingTax, tax)
+			}
+			if payout := 10000 + tt.interest - tax; math.Abs(a.ExpectedPayout-payout) > 1e-4 {
+				t.Errorf("ExpectedPayout = %v, want %v", a.ExpectedPayout, payout)
+			}
+			if math.Abs(a.EffectiveAnnualRate-tt.rate) > 1e-6 {
+				t.Errorf("EffectiveAnnualRate = %v, want %v", a.EffectiveAnnualRate, tt.rate)
+			}
+		})
+	}
+}
+
+func TestAccrualAt(t *testing.T) {
+	tests := []struct {
+		name     string
+		asOf     time.Time
+		elapsed  int
+		interest float64
+	}{
+		{"before the start", time.Date(2022, 12, 1, 0, 0, 0, 0, time.UTC), 0, 0},
+		{"mid term", time.Date(2023, 7, 2, 15, 30, 0, 0, time.UTC), 182, 498.6301},
+		{"at maturity", time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 365, 1000},
+		{"after maturity", time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC), 365, 1000},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := deposit(Simple).AccrualAt(tt.asOf)
+
+			if a.ElapsedDays != tt.elapsed || a.DaysToMaturity != 365-tt.elapsed {
+				t.Errorf("elapsed %d and %d to maturity, want %d and %d", a.ElapsedDays, a.DaysToMaturity, tt.elapsed, 365-tt.elapsed)
+			}
+			if math.Abs(a.AccruedInterest-tt.interest) > 1e-4 {
+				t.Errorf("AccruedInterest = %v, want %v", a.AccruedInterest, tt.interest)
+			}
+			if net := tt.interest * 0.85; math.Abs(a.AccruedNetInterest-net) > 1e-4 {
+				t.Errorf("AccruedNetInterest = %v, want %v", a.AccruedNetInterest, net)
+			}
+			if value := 10000 + tt.interest*0.85; math.Abs(a.CurrentValue-value) > 1e-4 {
+				t.Errorf("CurrentValue = %v, want %v", a.CurrentValue, value)
+			}
+		})
+	}
+}
+
+func TestSettle(t *testing.T) {
+	tests := []struct {
+		name      string
+		action    MaturityAction
+		maturity  time.Time
+		status    Status
+		nextStart time.Time
+		nextEnd   time.Time
+	}{
+		{
+			name:      "rollover keeps a term of whole months",
+			action:    Rollover,
+			maturity:  time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			status:    Active,
+			nextStart: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			nextEnd:   time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "rollover keeps a term of days",
+			action:    Rollover,
+			maturity:  time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC),
+			status:    Active,
+			nextStart: time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC),
+			nextEnd:   time.Date(2023, 6, 28, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "hold matures the deposit",
+			action:   Hold,
+			maturity: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			status:   Matured,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := deposit(Simple)
+			d.MaturityAction = tt.action
+			d.MaturityDate = tt.maturity
+			expected := d.AccrualAt(d.MaturityDate)
+
+			s := d.Settle()
+
+			if s.Payout != expected.ExpectedPayout || !s.Date.Equal(tt.maturity) {
+				t.Errorf("settled %v on %v, want %v on %v", s.Payout, s.Date, expected.ExpectedPayout, tt.maturity)
+			}
+			if d.Status != tt.status {
+				t.Errorf("Status = %s, want %s", d.Status, tt.status)
+			}
+			if tt.action != Rollover {
+				if d.MaturedAt == nil {
+					t.Error("MaturedAt not set")
+				}
+				return
+			}
+			if !d.StartDate.Equal(tt.nextStart) || !d.MaturityDate.Equal(tt.nextEnd) {
+				t.Errorf("next term %v to %v, want %v to %v", d.StartDate, d.MaturityDate, tt.nextStart, tt.nextEnd)
+			}
+			if d.Principal != s.Payout || d.Rollovers != 1 {
+				t.Errorf("rolled over principal %v after %d rollovers, want %v after 1", d.Principal, d.Rollovers, s.Payout)
+			}
+		})
+	}
+}
@@ -0,0 +1,41 @@
+package termdeposit
+
+import (
+	"time"
+)
+
+type CreateTermDepositCommand struct {
+	UserID  string `json:"userId" validate:"required"`
+	AssetID string `json:"assetId" validate:"required"`
+	// Principal defaults to the current quantity of the asset
+	Principal          float64        `json:"principal"`
+	AnnualRate         float64        `json:"annualRate"`
+	Compounding        Compounding    `json:"compounding"`
+	StartDate          int64          `json:"startDate" validate:"required"`
+	MaturityDate       int64          `json:"maturityDate" validate:"required"`
+	WithholdingTaxRate float64        `json:"withholdingTaxRate"`
+	MaturityAction     MaturityAction `json:"maturityAction"`
+	PayoutAccountID    *string        `json:"payoutAccountId,omitempty"`
+}
+
+type UpdateTermDepositCommand struct {
+	ID                 string         `json:"id" validate:"required"`
+	UserID             string         `json:"userId" validate:"required"`
+	AnnualRate         float64        `json:"annualRate"`
+	Compounding        Compounding    `json:"compounding"`
+	MaturityDate       int64          `json:"maturityDate" validate:"required"`
+	WithholdingTaxRate float64        `json:"withholdingTaxRate"`
+	MaturityAction     MaturityAction `json:"maturityAction"`
+	PayoutAccountID    *string        `json:"payoutAccountId,omitempty"`
+}
+
+type DeleteTermDepositCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// ProcessMaturitiesCommand settles every term that has matured by Now, including
+// successive rolled over terms missed while the service was down.
+type ProcessMaturitiesCommand struct {
+	Now time.Time `json:"now"`
+}
@@ -0,0 +1,251 @@
+package termdeposit
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/definition"
+)
+
+type Handler struct {
+	repo           Repository
+	assetRepo      asset.Repository
+	accountRepo    account.Repository
+	definitionRepo definition.Repository
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository, accountRepo account.Repository, definitionRepo definition.Repository) *Handler {
+	return &Handler{
+		repo:           repo,
+		assetRepo:      assetRepo,
+		accountRepo:    accountRepo,
+		definitionRepo: definitionRepo,
+	}
+}
+
+func (h *Handler) HandleCreateTermDepositCommand(ctx context.Context, command CreateTermDepositCommand) (*TermDeposit, error) {
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+	if err := validateTerms(command.AnnualRate, command.Compounding, command.StartDate, command.MaturityDate,
+		command.WithholdingTaxRate, command.MaturityAction); err != nil {
+		return nil, err
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+	if existingAsset.Type != asset.TermDeposit {
+		return nil, errors.New("asset is not a term deposit")
+	}
+	if _, err := h.repo.GetByAssetID(ctx, assetID); err == nil {
+		return nil, errors.New("asset already has term deposit terms")
+	}
+
+	if command.Principal == 0 {
+		command.Principal = existingAsset.Quantity
+	}
+	if command.Principal <= 0 {
+		return nil, errors.New("principal must be greater than zero")
+	}
+	if err := h.checkPayoutAccount(ctx, userID, command.PayoutAccountID); err != nil {
+		return nil, err
+	}
+
+	existingDefinition, err := h.definitionRepo.GetByID(ctx, existingAsset.DefinitionID)
+	if err != nil {
+		return nil, errors.New("definition not found")
+	}
+
+	deposit := NewTermDeposit(command, existingDefinition.Abbreviation)
+	if err := h.repo.Create(ctx, deposit); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+func (h *Handler) HandleUpdateTermDepositCommand(ctx context.Context, command UpdateTermDepositCommand) (*TermDeposit, error) {
+	deposit, err := h.ownedTermDeposit(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if deposit.Status != Active {
+		return nil, errors.New("term deposit has already matured")
+	}
+	if err := validateTerms(command.AnnualRate, command.Compounding, deposit.StartDate.Unix(), command.MaturityDate,
+		command.WithholdingTaxRate, command.MaturityAction); err != nil {
+		return nil, err
+	}
+	if err := h.checkPayoutAccount(ctx, deposit.UserID, command.PayoutAccountID); err != nil {
+		return nil, err
+	}
+
+	deposit.Update(command)
+	if err := h.repo.Update(ctx, deposit); err != nil {
+		return nil, err
+	}
+	return deposit, nil
+}
+
+func (h *Handler) HandleDeleteTermDepositCommand(ctx context.Context, command DeleteTermDepositCommand) error {
+	deposit, err := h.ownedTermDeposit(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, deposit.ID)
+}
+
+func (h *Handler) HandleGetTermDepositByIDQuery(ctx context.Context, query GetTermDepositByIDQuery) (*TermDeposit, error) {
+	return h.ownedTermDeposit(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserTermDepositsQuery(ctx context.Context, query GetUserTermDepositsQuery) ([]*TermDeposit, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleGetAccrualQuery(ctx context.Context, query GetAccrualQuery) (*Accrual, error) {
+	deposit, err := h.ownedTermDeposit(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if query.AsOf.IsZero() {
+		query.AsOf = time.Now()
+	}
+	return deposit.AccrualAt(query.AsOf), nil
+}
+
+// HandleProcessMaturitiesCommand settles every matured term and returns the settlements
+// that were posted. A deposit that fails is left for the next run.
+func (h *Handler) HandleProcessMaturitiesCommand(ctx context.Context, command ProcessMaturitiesCommand) ([]*Settlement, error) {
+	if command.Now.IsZero() {
+		command.Now = time.Now()
+	}
+
+	deposits, err := h.repo.GetDue(ctx, command.Now)
+	if err != nil {
+		return nil, err
+	}
+
+	var settlements []*Settlement
+	var errs []error
+	for _, deposit := range deposits {
+		// A rollover starts the next term right away, which may itself have matured
+		for deposit.Status == Active && !deposit.MaturityDate.After(command.Now) {
+			if ctx.Err() != nil {
+				return settlements, ctx.Err()
+			}
+
+			settlement := deposit.Settle()
+			settled, err := h.repo.Settle(ctx, deposit, settlement)
+			if err != nil {
+				errs = append(errs, err)
+				break
+			}
+			if !settled {
+				break
+			}
+			settlements = append(settlements, settlement)
+		}
+	}
+	return settlements, errors.Join(errs...)
+}
+
+func (h *Handler) ownedTermDeposit(ctx context.Context, depositIDValue, userIDValue string) (*TermDeposit, error) {
+	depositID, err := uuid.Parse(depositIDValue)
+	if err != nil {
+		return nil, errors.New("invalid term deposit ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	deposit, err := h.repo.GetByID(ctx, depositID)
+	if err != nil {
+		return nil, errors.New("term deposit not found")
+	}
+
+	if deposit.UserID != userID {
+		return nil, errors.New("unauthorized: term deposit does not belong to user")
+	}
+
+	return deposit, nil
+}
+
+func (h *Handler) checkPayoutAccount(ctx context.Context, userID uuid.UUID, payoutAccountIDValue *string) error {
+	if payoutAccountIDValue == nil {
+		return nil
+	}
+	payoutAccountID, err := uuid.Parse(*payoutAccountIDValue)
+	if err != nil {
+		return errors.New("invalid payout account ID")
+	}
+	payoutAccount, err := h.accountRepo.GetByID(ctx, payoutAccountID)
+	if err != nil {
+		return errors.New("account not found")
+	}
+	if payoutAccount.UserID != userID {
+		return errors.New("unauthorized: account does not belong to user")
+	}
+	return nil
+}
+
+func validateTerms(annualRate float64, compounding Compounding, startDate, maturityDate int64,
+	withholdingTaxRate float64, maturityAction MaturityAction) error {
+	if annualRate < 0 {
+		return errors.New("annual rate must not be negative")
+	}
+	if compounding != "" && !isValidCompounding(compounding) {
+		return errors.New("invalid compounding")
+	}
+	if startDate <= 0 {
+		return errors.New("start date is required")
+	}
+	if maturityDate <= startDate {
+		return errors.New("maturity date must be after start date")
+	}
+	if withholdingTaxRate < 0 || withholdingTaxRate > 100 {
+		return errors.New("withholding tax rate must be between 0 and 100")
+	}
+	if maturityAction != "" && !isValidMaturityAction(maturityAction) {
+		return errors.New("invalid maturity action")
+	}
+	return nil
+}
+
+func isValidCompounding(c Compounding) bool {
+	switch c {
+	case Simple, Daily, Monthly, Quarterly, Annually:
+		return true
+	default:
+		return false
+	}
+}
+
+func isValidMaturityAction(a MaturityAction) bool {
+	switch a {
+	case Hold, Rollover, MoveToCash:
+		return true
+	default:
+		return false
+	}
+}
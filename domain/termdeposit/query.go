@@ -0,0 +1,20 @@
+package termdeposit
+
+import (
+	"time"
+)
+
+type GetTermDepositByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserTermDepositsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetAccrualQuery struct {
+	ID     string    `json:"id" validate:"required"`
+	UserID string    `json:"userId" validate:"required"`
+	AsOf   time.Time `json:"asOf"`
+}
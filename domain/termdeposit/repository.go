@@ -0,0 +1,23 @@
+package termdeposit
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, deposit *TermDeposit) error
+	Update(ctx context.Context, deposit *TermDeposit) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*TermDeposit, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) (*TermDeposit, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*TermDeposit, error)
+	// GetDue returns the active deposits that have matured at or before now.
+	GetDue(ctx context.Context, now time.Time) ([]*TermDeposit, error)
+	// Settle posts the interest and withholding tax of a matured term, carries out its
+	// maturity action and saves the settled deposit in one database transaction. It
+	// returns false without writing anything when the term was already settled.
+	Settle(ctx context.Context, deposit *TermDeposit, settlement *Settlement) (bool, error)
+}
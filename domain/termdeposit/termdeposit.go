@@ -0,0 +1,185 @@
+package termdeposit
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Compounding is how often interest is added to the principal. Simple deposits pay
+// interest on the principal only.
+type Compounding string
+
+const (
+	Simple    Compounding = "SIMPLE"
+	Daily     Compounding = "DAILY"
+	Monthly   Compounding = "MONTHLY"
+	Quarterly Compounding = "QUARTERLY"
+	Annually  Compounding = "ANNUALLY"
+)
+
+// periodsPerYear returns how many times a year interest compounds, zero for simple interest.
+func (c Compounding) periodsPerYear() float64 {
+	switch c {
+	case Daily:
+		return 365
+	case Monthly:
+		return 12
+	case Quarterly:
+		return 4
+	case Annually:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// MaturityAction is what happens to the deposit once it matures. In every case the
+// net interest is credited to the deposit first.
+type MaturityAction string
+
+const (
+	// Hold leaves the matured balance on the deposit.
+	Hold MaturityAction = "HOLD"
+	// Rollover starts a new term of the same length with the payout as principal.
+	Rollover MaturityAction = "ROLLOVER"
+	// MoveToCash transfers the payout to a cash asset in the payout account.
+	MoveToCash MaturityAction = "MOVE_TO_CASH"
+)
+
+type Status string
+
+const (
+	Active  Status = "ACTIVE"
+	Matured Status = "MATURED"
+)
+
+// TermDeposit holds the terms of a TERM_DEPOSIT asset. AnnualRate and
+// WithholdingTaxRate are percentages.
+type TermDeposit struct {
+	ID                 uuid.UUID      `json:"id" db:"id"`
+	UserID             uuid.UUID      `json:"userId" db:"user_id"`
+	AssetID            uuid.UUID      `json:"assetId" db:"asset_id"`
+	Principal          float64        `json:"principal" db:"principal"`
+	Currency           string         `json:"currency" db:"currency"`
+	AnnualRate         float64        `json:"annualRate" db:"annual_rate"`
+	Compounding        Compounding    `json:"compounding" db:"compounding"`
+	StartDate          time.Time      `json:"startDate" db:"start_date"`
+	MaturityDate       time.Time      `json:"maturityDate" db:"maturity_date"`
+	WithholdingTaxRate float64        `json:"withholdingTaxRate" db:"withholding_tax_rate"`
+	MaturityAction     MaturityAction `json:"maturityAction" db:"maturity_action"`
+	PayoutAccountID    *uuid.UUID     `json:"payoutAccountId,omitempty" db:"payout_account_id"`
+	Status             Status         `json:"status" db:"status"`
+	Rollovers          int            `json:"rollovers" db:"rollovers"`
+	MaturedAt          *time.Time     `json:"maturedAt,omitempty" db:"matured_at"`
+	CreatedAt          time.Time      `json:"createdAt" db:"created_at"`
+	UpdatedAt          time.Time      `json:"updatedAt" db:"updated_at"`
+}
+
+func NewTermDeposit(command CreateTermDepositCommand, currency string) *TermDeposit {
+	now := time.Now()
+	d := &TermDeposit{
+		ID:                 uuid.New(),
+		UserID:             uuid.MustParse(command.UserID),
+		AssetID:            uuid.MustParse(command.AssetID),
+		Principal:          command.Principal,
+		Currency:           currency,
+		AnnualRate:         command.AnnualRate,
+		Compounding:        command.Compounding,
+		StartDate:          time.Unix(command.StartDate, 0),
+		MaturityDate:       time.Unix(command.MaturityDate, 0),
+		WithholdingTaxRate: command.WithholdingTaxRate,
+		MaturityAction:     command.MaturityAction,
+		Status:             Active,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+	if d.Compounding == "" {
+		d.Compounding = Simple
+	}
+	if d.MaturityAction == "" {
+		d.MaturityAction = Hold
+	}
+	if command.PayoutAccountID != nil {
+		payoutAccountID := uuid.MustParse(*command.PayoutAccountID)
+		d.PayoutAccountID = &payoutAccountID
+	}
+	return d
+}
+
+func (d *TermDeposit) Update(command UpdateTermDepositCommand) {
+	d.AnnualRate = command.AnnualRate
+	d.Compounding = command.Compounding
+	if d.Compounding == "" {
+		d.Compounding = Simple
+	}
+	d.MaturityDate = time.Unix(command.MaturityDate, 0)
+	d.WithholdingTaxRate = command.WithholdingTaxRate
+	d.MaturityAction = command.MaturityAction
+	if d.MaturityAction == "" {
+		d.MaturityAction = Hold
+	}
+	d.PayoutAccountID = nil
+	if command.PayoutAccountID != nil {
+		payoutAccountID := uuid.MustParse(*command.PayoutAccountID)
+		d.PayoutAccountID = &payoutAccountID
+	}
+	d.UpdatedAt = time.Now()
+}
+
+// Settlement is what a deposit pays out at the end of a term.
+type Settlement struct {
+	TermDepositID  uuid.UUID      `json:"termDepositId"`
+	AssetID        uuid.UUID      `json:"assetId"`
+	Date           time.Time      `json:"date"`
+	Action         MaturityAction `json:"action"`
+	Principal      float64        `json:"principal"`
+	GrossInterest  float64        `json:"grossInterest"`
+	WithholdingTax float64        `json:"withholdingTax"`
+	NetInterest    float64        `json:"netInterest"`
+	Payout         float64        `json:"payout"`
+	Currency       string         `json:"currency"`
+}
+
+// Settle computes the payout of the current term and moves the deposit past it: a
+// rollover starts the next term with the payout as principal, any other action marks
+// the deposit as matured.
+func (d *TermDeposit) Settle() *Settlement {
+	accrual := d.AccrualAt(d.MaturityDate)
+	s := &Settlement{
+		TermDepositID:  d.ID,
+		AssetID:        d.AssetID,
+		Date:           d.MaturityDate,
+		Action:         d.MaturityAction,
+		Principal:      d.Principal,
+		GrossInterest:  accrual.ExpectedInterest,
+		WithholdingTax: accrual.ExpectedWithholdingTax,
+		NetInterest:    accrual.ExpectedNetInterest,
+		Payout:         accrual.ExpectedPayout,
+		Currency:       d.Currency,
+	}
+
+	now := time.Now()
+	if d.MaturityAction == Rollover {
+		start := d.MaturityDate
+		d.MaturityDate = d.nextMaturity()
+		d.StartDate = start
+		d.Principal = s.Payout
+		d.Rollovers++
+	} else {
+		d.Status = Matured
+		d.MaturedAt = &now
+	}
+	d.UpdatedAt = now
+	return s
+}
+
+// nextMaturity keeps the length of the term for a rollover. Terms of whole months
+// stay whole months so that a one-year deposit does not drift across leap years.
+func (d *TermDeposit) nextMaturity() time.Time {
+	months := (d.MaturityDate.Year()-d.StartDate.Year())*12 + int(d.MaturityDate.Month()-d.StartDate.Month())
+	if months > 0 && d.StartDate.AddDate(0, months, 0).Equal(d.MaturityDate) {
+		return d.MaturityDate.AddDate(0, months, 0)
+	}
+	return d.MaturityDate.Add(d.MaturityDate.Sub(d.StartDate))
+}
@@ -0,0 +1,17 @@
+package transaction
+
+type CreateTransactionCommand struct {
+	UserID          string          `json:"userId" validate:"required"`
+	AssetID         string          `json:"assetId" validate:"required"`
+	Type            TransactionType `json:"type" validate:"required"`
+	Quantity        float64         `json:"quantity" validate:"required"`
+	Price           float64         `json:"price"`
+	Currency        string          `json:"currency"`
+	Notes           string          `json:"notes"`
+	TransactionDate int64           `json:"transactionDate" validate:"required"`
+}
+
+type DeleteTransactionCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
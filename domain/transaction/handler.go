@@ -0,0 +1,139 @@
+package transaction
+
+import (
+	"context"
+	"errors"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+type Handler struct {
+	repo      Repository
+	assetRepo asset.Repository
+}
+
+func NewHandler(repo Repository, assetRepo asset.Repository) *Handler {
+	return &Handler{
+		repo:      repo,
+		assetRepo: assetRepo,
+	}
+}
+
+func (h *Handler) HandleCreateTransactionCommand(ctx context.Context, command CreateTransactionCommand) (*Transaction, error) {
+	if !isValidTransactionType(command.Type) {
+		return nil, errors.New("invalid transaction type")
+	}
+	if command.Type == Adjustment {
+		if command.Quantity == 0 {
+			return nil, errors.New("adjustment quantity must not be zero")
+		}
+	} else if command.Quantity <= 0 {
+		return nil, errors.New("quantity must be greater than zero")
+	}
+	if command.Price < 0 {
+		return nil, errors.New("price must not be negative")
+	}
+
+	assetID, err := uuid.Parse(command.AssetID)
+	if err != nil {
+		return nil, errors.New("invalid asset ID")
+	}
+
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	existingAsset, err := h.assetRepo.GetByID(ctx, assetID)
+	if err != nil {
+		return nil, errors.New("asset not found")
+	}
+
+	if existingAsset.UserID != userID {
+		return nil, errors.New("unauthorized: asset does not belong to user")
+	}
+
+	transaction := NewTransaction(command)
+	if err := h.repo.Create(ctx, transaction); err != nil {
+		return nil, err
+	}
+	return transaction, nil
+}
+
+func (h *Handler) HandleDeleteTransactionCommand(ctx context.Context, command DeleteTransactionCommand) error {
+	transactionID, err := uuid.Parse(command.ID)
+	if err != nil {
+		return errors.New("invalid transaction ID")
+	}
+
+	userID, err := uuid.Parse(command.UserID)
+	if err != nil {
+		return errors.New("invalid user ID")
+	}
+
+	existingTransaction, err := h.repo.GetByID(ctx, transactionID)
+	if err != nil {
+		return errors.New("transaction not found")
+	}
+
+	if existingTransaction.UserID != userID {
+		return errors.New("unauthorized: transaction does not belong to user")
+	}
+
+	if err := h.repo.Delete(ctx, transactionID); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (h *Handler) HandleGetTransactionByIDQuery(ctx context.Context, query GetTransactionByIDQuery) (*Transaction, error) {
+	transactionID, err := uuid.Parse(query.ID)
+	if err != nil {
+		return nil, errors.New("invalid transaction ID")
+	}
+
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	transaction, err := h.repo.GetByID(ctx, transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if transaction.UserID != userID {
+		return nil, errors.New("unauthorized: transaction does not belong to user")
+	}
+
+	return transaction, nil
+}
+
+func (h *Handler) HandleFilterTransactionsQuery(ctx context.Context, query FilterTransactionsQuery) ([]*Transaction, error) {
+	if _, err := uuid.Parse(query.UserID); err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	if query.AssetID != nil {
+		if _, err := uuid.Parse(*query.AssetID); err != nil {
+			return nil, errors.New("invalid asset ID")
+		}
+	}
+
+	if query.Type != nil && !isValidTransactionType(*query.Type) {
+		return nil, errors.New("invalid transaction type")
+	}
+
+	return h.repo.Filter(ctx, query)
+}
+
+func isValidTransactionType(t TransactionType) bool {
+	switch t {
+	case Buy, Sell, Deposit, Withdraw, Fee, Adjustment:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,20 @@
+package transaction
+
+import (
+	"time"
+)
+
+type GetTransactionByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type FilterTransactionsQuery struct {
+	UserID  string           `json:"userId" validate:"required"`
+	AssetID *string          `json:"assetId,omitempty"`
+	Type    *TransactionType `json:"type,omitempty"`
+	From    *time.Time       `json:"from,omitempty"`
+	To      *time.Time       `json:"to,omitempty"`
+	Limit   int              `json:"limit,omitempty"`
+	Offset  int              `json:"offset,omitempty"`
+}
@@ -0,0 +1,15 @@
+package transaction
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, transaction *Transaction) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Transaction, error)
+	GetByAssetID(ctx context.Context, assetID uuid.UUID) ([]*Transaction, error)
+	Filter(ctx context.Context, query FilterTransactionsQuery) ([]*Transaction, error)
+}
@@ -0,0 +1,96 @@
+package transaction
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type TransactionType string
+
+const (
+	Buy        TransactionType = "BUY"
+	Sell       TransactionType = "SELL"
+	Deposit    TransactionType = "DEPOSIT"
+	Withdraw   TransactionType = "WITHDRAW"
+	Fee        TransactionType = "FEE"
+	Adjustment TransactionType = "ADJUSTMENT"
+)
+
+type Transaction struct {
+	ID              uuid.UUID       `json:"id" db:"id"`
+	UserID          uuid.UUID       `json:"userId" db:"user_id"`
+	AssetID         uuid.UUID       `json:"assetId" db:"asset_id"`
+	Type            TransactionType `json:"type" db:"type"`
+	Quantity        float64         `json:"quantity" db:"quantity"`
+	Price           float64         `json:"price" db:"price"`
+	Currency        string          `json:"currency" db:"currency"`
+	Notes           string          `json:"notes" db:"notes"`
+	TransferID      *uuid.UUID      `json:"transferId,omitempty" db:"transfer_id"`
+	TransactionDate time.Time       `json:"transactionDate" db:"transaction_date"`
+	CreatedAt       time.Time       `json:"createdAt" db:"created_at"`
+	UpdatedAt       time.Time       `json:"updatedAt" db:"updated_at"`
+}
+
+func NewTransaction(command CreateTransactionCommand) *Transaction {
+	now := time.Now()
+	return &Transaction{
+		ID:              uuid.New(),
+		UserID:          uuid.MustParse(command.UserID),
+		AssetID:         uuid.MustParse(command.AssetID),
+		Type:            command.Type,
+		Quantity:        command.Quantity,
+		Price:           command.Price,
+		Currency:        command.Currency,
+		Notes:           command.Notes,
+		TransactionDate: time.Unix(command.TransactionDate, 0),
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// NewOpeningTransaction records the quantity an asset was created with at its purchase price.
+func NewOpeningTransaction(userID, assetID uuid.UUID, quantity, price float64, currency string, date time.Time) *Transaction {
+	now := time.Now()
+	return &Transaction{
+		ID:              uuid.New(),
+		UserID:          userID,
+		AssetID:         assetID,
+		Type:            Deposit,
+		Quantity:        quantity,
+		Price:           price,
+		Currency:        currency,
+		Notes:           "Opening balance",
+		TransactionDate: date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// NewTransferLeg records one side of an account-to-account transfer.
+func NewTransferLeg(transferID, userID, assetID uuid.UUID, transactionType TransactionType, quantity float64, notes string, date time.Time) *Transaction {
+	now := time.Now()
+	return &Transaction{
+		ID:              uuid.New(),
+		UserID:          userID,
+		AssetID:         assetID,
+		Type:            transactionType,
+		Quantity:        quantity,
+		Notes:           notes,
+		TransferID:      &transferID,
+		TransactionDate: date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
+
+// Delta returns the signed effect of the transaction on the asset quantity.
+// Adjustments carry their own sign, every other type is stored as a positive quantity.
+func (t *Transaction) Delta() float64 {
+	switch t.Type {
+	case Sell, Withdraw, Fee:
+		return -t.Quantity
+	default:
+		return t.Quantity
+	}
+}
@@ -0,0 +1,25 @@
+package transaction
+
+import "testing"
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		transactionType TransactionType
+		quantity        float64
+		want            float64
+	}{
+		{Buy, 2, 2},
+		{Deposit, 2, 2},
+		{Sell, 2, -2},
+		{Withdraw, 2, -2},
+		{Fee, 2, -2},
+		{Adjustment, 2, 2},
+		{Adjustment, -2, -2},
+	}
+	for _, tt := range tests {
+		tx := &Transaction{Type: tt.transactionType, Quantity: tt.quantity}
+		if got := tx.Delta(); got != tt.want {
+			t.Errorf("%s of %v: Delta() = %v, want %v", tt.transactionType, tt.quantity, got, tt.want)
+		}
+	}
+}
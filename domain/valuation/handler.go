@@ -0,0 +1,179 @@
+package valuation
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/fx"
+	"siyahsensei/wallet-service/domain/price"
+)
+
+type Handler struct {
+	repo                Repository
+	priceRepo           price.Repository
+	fxService           *fx.Handler
+	defaultBaseCurrency string
+}
+
+func NewHandler(repo Repository, priceRepo price.Repository, fxService *fx.Handler, defaultBaseCurrency string) *Handler {
+	return &Handler{
+		repo:                repo,
+		priceRepo:           priceRepo,
+		fxService:           fxService,
+		defaultBaseCurrency: strings.ToUpper(defaultBaseCurrency),
+	}
+}
+
+func (h *Handler) HandleValuePortfolioQuery(ctx context.Context, query ValuePortfolioQuery) (*Portfolio, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	if query.At.IsZero() {
+		query.At = time.Now()
+	}
+
+	// Holdings are rebuilt from the ledger, so a past date values what was held that day
+	holdings, err := h.repo.GetHoldings(ctx, userID, query.At)
+	if err != nil {
+		return nil, err
+	}
+
+	return h.Value(ctx, holdings, query.BaseCurrency, query.At)
+}
+
+// Value prices the given holdings in the base currency as of at. Holdings that
+// cannot be priced are kept with a zero value and counted as unpriced.
+func (h *Handler) Value(ctx context.Context, holdings []*Holding, baseCurrency string, at time.Time) (*Portfolio, error) {
+	base := h.BaseCurrency(baseCurrency)
+	if at.IsZero() {
+		at = time.Now()
+	}
+
+	table, err := h.fxService.Table(ctx, at)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uuid.UUID]bool)
+	var definitionIDs []uuid.UUID
+	for _, holding := range holdings {
+		if !seen[holding.DefinitionID] {
+			seen[holding.DefinitionID] = true
+			definitionIDs = append(definitionIDs, holding.DefinitionID)
+		}
+	}
+
+	pricesByDefinition := make(map[uuid.UUID][]*price.Price)
+	if len(definitionIDs) > 0 {
+		prices, err := h.priceRepo.GetLatestForDefinitions(ctx, definitionIDs, at)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range prices {
+			pricesByDefinition[p.DefinitionID] = append(pricesByDefinition[p.DefinitionID], p)
+		}
+	}
+
+	portfolio := &Portfolio{
+		BaseCurrency: base,
+		At:           at,
+	}
+	for _, holding := range holdings {
+		v := value(holding, pricesByDefinition[holding.DefinitionID], table, base)
+		if !v.Priced {
+			portfolio.Unpriced++
+		}
+		if v.Liability {
+			portfolio.GrossLiabilities += v.Value
+		} else {
+			portfolio.GrossAssets += v.Value
+		}
+		portfolio.Total += v.NetValue()
+		portfolio.Holdings = append(portfolio.Holdings, v)
+	}
+	return portfolio, nil
+}
+
+// BaseCurrency normalises a requested base currency, falling back to the default.
+func (h *Handler) BaseCurrency(requested string) string {
+	if requested = strings.ToUpper(strings.TrimSpace(requested)); requested != "" {
+		return requested
+	}
+	return h.defaultBaseCurrency
+}
+
+func value(holding *Holding, prices []*price.Price, table *fx.Table, base string) *Valuation {
+	v := &Valuation{
+		Holding:     *holding,
+		Currency:    strings.ToUpper(holding.Symbol),
+		PriceSource: SourceNone,
+	}
+
+	apply := func(unit float64, currency string, source PriceSource) bool {
+		unitValue, ok := table.Convert(unit, currency, base)
+		if !ok {
+			return false
+		}
+		v.Price = unit
+		v.PriceCurrency = strings.ToUpper(currency)
+		v.PriceSource = source
+		v.UnitValue = unitValue
+		v.Value = holding.Quantity * unitValue
+		v.Priced = true
+		return true
+	}
+
+	if strings.EqualFold(holding.Symbol, base) {
+		apply(1, base, SourceBase)
+		return v
+	}
+
+	// A property is valued as a whole, so its value is spread over the quantity held
+	if holding.PropertyValue != nil && holding.PropertyCurrency != nil && holding.Quantity > 0 {
+		if apply(*holding.PropertyValue/holding.Quantity, *holding.PropertyCurrency, SourceAppraisal) {
+			v.Currency = strings.ToUpper(*holding.PropertyCurrency)
+			return v
+		}
+	}
+
+	// A stored quote in the base currency beats a fresher one that needs converting
+	for _, p := range orderPrices(prices, base) {
+		if apply(p.Price, p.QuoteCurrency, SourcePriceHistory) {
+			v.Currency = p.QuoteCurrency
+			return v
+		}
+	}
+
+	if table.Knows(holding.Symbol) && apply(1, holding.Symbol, SourceFX) {
+		return v
+	}
+
+	if holding.TradePrice != nil && holding.TradeCurrency != nil && *holding.TradePrice > 0 {
+		if apply(*holding.TradePrice, *holding.TradeCurrency, SourceTrade) {
+			v.Currency = strings.ToUpper(*holding.TradeCurrency)
+			return v
+		}
+	}
+
+	return v
+}
+
+func orderPrices(prices []*price.Price, base string) []*price.Price {
+	ordered := append([]*price.Price(nil), prices...)
+	sort.SliceStable(ordered, func(i, j int) bool {
+		iBase := strings.EqualFold(ordered[i].QuoteCurrency, base)
+		jBase := strings.EqualFold(ordered[j].QuoteCurrency, base)
+		if iBase != jBase {
+			return iBase
+		}
+		return ordered[i].PricedAt.After(ordered[j].PricedAt)
+	})
+	return ordered
+}
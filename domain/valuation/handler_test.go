@@ -0,0 +1,97 @@
+package valuation
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"siyahsensei/wallet-service/domain/fx"
+	"siyahsensei/wallet-service/domain/price"
+)
+
+func TestValue(t *testing.T) {
+	table := fx.NewTable([]*fx.Rate{
+		{BaseCurrency: "USD", QuoteCurrency: "TRY", Rate: 32},
+		{BaseCurrency: "EUR", QuoteCurrency: "USD", Rate: 1.1},
+	}, "USD")
+	amount := func(value float64) *float64 { return &value }
+	currency := func(code string) *string { return &code }
+	jan := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		holding Holding
+		prices  []*price.Price
+		source  PriceSource
+		value   float64
+		priced  bool
+	}{
+		{
+			name:    "the base currency itself",
+			holding: Holding{Symbol: "usd", Quantity: 100},
+			source:  SourceBase,
+			value:   100,
+			priced:  true,
+		},
+		{
+			name:    "a property is valued as a whole",
+			holding: Holding{Symbol: "FLAT", Quantity: 1, PropertyValue: amount(200000), PropertyCurrency: currency("EUR")},
+			source:  SourceAppraisal,
+			value:   220000,
+			priced:  true,
+		},
+		{
+			name:    "a quote in the base currency beats a fresher one",
+			holding: Holding{Symbol: "BTC", Quantity: 2},
+			prices: []*price.Price{
+				{QuoteCurrency: "EUR", Price: 60000, PricedAt: jan.AddDate(0, 0, 1)},
+				{QuoteCurrency: "USD", Price: 64000, PricedAt: jan},
+			},
+			source: SourcePriceHistory,
+			value:  128000,
+			priced: true,
+		},
+		{
+			name:    "a quote that cannot be converted is passed over",
+			holding: Holding{Symbol: "BTC", Quantity: 2},
+			prices: []*price.Price{
+				{QuoteCurrency: "XYZ", Price: 1, PricedAt: jan.AddDate(0, 0, 1)},
+				{QuoteCurrency: "EUR", Price: 60000, PricedAt: jan},
+			},
+			source: SourcePriceHistory,
+			value:  132000,
+			priced: true,
+		},
+		{
+			name:    "a currency is converted with the rates",
+			holding: Holding{Symbol: "TRY", Quantity: 3200},
+			source:  SourceFX,
+			value:   100,
+			priced:  true,
+		},
+		{
+			name:    "the last trade price as a last resort",
+			holding: Holding{Symbol: "ACME", Quantity: 10, TradePrice: amount(320), TradeCurrency: currency("try")},
+			source:  SourceTrade,
+			value:   100,
+			priced:  true,
+		},
+		{
+			name:    "nothing to price it with",
+			holding: Holding{Symbol: "ACME", Quantity: 10},
+			source:  SourceNone,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v := value(&tt.holding, tt.prices, table, "USD")
+
+			if v.PriceSource != tt.source || v.Priced != tt.priced {
+				t.Errorf("priced %v from %s, want %v from %s", v.Priced, v.PriceSource, tt.priced, tt.source)
+			}
+			if math.Abs(v.Value-tt.value) > 1e-6 {
+				t.Errorf("Value = %v, want %v", v.Value, tt.value)
+			}
+		})
+	}
+}
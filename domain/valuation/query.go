@@ -0,0 +1,11 @@
+package valuation
+
+import (
+	"time"
+)
+
+type ValuePortfolioQuery struct {
+	UserID       string    `json:"userId" validate:"required"`
+	BaseCurrency string    `json:"baseCurrency"`
+	At           time.Time `json:"at"`
+}
@@ -0,0 +1,13 @@
+package valuation
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	// GetHoldings returns the assets of the user as they stood at the given time.
+	GetHoldings(ctx context.Context, userID uuid.UUID, at time.Time) ([]*Holding, error)
+}
@@ -0,0 +1,78 @@
+package valuation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type PriceSource string
+
+const (
+	// SourceBase means the holding is the base currency itself
+	SourceBase PriceSource = "BASE"
+	// SourceFX means the holding is a currency converted with exchange rates
+	SourceFX PriceSource = "FX"
+	// SourcePriceHistory means the definition has a stored price
+	SourcePriceHistory PriceSource = "PRICE_HISTORY"
+	// SourceAppraisal means the property has an appraisal or a purchase price
+	SourceAppraisal PriceSource = "APPRAISAL"
+	// SourceTrade means the user's own last trade price was used
+	SourceTrade PriceSource = "TRADE"
+	// SourceNone means no price could be found
+	SourceNone PriceSource = "NONE"
+)
+
+// Holding is one asset row of a user together with what is needed to value it.
+type Holding struct {
+	AssetID       uuid.UUID `json:"assetId" db:"asset_id"`
+	AccountID     uuid.UUID `json:"accountId" db:"account_id"`
+	AccountName   string    `json:"accountName" db:"account_name"`
+	AccountType   string    `json:"accountType" db:"account_type"`
+	DefinitionID  uuid.UUID `json:"definitionId" db:"definition_id"`
+	AssetType     string    `json:"assetType" db:"asset_type"`
+	Symbol        string    `json:"symbol" db:"symbol"`
+	Name          string    `json:"name" db:"name"`
+	Quantity      float64   `json:"quantity" db:"quantity"`
+	TradePrice    *float64  `json:"tradePrice,omitempty" db:"trade_price"`
+	TradeCurrency *string   `json:"tradeCurrency,omitempty" db:"trade_currency"`
+	// PropertyValue is the latest appraisal of a property as a whole, or its purchase price
+	PropertyValue    *float64 `json:"propertyValue,omitempty" db:"property_value"`
+	PropertyCurrency *string  `json:"propertyCurrency,omitempty" db:"property_currency"`
+	// Liability marks an amount owed, such as a debt or a credit card balance
+	Liability bool `json:"liability" db:"-"`
+}
+
+// Valuation is a holding valued in a base currency.
+type Valuation struct {
+	Holding
+	// Currency is what the holding is denominated in
+	Currency      string      `json:"currency"`
+	Price         float64     `json:"price"`
+	PriceCurrency string      `json:"priceCurrency"`
+	PriceSource   PriceSource `json:"priceSource"`
+	// UnitValue is the value of one unit in the base currency
+	UnitValue float64 `json:"unitValue"`
+	// Value is the gross value in the base currency, positive for liabilities too
+	Value  float64 `json:"value"`
+	Priced bool    `json:"priced"`
+}
+
+// NetValue is what the holding adds to net worth: its value, or minus it for a liability.
+func (v *Valuation) NetValue() float64 {
+	if v.Liability {
+		return -v.Value
+	}
+	return v.Value
+}
+
+type Portfolio struct {
+	BaseCurrency     string    `json:"baseCurrency"`
+	At               time.Time `json:"at"`
+	GrossAssets      float64   `json:"grossAssets"`
+	GrossLiabilities float64   `json:"grossLiabilities"`
+	// Total is the net worth: gross assets minus gross liabilities
+	Total    float64      `json:"total"`
+	Unpriced int          `json:"unpriced"`
+	Holdings []*Valuation `json:"holdings"`
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"siyahsensei/wallet-service/domain/event"
+)
+
+type CreateSubscriptionCommand struct {
+	UserID     string   `json:"userId" validate:"required"`
+	URL        string   `json:"url" validate:"required"`
+	EventTypes []string `json:"eventTypes" validate:"required"`
+	// Secret signs the payloads; one is generated when empty
+	Secret      string `json:"secret"`
+	Description string `json:"description"`
+}
+
+type UpdateSubscriptionCommand struct {
+	ID         string   `json:"id" validate:"required"`
+	UserID     string   `json:"userId" validate:"required"`
+	URL        string   `json:"url" validate:"required"`
+	EventTypes []string `json:"eventTypes" validate:"required"`
+	// Secret replaces the signing secret when set
+	Secret      string `json:"secret"`
+	Description string `json:"description"`
+	Enabled     *bool  `json:"enabled,omitempty"`
+}
+
+type DeleteSubscriptionCommand struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+// PublishEventCommand queues an event for every enabled subscription of its user that wants it.
+type PublishEventCommand struct {
+	Event *event.Event `json:"event" validate:"required"`
+}
+
+// DispatchDeliveriesCommand sends every delivery whose next attempt is due.
+type DispatchDeliveriesCommand struct{}
+
+// RedeliverCommand queues the payload of a past delivery again.
+type RedeliverCommand struct {
+	ID         string `json:"id" validate:"required"`
+	DeliveryID string `json:"deliveryId" validate:"required"`
+	UserID     string `json:"userId" validate:"required"`
+}
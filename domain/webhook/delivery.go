@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/event"
+)
+
+type Status string
+
+const (
+	// Pending deliveries are waiting for their next attempt
+	Pending Status = "PENDING"
+	// Succeeded deliveries were answered with a 2xx status
+	Succeeded Status = "SUCCEEDED"
+	// Failed deliveries gave up after the last attempt
+	Failed Status = "FAILED"
+)
+
+func (s Status) IsValid() bool {
+	switch s {
+	case Pending, Succeeded, Failed:
+		return true
+	default:
+		return false
+	}
+}
+
+const (
+	// retryBase is the wait before the first retry; each later retry waits twice as long
+	retryBase = 30 * time.Second
+	// retryCap bounds the wait between two attempts
+	retryCap = 6 * time.Hour
+	// maxResponseBody is how much of a response is kept in the delivery log
+	maxResponseBody = 4096
+)
+
+// Delivery is one event queued for, or sent to, one subscription. Payload holds the exact
+// bytes that are signed and posted, so a redelivery sends the same body.
+type Delivery struct {
+	ID             uuid.UUID  `json:"id" db:"id"`
+	SubscriptionID uuid.UUID  `json:"subscriptionId" db:"subscription_id"`
+	UserID         uuid.UUID  `json:"userId" db:"user_id"`
+	EventID        uuid.UUID  `json:"eventId" db:"event_id"`
+	EventType      event.Type `json:"eventType" db:"event_type"`
+	Payload        string     `json:"payload" db:"payload"`
+	Status         Status     `json:"status" db:"status"`
+	Attempts       int        `json:"attempts" db:"attempts"`
+	NextAttemptAt  *time.Time `json:"nextAttemptAt,omitempty" db:"next_attempt_at"`
+	LastAttemptAt  *time.Time `json:"lastAttemptAt,omitempty" db:"last_attempt_at"`
+	ResponseStatus *int       `json:"responseStatus,omitempty" db:"response_status"`
+	ResponseBody   string     `json:"responseBody" db:"response_body"`
+	Error          string     `json:"error" db:"error"`
+	DurationMs     int64      `json:"durationMs" db:"duration_ms"`
+	RedeliveryOf   *uuid.UUID `json:"redeliveryOf,omitempty" db:"redelivery_of"`
+	CreatedAt      time.Time  `json:"createdAt" db:"created_at"`
+	UpdatedAt      time.Time  `json:"updatedAt" db:"updated_at"`
+}
+
+func NewDelivery(s *Subscription, e *event.Event, payload []byte) *Delivery {
+	now := time.Now()
+	return &Delivery{
+		ID:             uuid.New(),
+		SubscriptionID: s.ID,
+		UserID:         s.UserID,
+		EventID:        e.ID,
+		EventType:      e.Type,
+		Payload:        string(payload),
+		Status:         Pending,
+		NextAttemptAt:  &now,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Redeliver queues the payload of the delivery again as a new delivery.
+func (d *Delivery) Redeliver() *Delivery {
+	now := time.Now()
+	originalID := d.ID
+	return &Delivery{
+		ID:             uuid.New(),
+		SubscriptionID: d.SubscriptionID,
+		UserID:         d.UserID,
+		EventID:        d.EventID,
+		EventType:      d.EventType,
+		Payload:        d.Payload,
+		Status:         Pending,
+		NextAttemptAt:  &now,
+		RedeliveryOf:   &originalID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+}
+
+// Record stores the outcome of an attempt. A 2xx response completes the delivery; any
+// other outcome schedules a retry with exponential backoff, or fails the delivery once
+// maxAttempts were made.
+func (d *Delivery) Record(result *Result, maxAttempts int) {
+	now := time.Now()
+	d.Attempts++
+	d.LastAttemptAt = &now
+	d.UpdatedAt = now
+	d.ResponseStatus = nil
+	if result.StatusCode > 0 {
+		statusCode := result.StatusCode
+		d.ResponseStatus = &statusCode
+	}
+	d.ResponseBody = truncate(result.Body, maxResponseBody)
+	d.Error = ""
+	if result.Err != nil {
+		d.Error = result.Err.Error()
+	}
+	d.DurationMs = result.Duration.Milliseconds()
+
+	if result.Err == nil && result.StatusCode >= 200 && result.StatusCode < 300 {
+		d.Status = Succeeded
+		d.NextAttemptAt = nil
+		return
+	}
+	if d.Attempts >= maxAttempts {
+		d.Status = Failed
+		d.NextAttemptAt = nil
+		return
+	}
+	next := now.Add(Backoff(d.Attempts))
+	d.Status = Pending
+	d.NextAttemptAt = &next
+}
+
+// Backoff returns how long to wait after the given number of failed attempts.
+func Backoff(attempts int) time.Duration {
+	if attempts < 1 {
+		attempts = 1
+	}
+	wait := float64(retryBase) * math.Pow(2, float64(attempts-1))
+	if wait > float64(retryCap) {
+		return retryCap
+	}
+	return time.Duration(wait)
+}
+
+func truncate(value string, max int) string {
+	if len(value) <= max {
+		return value
+	}
+	return value[:max]
+}
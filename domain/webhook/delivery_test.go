@@ -0,0 +1,73 @@
+package webhook
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{0, 30 * time.Second},
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{5, 8 * time.Minute},
+		{10, 256 * time.Minute},
+		{11, 6 * time.Hour},
+		{100, 6 * time.Hour},
+	}
+	for _, tt := range tests {
+		if got := Backoff(tt.attempts); got != tt.want {
+			t.Errorf("Backoff(%d) = %v, want %v", tt.attempts, got, tt.want)
+		}
+	}
+}
+
+func TestRecord(t *testing.T) {
+	tests := []struct {
+		name     string
+		attempts int
+		result   Result
+		status   Status
+		retry    bool
+	}{
+		{"2xx succeeds", 0, Result{StatusCode: 204}, Succeeded, false},
+		{"5xx is retried", 0, Result{StatusCode: 503}, Pending, true},
+		{"redirect is retried", 0, Result{StatusCode: 302}, Pending, true},
+		{"network error is retried", 0, Result{Err: errors.New("connection refused")}, Pending, true},
+		{"last attempt fails", 4, Result{StatusCode: 500}, Failed, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := &Delivery{Attempts: tt.attempts, Status: Pending}
+			before := time.Now()
+
+			d.Record(&tt.result, 5)
+
+			if d.Status != tt.status || d.Attempts != tt.attempts+1 {
+				t.Errorf("%s after %d attempts, want %s after %d", d.Status, d.Attempts, tt.status, tt.attempts+1)
+			}
+			if (d.NextAttemptAt != nil) != tt.retry {
+				t.Fatalf("NextAttemptAt = %v, want a retry %v", d.NextAttemptAt, tt.retry)
+			}
+			if tt.retry && d.NextAttemptAt.Before(before.Add(Backoff(d.Attempts))) {
+				t.Errorf("retry at %v comes before the backoff", d.NextAttemptAt)
+			}
+			if (d.Error != "") != (tt.result.Err != nil) {
+				t.Errorf("Error = %q, want the error of the result", d.Error)
+			}
+		})
+	}
+}
+
+func TestRecordTruncatesResponseBody(t *testing.T) {
+	d := &Delivery{}
+	d.Record(&Result{StatusCode: 500, Body: strings.Repeat("x", maxResponseBody+10)}, 5)
+	if len(d.ResponseBody) != maxResponseBody {
+		t.Errorf("stored %d bytes of the response body, want %d", len(d.ResponseBody), maxResponseBody)
+	}
+}
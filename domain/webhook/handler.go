@@ -0,0 +1,343 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/endpoint"
+	"siyahsensei/wallet-service/domain/event"
+)
+
+const (
+	// dispatchBatchSize is how many due deliveries are claimed at once
+	dispatchBatchSize = 50
+	// relayBatchSize is how many outbox events are turned into deliveries at once
+	relayBatchSize = 100
+	// claimLease is how long a claimed delivery is held before another dispatcher may retry it
+	claimLease = 5 * time.Minute
+	// minSecretLength keeps user supplied secrets from being trivially guessable
+	minSecretLength = 16
+)
+
+type Handler struct {
+	repo        Repository
+	outbox      event.Outbox
+	sender      Sender
+	maxAttempts int
+	wake        chan struct{}
+}
+
+func NewHandler(repo Repository, outbox event.Outbox, sender Sender, maxAttempts int) *Handler {
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	return &Handler{
+		repo:        repo,
+		outbox:      outbox,
+		sender:      sender,
+		maxAttempts: maxAttempts,
+		wake:        make(chan struct{}, 1),
+	}
+}
+
+// Wake receives whenever deliveries were queued since the dispatcher last ran.
+func (h *Handler) Wake() <-chan struct{} {
+	return h.wake
+}
+
+func (h *Handler) notify() {
+	select {
+	case h.wake <- struct{}{}:
+	default:
+	}
+}
+
+func (h *Handler) HandleCreateSubscriptionCommand(ctx context.Context, command CreateSubscriptionCommand) (*Subscription, error) {
+	if _, err := uuid.Parse(command.UserID); err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	if err := validateSubscription(ctx, command.URL, command.EventTypes, command.Secret); err != nil {
+		return nil, err
+	}
+
+	secret := command.Secret
+	if secret == "" {
+		generated, err := NewSecret()
+		if err != nil {
+			return nil, err
+		}
+		secret = generated
+	}
+
+	subscription := NewSubscription(command, secret)
+	if err := h.repo.Create(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (h *Handler) HandleUpdateSubscriptionCommand(ctx context.Context, command UpdateSubscriptionCommand) (*Subscription, error) {
+	subscription, err := h.ownedSubscription(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateSubscription(ctx, command.URL, command.EventTypes, command.Secret); err != nil {
+		return nil, err
+	}
+
+	subscription.Update(command)
+	if err := h.repo.Update(ctx, subscription); err != nil {
+		return nil, err
+	}
+	return subscription, nil
+}
+
+func (h *Handler) HandleDeleteSubscriptionCommand(ctx context.Context, command DeleteSubscriptionCommand) error {
+	subscription, err := h.ownedSubscription(ctx, command.ID, command.UserID)
+	if err != nil {
+		return err
+	}
+	return h.repo.Delete(ctx, subscription.ID)
+}
+
+func (h *Handler) HandleGetSubscriptionByIDQuery(ctx context.Context, query GetSubscriptionByIDQuery) (*Subscription, error) {
+	return h.ownedSubscription(ctx, query.ID, query.UserID)
+}
+
+func (h *Handler) HandleGetUserSubscriptionsQuery(ctx context.Context, query GetUserSubscriptionsQuery) ([]*Subscription, error) {
+	userID, err := uuid.Parse(query.UserID)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+	return h.repo.GetByUserID(ctx, userID)
+}
+
+func (h *Handler) HandleGetDeliveriesQuery(ctx context.Context, query GetDeliveriesQuery) ([]*Delivery, error) {
+	subscription, err := h.ownedSubscription(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if query.Status != nil && !query.Status.IsValid() {
+		return nil, errors.New("invalid delivery status")
+	}
+	if query.Limit <= 0 {
+		query.Limit = 50
+	}
+	if query.Offset < 0 {
+		query.Offset = 0
+	}
+	return h.repo.GetDeliveries(ctx, subscription.ID, query.Status, query.Limit, query.Offset)
+}
+
+func (h *Handler) HandleGetDeliveryByIDQuery(ctx context.Context, query GetDeliveryByIDQuery) (*Delivery, error) {
+	subscription, err := h.ownedSubscription(ctx, query.ID, query.UserID)
+	if err != nil {
+		return nil, err
+	}
+	return h.subscriptionDelivery(ctx, subscription, query.DeliveryID)
+}
+
+func (h *Handler) HandleRedeliverCommand(ctx context.Context, command RedeliverCommand) (*Delivery, error) {
+	subscription, err := h.ownedSubscription(ctx, command.ID, command.UserID)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := h.subscriptionDelivery(ctx, subscription, command.DeliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	redelivery := delivery.Redeliver()
+	if err := h.repo.Enqueue(ctx, []*Delivery{redelivery}); err != nil {
+		return nil, err
+	}
+	h.notify()
+	return redelivery, nil
+}
+
+// HandlePublishEventCommand returns how many deliveries were queued for the event.
+func (h *Handler) HandlePublishEventCommand(ctx context.Context, command PublishEventCommand) (int, error) {
+	if command.Event == nil {
+		return 0, errors.New("event is required")
+	}
+
+	subscriptions, err := h.repo.GetEnabledByUserID(ctx, command.Event.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	var payload []byte
+	var deliveries []*Delivery
+	for _, s := range subscriptions {
+		if !s.Matches(command.Event.Type) {
+			continue
+		}
+		if payload == nil {
+			if payload, err = json.Marshal(command.Event); err != nil {
+				return 0, err
+			}
+		}
+		deliveries = append(deliveries, NewDelivery(s, command.Event, payload))
+	}
+	if len(deliveries) == 0 {
+		return 0, nil
+	}
+
+	if err := h.repo.Enqueue(ctx, deliveries); err != nil {
+		return 0, err
+	}
+	h.notify()
+	return len(deliveries), nil
+}
+
+// HandleDispatchDeliveriesCommand queues the deliveries of the events waiting in the
+// outbox, then sends the due deliveries and returns how many were attempted.
+func (h *Handler) HandleDispatchDeliveriesCommand(ctx context.Context, command DispatchDeliveriesCommand) (int, error) {
+	if err := h.relayOutbox(ctx); err != nil {
+		return 0, err
+	}
+
+	subscriptions := make(map[uuid.UUID]*Subscription)
+	var attempted int
+	var errs []error
+	for ctx.Err() == nil {
+		deliveries, err := h.repo.ClaimDue(ctx, dispatchBatchSize, claimLease)
+		if err != nil {
+			return attempted, err
+		}
+		if len(deliveries) == 0 {
+			break
+		}
+
+		for _, d := range deliveries {
+			subscription, ok := subscriptions[d.SubscriptionID]
+			if !ok {
+				subscription, err = h.repo.GetByID(ctx, d.SubscriptionID)
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+				subscriptions[d.SubscriptionID] = subscription
+			}
+
+			if subscription.Enabled {
+				d.Record(h.sender.Send(ctx, h.request(subscription, d)), h.maxAttempts)
+			} else {
+				d.Record(&Result{Err: errors.New("subscription is disabled")}, d.Attempts+1)
+			}
+			if err := h.repo.SaveAttempt(ctx, d); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			attempted++
+		}
+
+		if len(deliveries) < dispatchBatchSize {
+			break
+		}
+	}
+	return attempted, errors.Join(errs...)
+}
+
+// request signs the payload of a delivery for its subscription.
+func (h *Handler) request(s *Subscription, d *Delivery) *Request {
+	now := time.Now()
+	body := []byte(d.Payload)
+	return &Request{
+		URL: s.URL,
+		Headers: map[string]string{
+			"Content-Type":       "application/json",
+			"User-Agent":         "wallet-service-webhooks",
+			"X-Wallet-Event":     string(d.EventType),
+			"X-Wallet-Delivery":  d.ID.String(),
+			"X-Wallet-Timestamp": strconv.FormatInt(now.Unix(), 10),
+			"X-Wallet-Signature": "sha256=" + s.Sign(now, body),
+		},
+		Body: body,
+	}
+}
+
+func (h *Handler) subscriptionDelivery(ctx context.Context, s *Subscription, deliveryIDValue string) (*Delivery, error) {
+	deliveryID, err := uuid.Parse(deliveryIDValue)
+	if err != nil {
+		return nil, errors.New("invalid delivery ID")
+	}
+	delivery, err := h.repo.GetDeliveryByID(ctx, deliveryID)
+	if err != nil || delivery.SubscriptionID != s.ID {
+		return nil, errors.New("delivery not found")
+	}
+	return delivery, nil
+}
+
+func (h *Handler) ownedSubscription(ctx context.Context, subscriptionIDValue, userIDValue string) (*Subscription, error) {
+	subscriptionID, err := uuid.Parse(subscriptionIDValue)
+	if err != nil {
+		return nil, errors.New("invalid webhook ID")
+	}
+
+	userID, err := uuid.Parse(userIDValue)
+	if err != nil {
+		return nil, errors.New("invalid user ID")
+	}
+
+	subscription, err := h.repo.GetByID(ctx, subscriptionID)
+	if err != nil {
+		return nil, errors.New("webhook not found")
+	}
+
+	if subscription.UserID != userID {
+		return nil, errors.New("unauthorized: webhook does not belong to user")
+	}
+
+	return subscription, nil
+}
+
+// relayOutbox publishes the events written to the outbox by the ledger, which no handler
+// publishes, until it is empty.
+func (h *Handler) relayOutbox(ctx context.Context) error {
+	publish := func(ctx context.Context, e *event.Event) error {
+		_, err := h.HandlePublishEventCommand(ctx, PublishEventCommand{Event: e})
+		return err
+	}
+	for ctx.Err() == nil {
+		relayed, err := h.outbox.Relay(ctx, relayBatchSize, publish)
+		if err != nil {
+			return err
+		}
+		if relayed < relayBatchSize {
+			break
+		}
+	}
+	return nil
+}
+
+func validateSubscription(ctx context.Context, rawURL string, eventTypes []string, secret string) error {
+	if err := endpoint.Validate(ctx, rawURL); err != nil {
+		return errors.New("url " + err.Error())
+	}
+
+	eventTypes = normalizeEventTypes(eventTypes)
+	if len(eventTypes) == 0 {
+		return errors.New("at least one event type is required")
+	}
+	for _, t := range eventTypes {
+		if t != AllEvents && !event.Type(t).IsValid() {
+			known := make([]string, 0, len(event.Types))
+			for _, k := range event.Types {
+				known = append(known, string(k))
+			}
+			return errors.New("unknown event type " + t + ", expected " + AllEvents + " or one of " + strings.Join(known, ", "))
+		}
+	}
+
+	if secret != "" && len(secret) < minSecretLength {
+		return errors.New("secret must be at least 16 characters")
+	}
+	return nil
+}
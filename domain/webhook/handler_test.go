@@ -0,0 +1,202 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/endpoint"
+	"siyahsensei/wallet-service/domain/event"
+)
+
+type fakeRepository struct {
+	Repository
+	subscriptions map[uuid.UUID]*Subscription
+	deliveries    map[uuid.UUID]*Delivery
+	due           []*Delivery
+	enqueued      []*Delivery
+	saved         []*Delivery
+}
+
+func (f *fakeRepository) GetByID(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	s, ok := f.subscriptions[id]
+	if !ok {
+		return nil, errors.New("webhook not found")
+	}
+	return s, nil
+}
+
+func (f *fakeRepository) GetDeliveryByID(ctx context.Context, id uuid.UUID) (*Delivery, error) {
+	d, ok := f.deliveries[id]
+	if !ok {
+		return nil, errors.New("delivery not found")
+	}
+	return d, nil
+}
+
+func (f *fakeRepository) Enqueue(ctx context.Context, deliveries []*Delivery) error {
+	f.enqueued = append(f.enqueued, deliveries...)
+	return nil
+}
+
+func (f *fakeRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error) {
+	due := f.due
+	f.due = nil
+	return due, nil
+}
+
+func (f *fakeRepository) SaveAttempt(ctx context.Context, delivery *Delivery) error {
+	f.saved = append(f.saved, delivery)
+	return nil
+}
+
+type fakeOutbox struct{}
+
+func (fakeOutbox) Relay(ctx context.Context, limit int, publish func(ctx context.Context, e *event.Event) error) (int, error) {
+	return 0, nil
+}
+
+type fakeSender struct {
+	sent []*Request
+}
+
+func (f *fakeSender) Send(ctx context.Context, request *Request) *Result {
+	f.sent = append(f.sent, request)
+	return &Result{StatusCode: 200}
+}
+
+func TestValidateSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		eventTypes []string
+		secret     string
+		err        string
+	}{
+		{name: "every event", url: "https://93.184.216.34/hook", eventTypes: []string{"*"}},
+		{name: "named events in any case", url: "https://93.184.216.34/hook", eventTypes: []string{" Asset.Created ", "transaction.created"}},
+		{name: "secret of the minimum length", url: "https://93.184.216.34/hook", eventTypes: []string{"*"}, secret: "0123456789abcdef"},
+		{name: "no event types", url: "https://93.184.216.34/hook", eventTypes: []string{" ", ""}, err: "at least one event type is required"},
+		{
+			name:       "unknown event type",
+			url:        "https://93.184.216.34/hook",
+			eventTypes: []string{"asset.sold"},
+			err:        "unknown event type asset.sold, expected * or one of account.created, account.updated, account.deleted, asset.created, asset.updated, asset.deleted, asset.transferred, transaction.created, transaction.deleted",
+		},
+		{name: "short secret", url: "https://93.184.216.34/hook", eventTypes: []string{"*"}, secret: "0123456789abcde", err: "secret must be at least 16 characters"},
+		{name: "private address", url: "http://10.0.0.5/hook", eventTypes: []string{"*"}, err: "url " + endpoint.ErrNotPublic.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateSubscription(context.Background(), tt.url, tt.eventTypes, tt.secret)
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("validateSubscription: %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("validateSubscription error = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestHandleDispatchDeliveriesCommand(t *testing.T) {
+	enabled := &Subscription{ID: uuid.New(), URL: "https://93.184.216.34/hook", Secret: "whsec_test", Enabled: true}
+	disabled := &Subscription{ID: uuid.New(), URL: "https://93.184.216.34/other", Secret: "whsec_test"}
+	e := event.New(event.AssetCreated, uuid.New(), nil)
+	toEnabled := NewDelivery(enabled, e, []byte(`{}`))
+	toDisabled := NewDelivery(disabled, e, []byte(`{}`))
+
+	repo := &fakeRepository{
+		subscriptions: map[uuid.UUID]*Subscription{enabled.ID: enabled, disabled.ID: disabled},
+		due:           []*Delivery{toEnabled, toDisabled},
+	}
+	sender := &fakeSender{}
+	h := NewHandler(repo, fakeOutbox{}, sender, 5)
+
+	attempted, err := h.HandleDispatchDeliveriesCommand(context.Background(), DispatchDeliveriesCommand{})
+	if err != nil {
+		t.Fatalf("HandleDispatchDeliveriesCommand: %v", err)
+	}
+	if attempted != 2 || len(repo.saved) != 2 {
+		t.Fatalf("attempted %d and saved %d deliveries, want 2 and 2", attempted, len(repo.saved))
+	}
+
+	tests := []struct {
+		name     string
+		delivery *Delivery
+		status   Status
+		failure  string
+	}{
+		{"enabled subscription is sent to", toEnabled, Succeeded, ""},
+		{"disabled subscription fails without a retry", toDisabled, Failed, "subscription is disabled"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.delivery.Status != tt.status || tt.delivery.Error != tt.failure || tt.delivery.NextAttemptAt != nil {
+				t.Errorf("delivery is %s with error %q, next attempt %v, want %s with error %q and none",
+					tt.delivery.Status, tt.delivery.Error, tt.delivery.NextAttemptAt, tt.status, tt.failure)
+			}
+		})
+	}
+	if len(sender.sent) != 1 || sender.sent[0].URL != enabled.URL {
+		t.Errorf("sent %d requests, want one to %s", len(sender.sent), enabled.URL)
+	}
+}
+
+func TestHandleRedeliverCommand(t *testing.T) {
+	userID := uuid.New()
+	owned := &Subscription{ID: uuid.New(), UserID: userID}
+	foreign := &Subscription{ID: uuid.New(), UserID: uuid.New()}
+	e := event.New(event.AssetCreated, userID, nil)
+	ownedDelivery := NewDelivery(owned, e, []byte(`{"id":1}`))
+	foreignDelivery := NewDelivery(foreign, e, []byte(`{"id":2}`))
+
+	tests := []struct {
+		name           string
+		subscriptionID uuid.UUID
+		deliveryID     uuid.UUID
+		err            string
+	}{
+		{"delivery of the user's webhook", owned.ID, ownedDelivery.ID, ""},
+		{"webhook of another user", foreign.ID, foreignDelivery.ID, "unauthorized: webhook does not belong to user"},
+		{"delivery of another webhook", owned.ID, foreignDelivery.ID, "delivery not found"},
+		{"unknown delivery", owned.ID, uuid.New(), "delivery not found"},
+		{"unknown webhook", uuid.New(), ownedDelivery.ID, "webhook not found"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &fakeRepository{
+				subscriptions: map[uuid.UUID]*Subscription{owned.ID: owned, foreign.ID: foreign},
+				deliveries:    map[uuid.UUID]*Delivery{ownedDelivery.ID: ownedDelivery, foreignDelivery.ID: foreignDelivery},
+			}
+			h := NewHandler(repo, fakeOutbox{}, &fakeSender{}, 5)
+
+			redelivery, err := h.HandleRedeliverCommand(context.Background(), RedeliverCommand{
+				ID:         tt.subscriptionID.String(),
+				DeliveryID: tt.deliveryID.String(),
+				UserID:     userID.String(),
+			})
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("HandleRedeliverCommand error = %v, want %q", err, tt.err)
+				}
+				if len(repo.enqueued) != 0 {
+					t.Errorf("a rejected redelivery was queued")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("HandleRedeliverCommand: %v", err)
+			}
+			if len(repo.enqueued) != 1 || redelivery.RedeliveryOf == nil || *redelivery.RedeliveryOf != tt.deliveryID || redelivery.Payload != ownedDelivery.Payload {
+				t.Errorf("queued %d deliveries, redelivery of %v, want one resending %v", len(repo.enqueued), redelivery.RedeliveryOf, tt.deliveryID)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package webhook
+
+type GetSubscriptionByIDQuery struct {
+	ID     string `json:"id" validate:"required"`
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetUserSubscriptionsQuery struct {
+	UserID string `json:"userId" validate:"required"`
+}
+
+type GetDeliveriesQuery struct {
+	ID     string  `json:"id" validate:"required"`
+	UserID string  `json:"userId" validate:"required"`
+	Status *Status `json:"status,omitempty"`
+	Limit  int     `json:"limit"`
+	Offset int     `json:"offset"`
+}
+
+type GetDeliveryByIDQuery struct {
+	ID         string `json:"id" validate:"required"`
+	DeliveryID string `json:"deliveryId" validate:"required"`
+	UserID     string `json:"userId" validate:"required"`
+}
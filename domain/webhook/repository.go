@@ -0,0 +1,24 @@
+package webhook
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Repository interface {
+	Create(ctx context.Context, subscription *Subscription) error
+	Update(ctx context.Context, subscription *Subscription) error
+	Delete(ctx context.Context, id uuid.UUID) error
+	GetByID(ctx context.Context, id uuid.UUID) (*Subscription, error)
+	GetByUserID(ctx context.Context, userID uuid.UUID) ([]*Subscription, error)
+	GetEnabledByUserID(ctx context.Context, userID uuid.UUID) ([]*Subscription, error)
+	Enqueue(ctx context.Context, deliveries []*Delivery) error
+	// ClaimDue takes up to limit pending deliveries whose next attempt is due and pushes
+	// their next attempt back by lease, so no other dispatcher sends them meanwhile.
+	ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*Delivery, error)
+	SaveAttempt(ctx context.Context, delivery *Delivery) error
+	GetDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *Status, limit, offset int) ([]*Delivery, error)
+	GetDeliveryByID(ctx context.Context, id uuid.UUID) (*Delivery, error)
+}
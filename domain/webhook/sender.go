@@ -0,0 +1,26 @@
+package webhook
+
+import (
+	"context"
+	"time"
+)
+
+// Request is a signed payload ready to be posted.
+type Request struct {
+	URL     string
+	Headers map[string]string
+	Body    []byte
+}
+
+// Result is what came back from posting a request. Err is set when no response was received.
+type Result struct {
+	StatusCode int
+	Body       string
+	Duration   time.Duration
+	Err        error
+}
+
+// Sender posts webhook requests.
+type Sender interface {
+	Send(ctx context.Context, request *Request) *Result
+}
@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/event"
+)
+
+// AllEvents subscribes to every event type, including ones added later.
+const AllEvents = "*"
+
+// Subscription asks for the events of a user to be posted to a URL. Every payload is
+// signed with the secret so the receiver can check where it came from.
+type Subscription struct {
+	ID          uuid.UUID `json:"id" db:"id"`
+	UserID      uuid.UUID `json:"userId" db:"user_id"`
+	URL         string    `json:"url" db:"url"`
+	Secret      string    `json:"-" db:"secret"`
+	EventTypes  []string  `json:"eventTypes" db:"-"`
+	Description string    `json:"description" db:"description"`
+	Enabled     bool      `json:"enabled" db:"enabled"`
+	CreatedAt   time.Time `json:"createdAt" db:"created_at"`
+	UpdatedAt   time.Time `json:"updatedAt" db:"updated_at"`
+}
+
+func NewSubscription(command CreateSubscriptionCommand, secret string) *Subscription {
+	now := time.Now()
+	return &Subscription{
+		ID:          uuid.New(),
+		UserID:      uuid.MustParse(command.UserID),
+		URL:         command.URL,
+		Secret:      secret,
+		EventTypes:  normalizeEventTypes(command.EventTypes),
+		Description: command.Description,
+		Enabled:     true,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+}
+
+func (s *Subscription) Update(command UpdateSubscriptionCommand) {
+	s.URL = command.URL
+	s.EventTypes = normalizeEventTypes(command.EventTypes)
+	s.Description = command.Description
+	if command.Secret != "" {
+		s.Secret = command.Secret
+	}
+	if command.Enabled != nil {
+		s.Enabled = *command.Enabled
+	}
+	s.UpdatedAt = time.Now()
+}
+
+// Matches reports whether the subscription wants events of the given type.
+func (s *Subscription) Matches(t event.Type) bool {
+	for _, eventType := range s.EventTypes {
+		if eventType == AllEvents || eventType == string(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Sign returns the signature of a payload sent at the given time.
+func (s *Subscription) Sign(timestamp time.Time, body []byte) string {
+	return Signature(s.Secret, timestamp, body)
+}
+
+// Signature is the hex HMAC-SHA256, keyed with the secret, of the unix timestamp, a dot
+// and the body. Every signed payload the service posts uses it.
+func Signature(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// NewSecret generates a random signing secret.
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+func normalizeEventTypes(eventTypes []string) []string {
+	seen := make(map[string]bool)
+	normalized := []string{}
+	for _, t := range eventTypes {
+		t = strings.ToLower(strings.TrimSpace(t))
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		normalized = append(normalized, t)
+	}
+	return normalized
+}
@@ -0,0 +1,53 @@
+package webhook
+
+import (
+	"testing"
+	"time"
+
+	"siyahsensei/wallet-service/domain/event"
+)
+
+func TestSubscriptionMatches(t *testing.T) {
+	tests := []struct {
+		name       string
+		eventTypes []string
+		want       bool
+	}{
+		{"listed type", []string{string(event.TransactionCreated)}, true},
+		{"other type", []string{string(event.TransactionDeleted)}, false},
+		{"all events", []string{AllEvents}, true},
+		{"nothing", nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &Subscription{EventTypes: tt.eventTypes}
+			if got := s.Matches(event.TransactionCreated); got != tt.want {
+				t.Errorf("Matches = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSignature(t *testing.T) {
+	timestamp := time.Unix(1700000000, 0)
+	body := []byte(`{"a":1}`)
+
+	tests := []struct {
+		name   string
+		secret string
+		body   []byte
+		want   bool
+	}{
+		{"same secret and body", "whsec_test", body, true},
+		{"other secret", "whsec_other", body, false},
+		{"other body", "whsec_test", []byte(`{"a":2}`), false},
+	}
+	want := "38877139021993b830af32feea6e18a8da83eb2f6e49ee50bd9e4cf4ca4d3789"
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Signature(tt.secret, timestamp, tt.body); (got == want) != tt.want {
+				t.Errorf("Signature = %s, matching %s is %v, want %v", got, want, got == want, tt.want)
+			}
+		})
+	}
+}
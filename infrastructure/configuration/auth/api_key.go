@@ -0,0 +1,33 @@
+package auth
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// APIKeyMiddleware admits requests carrying key in the X-API-Key header. It guards
+// endpoints meant for trusted services rather than users, and rejects every request
+// when no key is configured.
+func APIKeyMiddleware(key string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if key == "" {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "Endpoint is disabled, no API key is configured",
+			})
+		}
+
+		provided := c.Get("X-API-Key")
+		if provided == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "X-API-Key header missing",
+			})
+		}
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(key)) != 1 {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "Invalid API key",
+			})
+		}
+		return c.Next()
+	}
+}
@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"siyahsensei/wallet-service/domain/endpoint"
+)
+
+// newClient returns the HTTP client used for URLs supplied by users. It connects only to
+// public addresses, checking the addresses it actually dials so that a name re-pointed
+// at an internal service after validation is still refused. It uses no proxy and does
+// not follow redirects, a redirect is returned as the response.
+func newClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   5 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	transport := &http.Transport{
+		DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+			return dialPublic(ctx, dialer, network, address)
+		},
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}
+	return &http.Client{
+		Timeout:   10 * time.Second,
+		Transport: transport,
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+func dialPublic(ctx context.Context, dialer *net.Dialer, network, address string) (net.Conn, error) {
+	host, portValue, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	port, err := strconv.ParseUint(portValue, 10, 16)
+	if err != nil {
+		return nil, err
+	}
+	addrs, err := endpoint.Resolve(ctx, host)
+	if err != nil {
+		return nil, errors.New("url " + err.Error())
+	}
+
+	var errs []error
+	for _, addr := range addrs {
+		conn, err := dialer.DialContext(ctx, network, netip.AddrPortFrom(addr, uint16(port)).String())
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
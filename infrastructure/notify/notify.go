@@ -0,0 +1,16 @@
+package notify
+
+import (
+	"siyahsensei/wallet-service/configs"
+	"siyahsensei/wallet-service/domain/alert"
+)
+
+// NewNotifiers builds the outbound alert channels available in the configuration.
+// Webhooks are always available; email needs an SMTP host.
+func NewNotifiers(config *configs.Config) []alert.Notifier {
+	notifiers := []alert.Notifier{NewWebhookNotifier()}
+	if config.SMTPHost != "" {
+		notifiers = append(notifiers, NewSMTPNotifier(config.SMTPHost, config.SMTPPort, config.SMTPUsername, config.SMTPPassword, config.SMTPFrom))
+	}
+	return notifiers
+}
@@ -0,0 +1,100 @@
+package notify
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/domain/alert"
+)
+
+// SMTPNotifier emails a triggered alert to the address of the alert. STARTTLS is used
+// whenever the server offers it.
+type SMTPNotifier struct {
+	host     string
+	port     int
+	username string
+	password string
+	from     string
+}
+
+func NewSMTPNotifier(host string, port int, username, password, from string) *SMTPNotifier {
+	return &SMTPNotifier{
+		host:     host,
+		port:     port,
+		username: username,
+		password: password,
+		from:     from,
+	}
+}
+
+func (n *SMTPNotifier) Channel() alert.Channel {
+	return alert.Email
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, a *alert.Alert, t *alert.Trigger) error {
+	dialer := &net.Dialer{Timeout: 10 * time.Second}
+	conn, err := dialer.DialContext(ctx, "tcp", net.JoinHostPort(n.host, strconv.Itoa(n.port)))
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(30 * time.Second))
+
+	client, err := smtp.NewClient(conn, n.host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		if err := client.StartTLS(&tls.Config{ServerName: n.host}); err != nil {
+			return err
+		}
+	}
+	if n.username != "" {
+		if err := client.Auth(smtp.PlainAuth("", n.username, n.password, n.host)); err != nil {
+			return err
+		}
+	}
+
+	if err := client.Mail(n.from); err != nil {
+		return err
+	}
+	if err := client.Rcpt(a.Email); err != nil {
+		return err
+	}
+	w, err := client.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(n.message(a, t)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+func (n *SMTPNotifier) message(a *alert.Alert, t *alert.Trigger) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", n.from)
+	fmt.Fprintf(&b, "To: %s\r\n", a.Email)
+	fmt.Fprintf(&b, "Subject: %s\r\n", headerValue("Wallet alert: "+a.Name))
+	fmt.Fprintf(&b, "Date: %s\r\n", t.TriggeredAt.Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&b, "%s\r\n\r\nTriggered at %s.\r\n", t.Message, t.TriggeredAt.Format(time.RFC1123))
+	return []byte(b.String())
+}
+
+// headerValue keeps user input from breaking out of a header line.
+func headerValue(value string) string {
+	return strings.NewReplacer("\r", " ", "\n", " ").Replace(value)
+}
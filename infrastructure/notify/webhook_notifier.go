@@ -0,0 +1,87 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/alert"
+	"siyahsensei/wallet-service/domain/webhook"
+)
+
+// WebhookNotifier posts a triggered alert as JSON to the webhook URL of the alert, signed
+// with the webhook secret of the alert.
+type WebhookNotifier struct {
+	client *http.Client
+}
+
+func NewWebhookNotifier() *WebhookNotifier {
+	return &WebhookNotifier{
+		client: newClient(),
+	}
+}
+
+type webhookPayload struct {
+	Event       string     `json:"event"`
+	AlertID     uuid.UUID  `json:"alertId"`
+	TriggerID   uuid.UUID  `json:"triggerId"`
+	Name        string     `json:"name"`
+	Kind        alert.Kind `json:"kind"`
+	Currency    string     `json:"currency"`
+	Threshold   float64    `json:"threshold"`
+	Value       float64    `json:"value"`
+	Message     string     `json:"message"`
+	TriggeredAt time.Time  `json:"triggeredAt"`
+}
+
+func (n *WebhookNotifier) Channel() alert.Channel {
+	return alert.Webhook
+}
+
+func (n *WebhookNotifier) Notify(ctx context.Context, a *alert.Alert, t *alert.Trigger) error {
+	body, err := json.Marshal(webhookPayload{
+		Event:       "alert.triggered",
+		AlertID:     a.ID,
+		TriggerID:   t.ID,
+		Name:        a.Name,
+		Kind:        a.Kind,
+		Currency:    a.Currency,
+		Threshold:   t.Threshold,
+		Value:       t.Value,
+		Message:     t.Message,
+		TriggeredAt: t.TriggeredAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, a.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	// Signed like the event webhooks, with the secret generated for the alert
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Wallet-Event", "alert.triggered")
+	req.Header.Set("X-Wallet-Timestamp", strconv.FormatInt(now.Unix(), 10))
+	req.Header.Set("X-Wallet-Signature", "sha256="+webhook.Signature(a.WebhookSecret, now, body))
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"time"
+
+	"siyahsensei/wallet-service/domain/webhook"
+)
+
+// maxResponseRead is how much of a webhook response is read for the delivery log.
+const maxResponseRead = 4096
+
+// HTTPSender posts signed webhook deliveries. Like the alert webhooks, it only reaches
+// public addresses and records a redirect as the response instead of following it.
+type HTTPSender struct {
+	client *http.Client
+}
+
+func NewHTTPSender() *HTTPSender {
+	return &HTTPSender{
+		client: newClient(),
+	}
+}
+
+func (s *HTTPSender) Send(ctx context.Context, request *webhook.Request) *webhook.Result {
+	start := time.Now()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, request.URL, bytes.NewReader(request.Body))
+	if err != nil {
+		return &webhook.Result{Err: err}
+	}
+	for key, value := range request.Headers {
+		req.Header.Set(key, value)
+	}
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return &webhook.Result{Duration: time.Since(start), Err: err}
+	}
+	defer resp.Body.Close()
+
+	// A body that cannot be read in full does not change the outcome of the delivery
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseRead))
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+	return &webhook.Result{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+		Duration:   time.Since(start),
+	}
+}
@@ -298,36 +298,9 @@ func (r *PostgresRepository) GetAccountSummary(ctx context.Context, userID uuid.
 		byType[accountType] = count
 	}
 
-	// Get currency totals from assets
-	currencyQuery := `
-		SELECT d.suffix, SUM(a.quantity) as total
-		FROM assets a
-		JOIN definitions d ON a.definition_id = d.id
-		JOIN accounts acc ON a.account_id = acc.id
-		WHERE acc.user_id = $1 AND d.suffix IS NOT NULL
-		GROUP BY d.suffix
-	`
-	currencyRows, err := r.db.QueryContext(ctx, currencyQuery, userID)
-	if err != nil {
-		return nil, err
-	}
-	defer currencyRows.Close()
-
-	byCurrency := make(map[string]float64)
-	for currencyRows.Next() {
-		var currency string
-		var total float64
-		err := currencyRows.Scan(&currency, &total)
-		if err != nil {
-			return nil, err
-		}
-		byCurrency[currency] = total
-	}
-
 	return &account.AccountSummary{
 		TotalAccounts: totalAccounts,
 		ByType:        byType,
-		ByCurrency:    byCurrency,
 	}, nil
 }
 
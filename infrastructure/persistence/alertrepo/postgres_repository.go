@@ -0,0 +1,253 @@
+package alertrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"siyahsensei/wallet-service/domain/alert"
+)
+
+const alertColumns = `id, user_id, name, kind, definition_id, account_id, currency, threshold, channels,
+	COALESCE(webhook_url, '') AS webhook_url, COALESCE(webhook_secret, '') AS webhook_secret, COALESCE(email, '') AS email,
+	enabled, triggered, last_value, last_evaluated_at, last_triggered_at, created_at, updated_at`
+
+const triggerColumns = `id, alert_id, user_id, kind, value, threshold, message, inbox, deliveries,
+	triggered_at, read_at, created_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// alertRow is the stored form of an alert with its channels as JSON.
+type alertRow struct {
+	alert.Alert
+	ChannelsJSON []byte `db:"channels"`
+}
+
+func toAlertRow(a *alert.Alert) (*alertRow, error) {
+	channels, err := json.Marshal(a.Channels)
+	if err != nil {
+		return nil, err
+	}
+	return &alertRow{Alert: *a, ChannelsJSON: channels}, nil
+}
+
+func (row *alertRow) toAlert() (*alert.Alert, error) {
+	a := row.Alert
+	if err := json.Unmarshal(row.ChannelsJSON, &a.Channels); err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+// triggerRow is the stored form of a trigger with its deliveries as JSON.
+type triggerRow struct {
+	alert.Trigger
+	DeliveriesJSON []byte `db:"deliveries"`
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, a *alert.Alert) error {
+	row, err := toAlertRow(a)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO alerts (id, user_id, name, kind, definition_id, account_id, currency, threshold, channels,
+			webhook_url, webhook_secret, email, enabled, triggered, last_value, last_evaluated_at, last_triggered_at,
+			created_at, updated_at)
+		VALUES (:id, :user_id, :name, :kind, :definition_id, :account_id, :currency, :threshold, :channels,
+			NULLIF(:webhook_url, ''), NULLIF(:webhook_secret, ''), NULLIF(:email, ''), :enabled, :triggered, :last_value,
+			:last_evaluated_at, :last_triggered_at, :created_at, :updated_at)
+	`
+	_, err = r.db.NamedExecContext(ctx, query, row)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, a *alert.Alert) error {
+	row, err := toAlertRow(a)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE alerts
+		SET name = :name, currency = :currency, threshold = :threshold, channels = :channels,
+			webhook_url = NULLIF(:webhook_url, ''), webhook_secret = NULLIF(:webhook_secret, ''), email = NULLIF(:email, ''),
+			enabled = :enabled, triggered = :triggered, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err = r.db.NamedExecContext(ctx, query, row)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM alerts WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*alert.Alert, error) {
+	var row alertRow
+	query := "SELECT " + alertColumns + " FROM alerts WHERE id = $1"
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		return nil, err
+	}
+	return row.toAlert()
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*alert.Alert, error) {
+	query := "SELECT " + alertColumns + " FROM alerts WHERE user_id = $1 ORDER BY created_at ASC"
+	return r.selectAlerts(ctx, query, userID)
+}
+
+func (r *PostgresRepository) GetEnabledPriceAlerts(ctx context.Context, definitionIDs []uuid.UUID) ([]*alert.Alert, error) {
+	query := "SELECT " + alertColumns + " FROM alerts WHERE enabled AND definition_id IS NOT NULL"
+	if len(definitionIDs) == 0 {
+		return r.selectAlerts(ctx, query+" ORDER BY created_at ASC")
+	}
+
+	ids := make([]string, 0, len(definitionIDs))
+	for _, id := range definitionIDs {
+		ids = append(ids, id.String())
+	}
+	return r.selectAlerts(ctx, query+" AND definition_id = ANY($1::uuid[]) ORDER BY created_at ASC", pq.Array(ids))
+}
+
+func (r *PostgresRepository) GetEnabledPortfolioAlerts(ctx context.Context) ([]*alert.Alert, error) {
+	query := "SELECT " + alertColumns + " FROM alerts WHERE enabled AND definition_id IS NULL ORDER BY user_id, created_at ASC"
+	return r.selectAlerts(ctx, query)
+}
+
+func (r *PostgresRepository) selectAlerts(ctx context.Context, query string, args ...interface{}) ([]*alert.Alert, error) {
+	var rows []alertRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	alerts := make([]*alert.Alert, 0, len(rows))
+	for i := range rows {
+		a, err := rows[i].toAlert()
+		if err != nil {
+			return nil, err
+		}
+		alerts = append(alerts, a)
+	}
+	return alerts, nil
+}
+
+func (r *PostgresRepository) SaveEvaluation(ctx context.Context, a *alert.Alert, trigger *alert.Trigger) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		UPDATE alerts
+		SET triggered = $1, last_value = $2, last_evaluated_at = $3, last_triggered_at = $4, updated_at = $5
+		WHERE id = $6
+	`
+	if _, err := tx.ExecContext(ctx, query, a.Triggered, a.LastValue, a.LastEvaluatedAt, a.LastTriggeredAt, a.UpdatedAt, a.ID); err != nil {
+		return err
+	}
+
+	if trigger != nil {
+		deliveries, err := json.Marshal(trigger.Deliveries)
+		if err != nil {
+			return err
+		}
+		query := `
+			INSERT INTO alert_triggers (id, alert_id, user_id, kind, value, threshold, message, inbox, deliveries, triggered_at, read_at, created_at)
+			VALUES (:id, :alert_id, :user_id, :kind, :value, :threshold, :message, :inbox, :deliveries, :triggered_at, :read_at, :created_at)
+		`
+		if _, err := tx.NamedExecContext(ctx, query, &triggerRow{Trigger: *trigger, DeliveriesJSON: deliveries}); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetTriggers(ctx context.Context, alertID uuid.UUID, limit, offset int) ([]*alert.Trigger, error) {
+	query := "SELECT " + triggerColumns + " FROM alert_triggers WHERE alert_id = $1 ORDER BY triggered_at DESC, created_at DESC LIMIT $2 OFFSET $3"
+	return r.selectTriggers(ctx, query, alertID, limit, offset)
+}
+
+func (r *PostgresRepository) GetInbox(ctx context.Context, userID uuid.UUID, unreadOnly bool, limit, offset int) ([]*alert.Trigger, error) {
+	query := "SELECT " + triggerColumns + " FROM alert_triggers WHERE user_id = $1 AND inbox"
+	if unreadOnly {
+		query += " AND read_at IS NULL"
+	}
+	query += " ORDER BY triggered_at DESC, created_at DESC LIMIT $2 OFFSET $3"
+	return r.selectTriggers(ctx, query, userID, limit, offset)
+}
+
+func (r *PostgresRepository) selectTriggers(ctx context.Context, query string, args ...interface{}) ([]*alert.Trigger, error) {
+	var rows []triggerRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	triggers := make([]*alert.Trigger, 0, len(rows))
+	for i := range rows {
+		trigger := rows[i].Trigger
+		if err := json.Unmarshal(rows[i].DeliveriesJSON, &trigger.Deliveries); err != nil {
+			return nil, err
+		}
+		triggers = append(triggers, &trigger)
+	}
+	return triggers, nil
+}
+
+func (r *PostgresRepository) CountUnread(ctx context.Context, userID uuid.UUID) (int, error) {
+	var count int
+	query := "SELECT COUNT(*) FROM alert_triggers WHERE user_id = $1 AND inbox AND read_at IS NULL"
+	if err := r.db.GetContext(ctx, &count, query, userID); err != nil {
+		return 0, err
+	}
+	return count, nil
+}
+
+func (r *PostgresRepository) GetTriggerByID(ctx context.Context, id uuid.UUID) (*alert.Trigger, error) {
+	var row triggerRow
+	query := "SELECT " + triggerColumns + " FROM alert_triggers WHERE id = $1"
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		return nil, err
+	}
+
+	trigger := row.Trigger
+	if err := json.Unmarshal(row.DeliveriesJSON, &trigger.Deliveries); err != nil {
+		return nil, err
+	}
+	return &trigger, nil
+}
+
+func (r *PostgresRepository) MarkRead(ctx context.Context, userID uuid.UUID, triggerID *uuid.UUID) (int, error) {
+	query := "UPDATE alert_triggers SET read_at = $1 WHERE user_id = $2 AND inbox AND read_at IS NULL"
+	args := []interface{}{time.Now(), userID}
+	if triggerID != nil {
+		query += " AND id = $3"
+		args = append(args, *triggerID)
+	}
+
+	result, err := r.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(affected), nil
+}
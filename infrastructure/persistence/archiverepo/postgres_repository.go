@@ -0,0 +1,256 @@
+package archiverepo
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/archive"
+)
+
+const exportColumns = `id, user_id, status, COALESCE(file_name, '') AS file_name, size, records,
+	COALESCE(error, '') AS error, started_at, completed_at, expires_at, created_at, updated_at`
+
+// tableQueries selects the rows of every archive table that belong to the user in $1.
+var tableQueries = map[string]string{
+	"definitions": `SELECT * FROM definitions WHERE id IN (
+		SELECT definition_id FROM assets WHERE user_id = $1
+		UNION SELECT definition_id FROM recurring_rules WHERE user_id = $1
+		UNION SELECT definition_id FROM alerts WHERE user_id = $1
+	) ORDER BY abbreviation`,
+	"accounts":            `SELECT * FROM accounts WHERE user_id = $1 ORDER BY created_at, id`,
+	"assets":              `SELECT * FROM assets WHERE user_id = $1 ORDER BY created_at, id`,
+	"transactions":        `SELECT * FROM transactions WHERE user_id = $1 ORDER BY transaction_date, created_at, id`,
+	"lots":                `SELECT * FROM lots WHERE user_id = $1 ORDER BY created_at, id`,
+	"lot_disposals":       `SELECT * FROM lot_disposals WHERE user_id = $1 ORDER BY created_at, id`,
+	"portfolio_snapshots": `SELECT * FROM portfolio_snapshots WHERE user_id = $1 ORDER BY snapshot_date`,
+	"allocation_targets":  `SELECT * FROM allocation_targets WHERE user_id = $1 ORDER BY created_at, id`,
+	"recurring_rules":     `SELECT * FROM recurring_rules WHERE user_id = $1 ORDER BY created_at, id`,
+	"recurring_occurrences": `SELECT o.* FROM recurring_occurrences o
+		JOIN recurring_rules r ON r.id = o.rule_id
+		WHERE r.user_id = $1 ORDER BY o.created_at, o.id`,
+	"term_deposits":          `SELECT * FROM term_deposits WHERE user_id = $1 ORDER BY created_at, id`,
+	"bonds":                  `SELECT * FROM bonds WHERE user_id = $1 ORDER BY created_at, id`,
+	"income":                 `SELECT * FROM income WHERE user_id = $1 ORDER BY created_at, id`,
+	"loans":                  `SELECT * FROM loans WHERE user_id = $1 ORDER BY created_at, id`,
+	"loan_payments":          `SELECT * FROM loan_payments WHERE user_id = $1 ORDER BY created_at, id`,
+	"receivables":            `SELECT * FROM receivables WHERE user_id = $1 ORDER BY created_at, id`,
+	"receivable_repayments":  `SELECT * FROM receivable_repayments WHERE user_id = $1 ORDER BY created_at, id`,
+	"properties":             `SELECT * FROM properties WHERE user_id = $1 ORDER BY created_at, id`,
+	"property_appraisals":    `SELECT * FROM property_appraisals WHERE user_id = $1 ORDER BY created_at, id`,
+	"property_expenses":      `SELECT * FROM property_expenses WHERE user_id = $1 ORDER BY created_at, id`,
+	"statement_accounts":     `SELECT * FROM statement_accounts WHERE user_id = $1 ORDER BY created_at, id`,
+	"statement_transactions": `SELECT * FROM statement_transactions WHERE user_id = $1 ORDER BY created_at, id`,
+	"alerts":                 `SELECT * FROM alerts WHERE user_id = $1 ORDER BY created_at, id`,
+	"alert_triggers":         `SELECT * FROM alert_triggers WHERE user_id = $1 ORDER BY created_at, id`,
+	"webhook_subscriptions":  `SELECT * FROM webhook_subscriptions WHERE user_id = $1 ORDER BY created_at, id`,
+}
+
+// secretColumns holds the signing secret column of the tables that have one. Secrets
+// never leave the database: they are left out of exports and generated anew on restore.
+var secretColumns = map[string]string{
+	"alerts":                "webhook_secret",
+	"webhook_subscriptions": "secret",
+}
+
+// urlColumns holds the webhook URL column of the tables that deliver to one. A restored
+// URL is checked like a new one, so an archive cannot point deliveries at private
+// addresses.
+var urlColumns = map[string]string{
+	"alerts":                "webhook_url",
+	"webhook_subscriptions": "url",
+}
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// Collect reads all tables in one read-only snapshot so the archive is consistent even
+// while the user keeps working.
+func (r *PostgresRepository) Collect(ctx context.Context, a *archive.Archive) error {
+	tx, err := r.db.BeginTxx(ctx, &sql.TxOptions{Isolation: sql.LevelRepeatableRead, ReadOnly: true})
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, table := range archive.Tables {
+		query, ok := tableQueries[table]
+		if !ok {
+			return fmt.Errorf("no query for table %s", table)
+		}
+		columns, records, err := readTable(ctx, tx, query, a.Profile.ID, secretColumns[table])
+		if err != nil {
+			return fmt.Errorf("reading %s: %w", table, err)
+		}
+		a.Columns[table] = columns
+		a.Tables[table] = records
+	}
+	return nil
+}
+
+func (r *PostgresRepository) CountRecords(ctx context.Context, userID uuid.UUID) (int, error) {
+	counts := make([]string, 0, len(archive.Tables))
+	for _, table := range archive.Tables {
+		counts = append(counts, "(SELECT COUNT(*) FROM ("+tableQueries[table]+") AS "+table+")")
+	}
+	var count int
+	err := r.db.GetContext(ctx, &count, "SELECT "+strings.Join(counts, " + "), userID)
+	return count, err
+}
+
+func (r *PostgresRepository) CreateExport(ctx context.Context, export *archive.Export) error {
+	query := `
+		INSERT INTO data_exports (
+			id, user_id, status, file_name, size, records, error,
+			started_at, completed_at, expires_at, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :status, :file_name, :size, :records, :error,
+			:started_at, :completed_at, :expires_at, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, export)
+	return err
+}
+
+func (r *PostgresRepository) GetExportByID(ctx context.Context, id uuid.UUID) (*archive.Export, error) {
+	var export archive.Export
+	query := `SELECT ` + exportColumns + ` FROM data_exports WHERE id = $1`
+	err := r.db.GetContext(ctx, &export, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("export not found")
+		}
+		return nil, err
+	}
+	return &export, nil
+}
+
+func (r *PostgresRepository) GetExportsByUserID(ctx context.Context, userID uuid.UUID) ([]*archive.Export, error) {
+	var exports []*archive.Export
+	query := `SELECT ` + exportColumns + ` FROM data_exports WHERE user_id = $1 ORDER BY created_at DESC`
+	err := r.db.SelectContext(ctx, &exports, query, userID)
+	return exports, err
+}
+
+func (r *PostgresRepository) UpdateExport(ctx context.Context, export *archive.Export) error {
+	query := `
+		UPDATE data_exports SET
+			status = :status,
+			file_name = :file_name,
+			size = :size,
+			records = :records,
+			error = :error,
+			started_at = :started_at,
+			completed_at = :completed_at,
+			expires_at = :expires_at,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, export)
+	return err
+}
+
+func (r *PostgresRepository) ClaimPending(ctx context.Context, staleBefore time.Time) (*archive.Export, error) {
+	var export archive.Export
+	query := `
+		UPDATE data_exports SET status = $1, started_at = $2, updated_at = $2
+		WHERE id = (
+			SELECT id FROM data_exports
+			WHERE status = $3 OR (status = $1 AND started_at < $4)
+			ORDER BY created_at
+			LIMIT 1
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + exportColumns
+	err := r.db.GetContext(ctx, &export, query, archive.Running, time.Now(), archive.Pending, staleBefore)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &export, nil
+}
+
+func (r *PostgresRepository) DeleteExpired(ctx context.Context, t time.Time) ([]*archive.Export, error) {
+	var exports []*archive.Export
+	query := `DELETE FROM data_exports WHERE expires_at < $1 RETURNING ` + exportColumns
+	err := r.db.SelectContext(ctx, &exports, query, t)
+	return exports, err
+}
+
+// readTable reads the rows of a query as records. Values are kept as JSON friendly
+// types: decimals as numbers without losing precision, JSON columns as raw JSON and
+// identifiers and text as strings. The omitted column, if any, is left out.
+func readTable(ctx context.Context, tx *sqlx.Tx, query string, userID uuid.UUID, omit string) ([]string, []archive.Record, error) {
+	rows, err := tx.QueryContext(ctx, query, userID)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return nil, nil, err
+	}
+	types, err := rows.ColumnTypes()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	records := []archive.Record{}
+	values := make([]interface{}, len(columns))
+	pointers := make([]interface{}, len(columns))
+	for i := range values {
+		pointers[i] = &values[i]
+	}
+	for rows.Next() {
+		if err := rows.Scan(pointers...); err != nil {
+			return nil, nil, err
+		}
+		record := make(archive.Record, len(columns))
+		for i, column := range columns {
+			if column != omit {
+				record[column] = recordValue(values[i], types[i].DatabaseTypeName())
+			}
+		}
+		records = append(records, record)
+	}
+
+	kept := make([]string, 0, len(columns))
+	for _, column := range columns {
+		if column != omit {
+			kept = append(kept, column)
+		}
+	}
+	return kept, records, rows.Err()
+}
+
+func recordValue(value interface{}, databaseType string) interface{} {
+	raw, ok := value.([]byte)
+	if !ok {
+		return value
+	}
+	switch databaseType {
+	case "NUMERIC":
+		return json.Number(string(raw))
+	case "JSON", "JSONB":
+		return json.RawMessage(append([]byte(nil), raw...))
+	default:
+		return string(raw)
+	}
+}
@@ -0,0 +1,245 @@
+package archiverepo
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"siyahsensei/wallet-service/domain/archive"
+	"siyahsensei/wallet-service/domain/endpoint"
+	"siyahsensei/wallet-service/domain/webhook"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+func (r *PostgresRepository) Restore(ctx context.Context, userID uuid.UUID, a *archive.Archive, mode archive.Mode) (*archive.RestoreResult, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	if mode == archive.Replace {
+		if err := deleteUserData(ctx, tx, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &archive.RestoreResult{Mode: mode}
+	// ids maps every identifier of the archive to the one it gets on restore, and skipped
+	// holds the new identifiers of rows that were left out
+	ids := make(map[uuid.UUID]uuid.UUID)
+	skipped := make(map[uuid.UUID]bool)
+	if err := resolveDefinitions(ctx, tx, userID, a.Tables["definitions"], ids, skipped, result); err != nil {
+		return nil, err
+	}
+
+	for _, table := range archive.Tables {
+		if table == "definitions" {
+			continue
+		}
+		count, err := restoreTable(ctx, tx, userID, table, a.Tables[table], ids, skipped, result)
+		if err != nil {
+			return nil, err
+		}
+		result.Tables = append(result.Tables, count)
+	}
+
+	// The archived quantities are not trusted: an edited archive, or one whose
+	// transactions were partly skipped, would leave them out of step with the ledger
+	for _, record := range a.Tables["assets"] {
+		oldID, err := uuid.Parse(record.Text("id"))
+		if err != nil {
+			continue
+		}
+		id, ok := ids[oldID]
+		if !ok || skipped[id] {
+			continue
+		}
+		if _, err := transactionrepo.SyncAssetQuantity(ctx, tx, id); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// deleteUserData removes every row of the user, children first. The profile and the
+// shared definitions stay.
+func deleteUserData(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID) error {
+	for i := len(archive.Tables) - 1; i >= 0; i-- {
+		table := archive.Tables[i]
+		switch table {
+		case "definitions":
+			continue
+		case "recurring_occurrences":
+			query := `DELETE FROM recurring_occurrences WHERE rule_id IN (SELECT id FROM recurring_rules WHERE user_id = $1)`
+			if _, err := tx.ExecContext(ctx, query, userID); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+pq.QuoteIdentifier(table)+" WHERE user_id = $1", userID); err != nil {
+			return fmt.Errorf("clearing %s: %w", table, err)
+		}
+	}
+	return nil
+}
+
+// resolveDefinitions points the definitions of the archive at the existing definitions
+// with the same abbreviation, the shared ones first and then the user's own. A definition
+// this instance lacks is created for the user alone, so a restore never adds to the
+// definitions everyone shares.
+func resolveDefinitions(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, records []archive.Record, ids map[uuid.UUID]uuid.UUID, skipped map[uuid.UUID]bool, result *archive.RestoreResult) error {
+	var abbreviations []string
+	for _, record := range records {
+		abbreviations = append(abbreviations, strings.ToUpper(record.Text("abbreviation")))
+	}
+
+	var existing []struct {
+		ID           uuid.UUID `db:"id"`
+		Abbreviation string    `db:"abbreviation"`
+	}
+	query := `SELECT id, UPPER(abbreviation) AS abbreviation FROM definitions
+		WHERE UPPER(abbreviation) = ANY($1) AND (user_id IS NULL OR user_id = $2)
+		ORDER BY user_id NULLS LAST`
+	if err := tx.SelectContext(ctx, &existing, query, pq.Array(abbreviations), userID); err != nil {
+		return err
+	}
+	byAbbreviation := make(map[string]uuid.UUID)
+	for _, d := range existing {
+		if _, ok := byAbbreviation[d.Abbreviation]; !ok {
+			byAbbreviation[d.Abbreviation] = d.ID
+		}
+	}
+
+	var missing []archive.Record
+	for i, record := range records {
+		oldID, err := uuid.Parse(record.Text("id"))
+		if err != nil {
+			return fmt.Errorf("definitions: invalid id %v", record["id"])
+		}
+		abbreviation := abbreviations[i]
+		if abbreviation == "" {
+			return fmt.Errorf("definitions: missing abbreviation for %s", oldID)
+		}
+
+		id, ok := byAbbreviation[abbreviation]
+		if !ok {
+			// Written like any other row, so the user becomes the owner and the
+			// definition gets a new ID
+			definition := make(archive.Record, len(record)+1)
+			for column, value := range record {
+				definition[column] = value
+			}
+			definition["user_id"] = userID.String()
+			missing = append(missing, definition)
+			continue
+		}
+		ids[oldID] = id
+		result.DefinitionsMatched++
+	}
+
+	count, err := restoreTable(ctx, tx, userID, "definitions", missing, ids, skipped, result)
+	if err != nil {
+		return err
+	}
+	result.DefinitionsCreated = count.Restored
+	return nil
+}
+
+// restoreTable inserts the rows of one table. Only columns the table has are written, the
+// user becomes the owner, and the id column and every other *_id column is remapped so
+// references between restored rows hold. Rows that clash with a row the user already has
+// are skipped, and so are the rows referencing a skipped row, which would otherwise point
+// at nothing. Rows whose webhook URL fails endpoint.Validate are skipped the same way. A
+// secret column gets a newly generated secret rather than the archived one, returned in
+// the result for the rows that deliver to a URL.
+func restoreTable(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, table string, records []archive.Record, ids map[uuid.UUID]uuid.UUID, skipped map[uuid.UUID]bool, result *archive.RestoreResult) (*archive.TableCount, error) {
+	count := &archive.TableCount{Table: table}
+	if len(records) == 0 {
+		return count, nil
+	}
+
+	var columns []string
+	query := `SELECT column_name FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = $1`
+	if err := tx.SelectContext(ctx, &columns, query, table); err != nil {
+		return nil, err
+	}
+	sort.Strings(columns)
+
+	for _, record := range records {
+		var names, placeholders []string
+		var values []interface{}
+		var rowID uuid.UUID
+		var secret, url string
+		orphan := false
+		for _, column := range columns {
+			value, ok := record[column]
+			if column == secretColumns[table] {
+				var err error
+				if secret, err = webhook.NewSecret(); err != nil {
+					return nil, err
+				}
+				value, ok = secret, true
+			}
+			if column == urlColumns[table] {
+				url = record.Text(column)
+			}
+			if !ok {
+				continue
+			}
+			value, err := archive.RestoreValue(column, value, userID, ids)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", table, err)
+			}
+			if id, ok := value.(uuid.UUID); ok {
+				if column == "id" {
+					rowID = id
+				} else if skipped[id] {
+					orphan = true
+				}
+			}
+			names = append(names, pq.QuoteIdentifier(column))
+			values = append(values, value)
+			placeholders = append(placeholders, fmt.Sprintf("$%d", len(values)))
+		}
+
+		if url != "" && endpoint.Validate(ctx, url) != nil {
+			orphan = true
+		}
+		if orphan {
+			skipped[rowID] = true
+			count.Skipped++
+			continue
+		}
+
+		insert := "INSERT INTO " + pq.QuoteIdentifier(table) + " (" + strings.Join(names, ", ") + ") VALUES (" +
+			strings.Join(placeholders, ", ") + ") ON CONFLICT DO NOTHING"
+		res, err := tx.ExecContext(ctx, insert, values...)
+		if err != nil {
+			return nil, fmt.Errorf("restoring %s: %w", table, err)
+		}
+		affected, err := res.RowsAffected()
+		if err != nil {
+			return nil, err
+		}
+		if affected == 0 {
+			skipped[rowID] = true
+			count.Skipped++
+		} else {
+			count.Restored++
+			if url != "" {
+				result.Secrets = append(result.Secrets, &archive.RestoredSecret{Table: table, ID: rowID, Secret: secret})
+			}
+		}
+	}
+	return count, nil
+}
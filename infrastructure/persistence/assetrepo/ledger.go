@@ -0,0 +1,60 @@
+package assetrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/asset"
+)
+
+// FindOrCreate returns, inside tx, the oldest asset of the user holding the definition in
+// the account, creating an empty one of the given type when there is none. The asset row
+// is locked so concurrent postings to it serialise.
+func FindOrCreate(ctx context.Context, tx *sqlx.Tx, userID, accountID, definitionID uuid.UUID, assetType asset.AssetType, date time.Time) (uuid.UUID, error) {
+	return findOrCreate(ctx, tx, userID, accountID, definitionID, assetType, false, asset.FIFO, date)
+}
+
+// FindOrCreateOfType is FindOrCreate restricted to assets of the given type, for callers
+// that must not post into another holding of the same definition in the account.
+func FindOrCreateOfType(ctx context.Context, tx *sqlx.Tx, userID, accountID, definitionID uuid.UUID, assetType asset.AssetType, date time.Time) (uuid.UUID, error) {
+	return findOrCreate(ctx, tx, userID, accountID, definitionID, assetType, true, asset.FIFO, date)
+}
+
+func findOrCreate(ctx context.Context, tx *sqlx.Tx, userID, accountID, definitionID uuid.UUID, assetType asset.AssetType, sameType bool, method asset.CostBasisMethod, date time.Time) (uuid.UUID, error) {
+	var assetID uuid.UUID
+	query := `
+		SELECT id
+		FROM assets
+		WHERE account_id = $1 AND definition_id = $2 AND user_id = $3 AND (NOT $4 OR type = $5)
+		ORDER BY created_at ASC
+		LIMIT 1
+		FOR UPDATE
+	`
+	err := tx.GetContext(ctx, &assetID, query, accountID, definitionID, userID, sameType, assetType)
+	if err == nil {
+		return assetID, nil
+	}
+	if !errors.Is(err, sql.ErrNoRows) {
+		return uuid.Nil, err
+	}
+
+	now := time.Now()
+	assetID = uuid.New()
+	insertQuery := `
+		INSERT INTO assets (
+			id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, cost_basis_method, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, 0, '', $6, $7, $8, $8
+		)
+	`
+	_, err = tx.ExecContext(ctx, insertQuery, assetID, userID, accountID, definitionID, assetType, date, method, now)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return assetID, nil
+}
@@ -8,8 +8,12 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
+	"siyahsensei/wallet-service/domain/account"
 	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
 )
 
 type PostgresRepository struct {
@@ -23,14 +27,21 @@ func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
 }
 
 func (r *PostgresRepository) Create(ctx context.Context, a *asset.Asset) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
 	query := `
 		INSERT INTO assets (
-			id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, created_at, updated_at
+			id, user_id, account_id, definition_id, type, quantity, notes, purchase_date,
+			purchase_price, purchase_currency, cost_basis_method, created_at, updated_at
 		) VALUES (
-			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10
+			$1, $2, $3, $4, $5, 0, $6, $7, $8, $9, $10, $11, $12
 		)
 	`
-	_, err := r.db.ExecContext(
+	_, err = tx.ExecContext(
 		ctx,
 		query,
 		a.ID,
@@ -38,18 +49,31 @@ func (r *PostgresRepository) Create(ctx context.Context, a *asset.Asset) error {
 		a.AccountID,
 		a.DefinitionID,
 		a.Type,
-		a.Quantity,
 		a.Notes,
 		a.PurchaseDate,
+		a.PurchasePrice,
+		a.PurchaseCurrency,
+		a.CostBasisMethod,
 		a.CreatedAt,
 		a.UpdatedAt,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	// The initial quantity enters the ledger like any other movement
+	if a.Quantity != 0 {
+		opening := transaction.NewOpeningTransaction(a.UserID, a.ID, a.Quantity, a.PurchasePrice, a.PurchaseCurrency, a.PurchaseDate)
+		if _, err := transactionrepo.Post(ctx, tx, opening); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
 }
 
 func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*asset.Asset, error) {
 	query := `
-		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, created_at, updated_at
+		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, purchase_price, purchase_currency, cost_basis_method, created_at, updated_at
 		FROM assets
 		WHERE id = $1
 	`
@@ -66,7 +90,7 @@ func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*asset.
 
 func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*asset.Asset, error) {
 	query := `
-		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, created_at, updated_at
+		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, purchase_price, purchase_currency, cost_basis_method, created_at, updated_at
 		FROM assets
 		WHERE user_id = $1
 		ORDER BY created_at DESC
@@ -81,7 +105,7 @@ func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID)
 
 func (r *PostgresRepository) GetByAccountID(ctx context.Context, accountID uuid.UUID) ([]*asset.Asset, error) {
 	query := `
-		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, created_at, updated_at
+		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, purchase_price, purchase_currency, cost_basis_method, created_at, updated_at
 		FROM assets
 		WHERE account_id = $1
 		ORDER BY created_at DESC
@@ -96,7 +120,7 @@ func (r *PostgresRepository) GetByAccountID(ctx context.Context, accountID uuid.
 
 func (r *PostgresRepository) GetByType(ctx context.Context, userID uuid.UUID, assetType asset.AssetType) ([]*asset.Asset, error) {
 	query := `
-		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, created_at, updated_at
+		SELECT id, user_id, account_id, definition_id, type, quantity, notes, purchase_date, purchase_price, purchase_currency, cost_basis_method, created_at, updated_at
 		FROM assets
 		WHERE user_id = $1 AND type = $2
 		ORDER BY created_at DESC
@@ -109,11 +133,13 @@ func (r *PostgresRepository) GetByType(ctx context.Context, userID uuid.UUID, as
 	return assets, nil
 }
 
+// Update changes the descriptive fields of an asset. Quantity is derived from
+// the transactions ledger and is never written here.
 func (r *PostgresRepository) Update(ctx context.Context, a *asset.Asset) error {
 	a.UpdatedAt = time.Now()
 	query := `
 		UPDATE assets
-		SET account_id = $1, definition_id = $2, type = $3, quantity = $4, notes = $5, purchase_date = $6, updated_at = $7
+		SET account_id = $1, definition_id = $2, type = $3, notes = $4, purchase_date = $5, cost_basis_method = $6, updated_at = $7
 		WHERE id = $8
 	`
 	result, err := r.db.ExecContext(
@@ -122,9 +148,9 @@ func (r *PostgresRepository) Update(ctx context.Context, a *asset.Asset) error {
 		a.AccountID,
 		a.DefinitionID,
 		a.Type,
-		a.Quantity,
 		a.Notes,
 		a.PurchaseDate,
+		a.CostBasisMethod,
 		a.UpdatedAt,
 		a.ID,
 	)
@@ -160,31 +186,72 @@ func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
 	return nil
 }
 
+// Transfer posts the withdraw, fee and deposit legs of a transfer in a single DB
+// transaction. The target asset is the oldest one holding the same definition in
+// the target account and is created when the account has none yet.
+func (r *PostgresRepository) Transfer(ctx context.Context, t *asset.Transfer) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	// A target created here keeps the cost basis method of the source so the carried
+	// lots are relieved the same way after the transfer
+	var method asset.CostBasisMethod
+	err = tx.GetContext(ctx, &method, `SELECT cost_basis_method FROM assets WHERE id = $1`, t.SourceAssetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("asset not found")
+		}
+		return err
+	}
+	t.TargetAssetID, err = findOrCreate(ctx, tx, t.UserID, t.TargetAccountID, t.DefinitionID, t.Type, false, method, t.TransferDate)
+	if err != nil {
+		return err
+	}
+
+	// The withdraw leg relieves lots on the source, the deposit leg re-opens them on the
+	// target with their original cost and acquisition date so the basis moves with the holding
+	withdraw := transaction.NewTransferLeg(t.ID, t.UserID, t.SourceAssetID, transaction.Withdraw, t.Quantity, t.Notes, t.TransferDate)
+	relieved, err := transactionrepo.Post(ctx, tx, withdraw)
+	if err != nil {
+		return err
+	}
+
+	if t.Fee > 0 {
+		fee := transaction.NewTransferLeg(t.ID, t.UserID, t.SourceAssetID, transaction.Fee, t.Fee, t.Notes, t.TransferDate)
+		if _, err := transactionrepo.Post(ctx, tx, fee); err != nil {
+			return err
+		}
+	}
+
+	deposit := transaction.NewTransferLeg(t.ID, t.UserID, t.TargetAssetID, transaction.Deposit, t.Quantity, t.Notes, t.TransferDate)
+	if err := transactionrepo.PostCarried(ctx, tx, deposit, relieved); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// GetTotalValue sums the quantities of the user's assets, counting liabilities (debts
+// and balances on credit card accounts) against the total.
 func (r *PostgresRepository) GetTotalValue(ctx context.Context, userID uuid.UUID, assetTypes []asset.AssetType) (float64, error) {
-	var query string
-	var args []interface{}
-
-	if len(assetTypes) == 0 {
-		// Get total value for all asset types
-		query = `
-			SELECT COALESCE(SUM(quantity), 0) as total_value
-			FROM assets
-			WHERE user_id = $1
-		`
-		args = []interface{}{userID}
-	} else {
-		// Get total value for specific asset types
-		query = `
-			SELECT COALESCE(SUM(quantity), 0) as total_value
-			FROM assets
-			WHERE user_id = $1 AND type = ANY($2)
-		`
+	query := `
+		SELECT COALESCE(SUM(CASE WHEN a.type = $2 OR acc.account_type = $3 THEN -a.quantity ELSE a.quantity END), 0) as total_value
+		FROM assets a
+		JOIN accounts acc ON acc.id = a.account_id
+		WHERE a.user_id = $1
+	`
+	args := []interface{}{userID, string(asset.Debt), string(account.CreditCard)}
+
+	if len(assetTypes) > 0 {
 		// Convert AssetType slice to string slice for PostgreSQL array
 		typeStrings := make([]string, len(assetTypes))
 		for i, t := range assetTypes {
 			typeStrings[i] = string(t)
 		}
-		args = []interface{}{userID, typeStrings}
+		query += " AND a.type = ANY($4)"
+		args = append(args, pq.Array(typeStrings))
 	}
 
 	var totalValue float64
@@ -195,56 +262,137 @@ func (r *PostgresRepository) GetTotalValue(ctx context.Context, userID uuid.UUID
 	return totalValue, nil
 }
 
+// GetAssetPerformance loads, per asset held at the end of the period or sold within it,
+// the quantity held at the end rebuilt from the ledger, the cost of the lot quantities
+// still open then, the cost and proceeds of lots sold in the period and the latest price
+// of the definition up to the end of the period. Costs and proceeds are kept per currency.
+// Price history in a currency of the asset's lots is preferred, then any stored price,
+// then the user's own last trade price.
 func (r *PostgresRepository) GetAssetPerformance(ctx context.Context, userID uuid.UUID, startDate, endDate time.Time) ([]*asset.AssetPerformance, error) {
-	// This is a simplified implementation. In a real-world scenario, you would need
-	// additional tables for price history, market data, etc.
-	query := `
-		SELECT 
-			a.id as asset_id,
-			d.name,
-			d.abbreviation as symbol,
-			a.type,
-			a.quantity as initial_value,
-			a.quantity as current_value,
-			0 as profit_loss,
-			0 as profit_loss_perc,
-			d.abbreviation as currency
+	holdingsQuery := `
+		SELECT a.id, d.name, d.abbreviation, a.type, q.quantity, p.price, p.currency
 		FROM assets a
 		JOIN definitions d ON a.definition_id = d.id
-		WHERE a.user_id = $1 
-		AND a.purchase_date BETWEEN $2 AND $3
-		ORDER BY a.purchase_date DESC
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(` + transactionrepo.SignedQuantity + `), 0) AS quantity
+			FROM transactions
+			WHERE asset_id = a.id AND transaction_date <= $2
+		) q
+		LEFT JOIN LATERAL (
+			SELECT price, currency
+			FROM (
+				SELECT ph.price, ph.quote_currency AS currency, ph.priced_at AS priced_at,
+					CASE WHEN ph.quote_currency IN (SELECT currency FROM lots WHERE asset_id = a.id) THEN 0 ELSE 1 END AS preference
+				FROM price_history ph
+				WHERE ph.definition_id = a.definition_id
+				AND ph.priced_at <= $2
+				UNION ALL
+				SELECT t.price, t.currency, t.transaction_date, 2
+				FROM transactions t
+				JOIN assets ta ON ta.id = t.asset_id
+				WHERE ta.user_id = a.user_id
+				AND ta.definition_id = a.definition_id
+				AND t.type IN ('BUY', 'SELL')
+				AND t.price > 0
+				AND t.transaction_date <= $2
+			) candidates
+			ORDER BY preference ASC, priced_at DESC
+			LIMIT 1
+		) p ON true
+		WHERE a.user_id = $1
+		ORDER BY d.name ASC
 	`
-
-	rows, err := r.db.QueryContext(ctx, query, userID, startDate, endDate)
+	rows, err := r.db.QueryContext(ctx, holdingsQuery, userID, endDate)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
 
 	var performances []*asset.AssetPerformance
+	byAsset := make(map[uuid.UUID]*asset.AssetPerformance)
 	for rows.Next() {
 		var perf asset.AssetPerformance
-		err := rows.Scan(
-			&perf.AssetID,
-			&perf.Name,
-			&perf.Symbol,
-			&perf.Type,
-			&perf.InitialValue,
-			&perf.CurrentValue,
-			&perf.ProfitLoss,
-			&perf.ProfitLossPerc,
-			&perf.Currency,
-		)
+		var price sql.NullFloat64
+		var currency sql.NullString
+		err := rows.Scan(&perf.AssetID, &perf.Name, &perf.Symbol, &perf.Type, &perf.Quantity, &price, &currency)
 		if err != nil {
 			return nil, err
 		}
+		if price.Valid {
+			perf.Priced = true
+			perf.CurrentPrice = price.Float64
+			perf.Currency = currency.String
+		}
 		performances = append(performances, &perf)
+		byAsset[perf.AssetID] = &perf
 	}
-
 	if err = rows.Err(); err != nil {
 		return nil, err
 	}
 
-	return performances, nil
+	// A lot counts from the date of the transaction that opened it, so lots carried over by
+	// a transfer are not counted on both sides before the transfer date
+	var open []struct {
+		AssetID  uuid.UUID `db:"asset_id"`
+		Currency string    `db:"currency"`
+		OpenCost float64   `db:"open_cost"`
+	}
+	openQuery := `
+		SELECT l.asset_id, l.currency, SUM((l.quantity - COALESCE(r.quantity, 0)) * l.unit_price) AS open_cost
+		FROM lots l
+		LEFT JOIN transactions lt ON lt.id = l.transaction_id
+		LEFT JOIN (
+			SELECT lot_id, SUM(quantity) AS quantity
+			FROM lot_disposals
+			WHERE user_id = $1 AND disposed_at <= $2
+			GROUP BY lot_id
+		) r ON r.lot_id = l.id
+		WHERE l.user_id = $1 AND COALESCE(lt.transaction_date, l.acquired_at) <= $2
+		GROUP BY l.asset_id, l.currency
+	`
+	if err := r.db.SelectContext(ctx, &open, openQuery, userID, endDate); err != nil {
+		return nil, err
+	}
+	for _, o := range open {
+		if perf, ok := byAsset[o.AssetID]; ok {
+			perf.Add(asset.PerformanceAmount{Currency: o.Currency, OpenCost: o.OpenCost})
+		}
+	}
+
+	var sold []struct {
+		AssetID          uuid.UUID `db:"asset_id"`
+		Currency         string    `db:"currency"`
+		ProceedsCurrency string    `db:"proceeds_currency"`
+		SoldCost         float64   `db:"sold_cost"`
+		Proceeds         float64   `db:"proceeds"`
+	}
+	soldQuery := `
+		SELECT ld.asset_id, ld.currency, COALESCE(NULLIF(t.currency, ''), ld.currency) AS proceeds_currency,
+			SUM(ld.quantity * ld.unit_cost) AS sold_cost, SUM(ld.quantity * ld.unit_proceeds) AS proceeds
+		FROM lot_disposals ld
+		JOIN transactions t ON t.id = ld.transaction_id
+		WHERE ld.user_id = $1 AND ld.kind = 'SALE' AND ld.disposed_at BETWEEN $2 AND $3
+		GROUP BY ld.asset_id, ld.currency, COALESCE(NULLIF(t.currency, ''), ld.currency)
+	`
+	if err := r.db.SelectContext(ctx, &sold, soldQuery, userID, startDate, endDate); err != nil {
+		return nil, err
+	}
+	soldAssets := make(map[uuid.UUID]bool)
+	for _, s := range sold {
+		perf, ok := byAsset[s.AssetID]
+		if !ok {
+			continue
+		}
+		soldAssets[s.AssetID] = true
+		perf.Add(asset.PerformanceAmount{Currency: s.Currency, SoldCost: s.SoldCost})
+		perf.Add(asset.PerformanceAmount{Currency: s.ProceedsCurrency, Proceeds: s.Proceeds})
+	}
+
+	held := performances[:0]
+	for _, perf := range performances {
+		if perf.Quantity > 0 || soldAssets[perf.AssetID] {
+			held = append(held, perf)
+		}
+	}
+	return held, nil
 }
@@ -0,0 +1,86 @@
+package bondrepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/bond"
+)
+
+const bondColumns = `id, user_id, asset_id, face_value, currency, coupon_rate, coupon_frequency, issue_date, maturity_date,
+	day_count, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, b *bond.Bond) error {
+	query := `
+		INSERT INTO bonds (
+			id, user_id, asset_id, face_value, currency, coupon_rate, coupon_frequency, issue_date, maturity_date,
+			day_count, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :face_value, :currency, :coupon_rate, :coupon_frequency, :issue_date, :maturity_date,
+			:day_count, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, b)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, b *bond.Bond) error {
+	query := `
+		UPDATE bonds SET
+			face_value = :face_value,
+			currency = :currency,
+			coupon_rate = :coupon_rate,
+			coupon_frequency = :coupon_frequency,
+			issue_date = :issue_date,
+			maturity_date = :maturity_date,
+			day_count = :day_count,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, b)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM bonds WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*bond.Bond, error) {
+	var b bond.Bond
+	err := r.db.GetContext(ctx, &b, "SELECT "+bondColumns+" FROM bonds WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) (*bond.Bond, error) {
+	var b bond.Bond
+	err := r.db.GetContext(ctx, &b, "SELECT "+bondColumns+" FROM bonds WHERE asset_id = $1", assetID)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*bond.Bond, error) {
+	var bonds []*bond.Bond
+	err := r.db.SelectContext(ctx, &bonds, "SELECT "+bondColumns+" FROM bonds WHERE user_id = $1 ORDER BY maturity_date ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return bonds, nil
+}
@@ -0,0 +1,45 @@
+package capitalgainsrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/capitalgains"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) GetSales(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*capitalgains.Sale, error) {
+	query := `
+		SELECT d.id, d.user_id, d.lot_id, d.asset_id, d.transaction_id, d.kind, d.quantity, d.unit_cost, d.unit_proceeds,
+			d.currency, d.acquired_at, d.disposed_at, d.created_at,
+			def.abbreviation AS symbol, def.name AS asset_name, a.type AS asset_type, acc.name AS account_name,
+			t.currency AS proceeds_currency
+		FROM lot_disposals d
+		JOIN transactions t ON t.id = d.transaction_id
+		JOIN assets a ON a.id = d.asset_id
+		JOIN definitions def ON def.id = a.definition_id
+		JOIN accounts acc ON acc.id = a.account_id
+		WHERE d.user_id = $1 AND d.kind = 'SALE'
+			AND d.disposed_at >= $2 AND d.disposed_at < $3
+		ORDER BY d.disposed_at ASC, d.created_at ASC
+	`
+
+	var sales []*capitalgains.Sale
+	err := r.db.SelectContext(ctx, &sales, query, userID, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return sales, nil
+}
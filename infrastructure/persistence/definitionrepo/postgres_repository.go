@@ -9,6 +9,7 @@ import (
 
 	"github.com/google/uuid"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
 
 	"siyahsensei/wallet-service/domain/definition"
 )
@@ -65,6 +66,7 @@ func (r *PostgresRepository) GetAll(ctx context.Context, limit, offset int) ([]*
 	query := `
 		SELECT id, name, abbreviation, suffix, created_at, updated_at
 		FROM definitions
+		WHERE user_id IS NULL
 		ORDER BY name ASC
 		LIMIT $1 OFFSET $2
 	`
@@ -76,6 +78,25 @@ func (r *PostgresRepository) GetAll(ctx context.Context, limit, offset int) ([]*
 	return definitions, nil
 }
 
+func (r *PostgresRepository) GetByAbbreviations(ctx context.Context, abbreviations []string) ([]*definition.Definition, error) {
+	upper := make([]string, 0, len(abbreviations))
+	for _, abbreviation := range abbreviations {
+		upper = append(upper, strings.ToUpper(abbreviation))
+	}
+
+	query := `
+		SELECT id, name, abbreviation, suffix, created_at, updated_at
+		FROM definitions
+		WHERE UPPER(abbreviation) = ANY($1) AND user_id IS NULL
+	`
+	var definitions []*definition.Definition
+	err := r.db.SelectContext(ctx, &definitions, query, pq.Array(upper))
+	if err != nil {
+		return nil, err
+	}
+	return definitions, nil
+}
+
 func (r *PostgresRepository) Update(ctx context.Context, def *definition.Definition) error {
 	def.UpdatedAt = time.Now()
 	query := `
@@ -129,7 +150,7 @@ func (r *PostgresRepository) Search(ctx context.Context, searchTerm string, limi
 	query := `
 		SELECT id, name, abbreviation, suffix, created_at, updated_at
 		FROM definitions
-		WHERE LOWER(name) LIKE $1 OR LOWER(abbreviation) LIKE $1 AND type = $2
+		WHERE user_id IS NULL AND (LOWER(name) LIKE $1 OR LOWER(abbreviation) LIKE $1 AND type = $2)
 		ORDER BY 
 			CASE 
 				WHEN LOWER(abbreviation) = LOWER($2) THEN 1
@@ -0,0 +1,98 @@
+package eventrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/event"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// outboxRow is the stored form of an event with its data as JSON.
+type outboxRow struct {
+	ID         uuid.UUID  `db:"id"`
+	UserID     uuid.UUID  `db:"user_id"`
+	Type       event.Type `db:"event_type"`
+	Data       []byte     `db:"data"`
+	OccurredAt time.Time  `db:"occurred_at"`
+}
+
+// Insert writes events to the outbox inside tx, so they are kept only if the change they
+// describe is committed.
+func Insert(ctx context.Context, tx *sqlx.Tx, events ...*event.Event) error {
+	query := `
+		INSERT INTO event_outbox (
+			id, user_id, event_type, data, occurred_at
+		) VALUES (
+			$1, $2, $3, $4, $5
+		)
+	`
+	for _, e := range events {
+		data, err := json.Marshal(e.Data)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.ExecContext(ctx, query, e.ID, e.UserID, e.Type, data, e.OccurredAt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Relay locks the oldest pending events so concurrent relays never hand out the same one.
+func (r *PostgresRepository) Relay(ctx context.Context, limit int, publish func(ctx context.Context, e *event.Event) error) (int, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	query := `
+		SELECT id, user_id, event_type, data, occurred_at
+		FROM event_outbox
+		ORDER BY occurred_at ASC, id ASC
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	var rows []outboxRow
+	if err := tx.SelectContext(ctx, &rows, query, limit); err != nil {
+		return 0, err
+	}
+
+	relayed := 0
+	var publishErr error
+	for _, row := range rows {
+		e := &event.Event{
+			ID:         row.ID,
+			Type:       row.Type,
+			UserID:     row.UserID,
+			OccurredAt: row.OccurredAt,
+			Data:       json.RawMessage(row.Data),
+		}
+		if publishErr = publish(ctx, e); publishErr != nil {
+			break
+		}
+		if _, err := tx.ExecContext(ctx, `DELETE FROM event_outbox WHERE id = $1`, row.ID); err != nil {
+			return 0, err
+		}
+		relayed++
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, err
+	}
+	return relayed, publishErr
+}
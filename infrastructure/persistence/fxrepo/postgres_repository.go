@@ -0,0 +1,59 @@
+package fxrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/fx"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) BulkUpsert(ctx context.Context, rates []*fx.Rate) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO fx_rates (id, base_currency, quote_currency, rate, observed_at, source, created_at)
+		VALUES (:id, :base_currency, :quote_currency, :rate, :observed_at, :source, :created_at)
+		ON CONFLICT (base_currency, quote_currency, observed_at, source)
+		DO UPDATE SET rate = EXCLUDED.rate
+	`
+	for _, rate := range rates {
+		if _, err := tx.NamedExecContext(ctx, query, rate); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetLatestRates(ctx context.Context, at time.Time) ([]*fx.Rate, error) {
+	query := `
+		SELECT DISTINCT ON (base_currency, quote_currency)
+			id, base_currency, quote_currency, rate, observed_at, source, created_at
+		FROM fx_rates
+		WHERE observed_at <= $1
+		ORDER BY base_currency, quote_currency, observed_at DESC, created_at DESC
+	`
+
+	var rates []*fx.Rate
+	err := r.db.SelectContext(ctx, &rates, query, at)
+	if err != nil {
+		return nil, err
+	}
+	return rates, nil
+}
@@ -0,0 +1,221 @@
+package importerrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/importer"
+	"siyahsensei/wallet-service/infrastructure/persistence/assetrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Import(ctx context.Context, userID uuid.UUID, rows []*importer.Row, notes string, commit bool) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	failed, err := postRows(ctx, tx, userID, rows, notes, false)
+	if err != nil {
+		return false, err
+	}
+
+	if !commit || failed {
+		discard(rows)
+		return false, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *PostgresRepository) GetStatementAccount(ctx context.Context, userID uuid.UUID, accountKey string) (uuid.UUID, error) {
+	var accountID uuid.UUID
+	query := `SELECT account_id FROM statement_accounts WHERE user_id = $1 AND account_key = $2`
+	err := r.db.GetContext(ctx, &accountID, query, userID, accountKey)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return uuid.Nil, errors.New("statement account not found")
+		}
+		return uuid.Nil, err
+	}
+	return accountID, nil
+}
+
+func (r *PostgresRepository) ImportStatements(ctx context.Context, userID uuid.UUID, statements []*importer.Statement, notes string, commit bool) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	failed := false
+	for _, statement := range statements {
+		if statement.NewAccount != nil {
+			a := statement.NewAccount
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO accounts (
+					id, user_id, name, account_type, created_at, updated_at
+				) VALUES (
+					$1, $2, $3, $4, $5, $6
+				)
+			`, a.ID, a.UserID, a.Name, a.AccountType, a.CreatedAt, a.UpdatedAt)
+			if err != nil {
+				return false, err
+			}
+		}
+		if statement.AccountKey != "" {
+			now := time.Now()
+			_, err := tx.ExecContext(ctx, `
+				INSERT INTO statement_accounts (
+					id, user_id, account_key, account_id, created_at, updated_at
+				) VALUES (
+					$1, $2, $3, $4, $5, $5
+				)
+				ON CONFLICT (user_id, account_key)
+				DO UPDATE SET account_id = EXCLUDED.account_id, updated_at = EXCLUDED.updated_at
+			`, uuid.New(), userID, statement.AccountKey, statement.AccountID, now)
+			if err != nil {
+				return false, err
+			}
+		}
+
+		statementFailed, err := postRows(ctx, tx, userID, statement.Rows, notes, true)
+		if err != nil {
+			return false, err
+		}
+		failed = failed || statementFailed
+	}
+
+	if !commit || failed {
+		for _, statement := range statements {
+			discard(statement.Rows)
+			if statement.NewAccount != nil {
+				statement.AccountID = uuid.Nil
+				for _, row := range statement.Rows {
+					row.AccountID = uuid.Nil
+				}
+			}
+		}
+		return false, nil
+	}
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// postRows posts the valid rows in order and reports whether any row failed.
+func postRows(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, rows []*importer.Row, notes string, sameType bool) (bool, error) {
+	failed := false
+	for _, row := range rows {
+		if !row.Valid() {
+			failed = true
+			continue
+		}
+		if row.Skipped {
+			continue
+		}
+		if err := postRow(ctx, tx, userID, row, notes, sameType); err != nil {
+			return false, err
+		}
+		if !row.Valid() {
+			failed = true
+		}
+	}
+	return failed, nil
+}
+
+// discard forgets the IDs handed out to rows whose import was not kept.
+func discard(rows []*importer.Row) {
+	for _, row := range rows {
+		row.AssetID = nil
+		row.TransactionID = nil
+	}
+}
+
+// postRow posts one row behind a savepoint so that a row the ledger rejects for lack of
+// quantity is rolled back on its own and recorded on the row, leaving the transaction
+// usable. Any other error aborts the import. Statement lines already imported into the
+// account are skipped, as is an opening balance for a holding that already has history.
+func postRow(ctx context.Context, tx *sqlx.Tx, userID uuid.UUID, row *importer.Row, notes string, sameType bool) error {
+	if row.FITID != "" {
+		var imported bool
+		query := `SELECT EXISTS (SELECT 1 FROM statement_transactions WHERE account_id = $1 AND fitid = $2)`
+		if err := tx.GetContext(ctx, &imported, query, row.AccountID, row.FITID); err != nil {
+			return err
+		}
+		if imported {
+			row.Skip("already imported")
+			return nil
+		}
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT import_row"); err != nil {
+		return err
+	}
+
+	var assetID uuid.UUID
+	var err error
+	if sameType {
+		assetID, err = assetrepo.FindOrCreateOfType(ctx, tx, userID, row.AccountID, row.DefinitionID, row.AssetType, row.Date)
+	} else {
+		assetID, err = assetrepo.FindOrCreate(ctx, tx, userID, row.AccountID, row.DefinitionID, row.AssetType, row.Date)
+	}
+
+	if err == nil && row.Opening {
+		var history bool
+		query := `SELECT EXISTS (SELECT 1 FROM transactions WHERE asset_id = $1)`
+		if err = tx.GetContext(ctx, &history, query, assetID); err == nil && history {
+			row.Skip("holding already has a balance")
+			_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT import_row")
+			return err
+		}
+	}
+
+	if err == nil {
+		entry := row.Transaction(userID, assetID, notes)
+		if _, err = transactionrepo.Post(ctx, tx, entry); err == nil {
+			row.AssetID = &assetID
+			row.TransactionID = &entry.ID
+		}
+	}
+
+	if err == nil && row.FITID != "" {
+		_, err = tx.ExecContext(ctx, `
+			INSERT INTO statement_transactions (
+				id, user_id, account_id, fitid, transaction_id, created_at
+			) VALUES (
+				$1, $2, $3, $4, $5, $6
+			)
+		`, uuid.New(), userID, row.AccountID, row.FITID, *row.TransactionID, time.Now())
+	}
+
+	if errors.Is(err, transactionrepo.ErrInsufficientQuantity) {
+		row.Fail(err.Error())
+		_, err = tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT import_row")
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT import_row")
+	return err
+}
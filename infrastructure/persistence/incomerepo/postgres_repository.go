@@ -0,0 +1,118 @@
+package incomerepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/income"
+)
+
+// selectIncome reads income together with the symbol and type of its asset.
+const selectIncome = `
+	SELECT i.id, i.user_id, i.asset_id, i.type, i.gross_amount, i.withholding_tax, i.currency, i.pay_date,
+		COALESCE(i.notes, '') AS notes, d.abbreviation AS symbol, a.type AS asset_type, i.created_at, i.updated_at
+	FROM income i
+	JOIN assets a ON a.id = i.asset_id
+	JOIN definitions d ON d.id = a.definition_id
+`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, i *income.Income) error {
+	query := `
+		INSERT INTO income (
+			id, user_id, asset_id, type, gross_amount, withholding_tax, currency, pay_date, notes, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :type, :gross_amount, :withholding_tax, :currency, :pay_date, :notes, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, i)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, i *income.Income) error {
+	query := `
+		UPDATE income SET
+			type = :type,
+			gross_amount = :gross_amount,
+			withholding_tax = :withholding_tax,
+			currency = :currency,
+			pay_date = :pay_date,
+			notes = :notes,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, i)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM income WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*income.Income, error) {
+	var i income.Income
+	err := r.db.GetContext(ctx, &i, selectIncome+" WHERE i.id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &i, nil
+}
+
+func (r *PostgresRepository) Filter(ctx context.Context, query income.FilterIncomeQuery) ([]*income.Income, error) {
+	baseQuery := selectIncome + " WHERE i.user_id = $1"
+
+	var conditions []string
+	var args []interface{}
+	args = append(args, query.UserID)
+	argIndex := 2
+
+	if query.AssetID != nil {
+		conditions = append(conditions, fmt.Sprintf("i.asset_id = $%d", argIndex))
+		args = append(args, *query.AssetID)
+		argIndex++
+	}
+
+	if query.Type != nil {
+		conditions = append(conditions, fmt.Sprintf("i.type = $%d", argIndex))
+		args = append(args, *query.Type)
+		argIndex++
+	}
+
+	if query.From != nil {
+		conditions = append(conditions, fmt.Sprintf("i.pay_date >= $%d", argIndex))
+		args = append(args, *query.From)
+		argIndex++
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, fmt.Sprintf("i.pay_date <= $%d", argIndex))
+		args = append(args, *query.To)
+	}
+
+	if len(conditions) > 0 {
+		baseQuery += " AND " + strings.Join(conditions, " AND ")
+	}
+
+	baseQuery += " ORDER BY i.pay_date DESC, i.created_at DESC"
+
+	var records []*income.Income
+	err := r.db.SelectContext(ctx, &records, baseQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	return records, nil
+}
@@ -0,0 +1,164 @@
+package loanrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/loan"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+const loanColumns = `id, user_id, asset_id, principal, currency, annual_rate, term_periods, payment_frequency, method,
+	start_date, first_payment_date, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, l *loan.Loan) error {
+	query := `
+		INSERT INTO loans (
+			id, user_id, asset_id, principal, currency, annual_rate, term_periods, payment_frequency, method,
+			start_date, first_payment_date, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :principal, :currency, :annual_rate, :term_periods, :payment_frequency, :method,
+			:start_date, :first_payment_date, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, l)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, l *loan.Loan) error {
+	query := `
+		UPDATE loans SET
+			principal = :principal,
+			annual_rate = :annual_rate,
+			term_periods = :term_periods,
+			payment_frequency = :payment_frequency,
+			method = :method,
+			start_date = :start_date,
+			first_payment_date = :first_payment_date,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, l)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM loans WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*loan.Loan, error) {
+	var l loan.Loan
+	err := r.db.GetContext(ctx, &l, "SELECT "+loanColumns+" FROM loans WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) (*loan.Loan, error) {
+	var l loan.Loan
+	err := r.db.GetContext(ctx, &l, "SELECT "+loanColumns+" FROM loans WHERE asset_id = $1", assetID)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*loan.Loan, error) {
+	var loans []*loan.Loan
+	err := r.db.SelectContext(ctx, &loans, "SELECT "+loanColumns+" FROM loans WHERE user_id = $1 ORDER BY start_date ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return loans, nil
+}
+
+func (r *PostgresRepository) GetPayments(ctx context.Context, loanID uuid.UUID) ([]*loan.Payment, error) {
+	query := `
+		SELECT id, loan_id, user_id, paid_at, amount, principal, interest, from_asset_id, transaction_id,
+			COALESCE(notes, '') AS notes, created_at
+		FROM loan_payments
+		WHERE loan_id = $1
+		ORDER BY paid_at ASC, created_at ASC
+	`
+
+	var payments []*loan.Payment
+	err := r.db.SelectContext(ctx, &payments, query, loanID)
+	if err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (r *PostgresRepository) RecordPayment(ctx context.Context, l *loan.Loan, p *loan.Payment) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	notes := "Loan payment"
+	if p.Notes != "" {
+		notes = "Loan payment: " + p.Notes
+	}
+
+	if p.Principal > 0 {
+		repayment := ledgerEntry(l, l.AssetID, p.Principal, p.PaidAt, notes)
+		if _, err := transactionrepo.Post(ctx, tx, repayment); err != nil {
+			return err
+		}
+		p.TransactionID = &repayment.ID
+	}
+
+	if p.FromAssetID != nil {
+		outflow := ledgerEntry(l, *p.FromAssetID, p.Amount, p.PaidAt, notes)
+		if _, err := transactionrepo.Post(ctx, tx, outflow); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO loan_payments (
+			id, loan_id, user_id, paid_at, amount, principal, interest, from_asset_id, transaction_id, notes, created_at
+		) VALUES (
+			:id, :loan_id, :user_id, :paid_at, :amount, :principal, :interest, :from_asset_id, :transaction_id, :notes, :created_at
+		)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, p); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// ledgerEntry builds a withdrawal in the currency of the loan, hence the unit price of one.
+func ledgerEntry(l *loan.Loan, assetID uuid.UUID, amount float64, date time.Time, notes string) *transaction.Transaction {
+	now := time.Now()
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		UserID:          l.UserID,
+		AssetID:         assetID,
+		Type:            transaction.Withdraw,
+		Quantity:        amount,
+		Price:           1,
+		Currency:        l.Currency,
+		Notes:           notes,
+		TransactionDate: date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
@@ -0,0 +1,152 @@
+package lotrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"siyahsensei/wallet-service/domain/lot"
+)
+
+// GetOpenForUpdate locks and returns the lots of an asset that still hold a quantity.
+func GetOpenForUpdate(ctx context.Context, tx *sqlx.Tx, assetID uuid.UUID) ([]*lot.Lot, error) {
+	query := `
+		SELECT id, user_id, asset_id, transaction_id, quantity, remaining_quantity, unit_price, currency, acquired_at, created_at, updated_at
+		FROM lots
+		WHERE asset_id = $1 AND remaining_quantity > 0
+		ORDER BY acquired_at ASC, created_at ASC
+		FOR UPDATE
+	`
+	var lots []*lot.Lot
+	err := tx.SelectContext(ctx, &lots, query, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func Insert(ctx context.Context, tx *sqlx.Tx, lots ...*lot.Lot) error {
+	query := `
+		INSERT INTO lots (
+			id, user_id, asset_id, transaction_id, quantity, remaining_quantity, unit_price, currency, acquired_at, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11
+		)
+	`
+	for _, l := range lots {
+		_, err := tx.ExecContext(
+			ctx,
+			query,
+			l.ID,
+			l.UserID,
+			l.AssetID,
+			l.TransactionID,
+			l.Quantity,
+			l.RemainingQuantity,
+			l.UnitPrice,
+			l.Currency,
+			l.AcquiredAt,
+			l.CreatedAt,
+			l.UpdatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// SaveRelief persists the remaining quantities of relieved lots and the disposals that relieved them.
+func SaveRelief(ctx context.Context, tx *sqlx.Tx, lots []*lot.Lot, disposals []*lot.Disposal) error {
+	updateQuery := `
+		UPDATE lots
+		SET remaining_quantity = $1, updated_at = $2
+		WHERE id = $3
+	`
+	for _, l := range lots {
+		if _, err := tx.ExecContext(ctx, updateQuery, l.RemainingQuantity, l.UpdatedAt, l.ID); err != nil {
+			return err
+		}
+	}
+
+	insertQuery := `
+		INSERT INTO lot_disposals (
+			id, user_id, lot_id, asset_id, transaction_id, kind, quantity, unit_cost, unit_proceeds, currency, acquired_at, disposed_at, created_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13
+		)
+	`
+	for _, d := range disposals {
+		_, err := tx.ExecContext(
+			ctx,
+			insertQuery,
+			d.ID,
+			d.UserID,
+			d.LotID,
+			d.AssetID,
+			d.TransactionID,
+			d.Kind,
+			d.Quantity,
+			d.UnitCost,
+			d.UnitProceeds,
+			d.Currency,
+			d.AcquiredAt,
+			d.DisposedAt,
+			d.CreatedAt,
+		)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Unwind reverts the lot effects of transactions that are about to be deleted: relieved
+// quantities go back to their lots and lots opened by the transactions are removed. Lots
+// that later transactions already relieved cannot be removed without rewriting history.
+func Unwind(ctx context.Context, tx *sqlx.Tx, transactionIDs []uuid.UUID) error {
+	idStrings := make([]string, len(transactionIDs))
+	for i, id := range transactionIDs {
+		idStrings[i] = id.String()
+	}
+	ids := pq.Array(idStrings)
+
+	restoreQuery := `
+		UPDATE lots l
+		SET remaining_quantity = l.remaining_quantity + d.quantity, updated_at = $2
+		FROM (
+			SELECT lot_id, SUM(quantity) AS quantity
+			FROM lot_disposals
+			WHERE transaction_id = ANY($1)
+			GROUP BY lot_id
+		) d
+		WHERE l.id = d.lot_id
+	`
+	if _, err := tx.ExecContext(ctx, restoreQuery, ids, time.Now()); err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, `DELETE FROM lot_disposals WHERE transaction_id = ANY($1)`, ids); err != nil {
+		return err
+	}
+
+	var relieved int
+	relievedQuery := `
+		SELECT COUNT(*)
+		FROM lots
+		WHERE transaction_id = ANY($1) AND remaining_quantity < quantity
+	`
+	if err := tx.GetContext(ctx, &relieved, relievedQuery, ids); err != nil {
+		return err
+	}
+	if relieved > 0 {
+		return errors.New("lots opened by this transaction have already been relieved")
+	}
+
+	_, err := tx.ExecContext(ctx, `DELETE FROM lots WHERE transaction_id = ANY($1)`, ids)
+	return err
+}
@@ -0,0 +1,85 @@
+package lotrepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/lot"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID, openOnly bool) ([]*lot.Lot, error) {
+	query := `
+		SELECT id, user_id, asset_id, transaction_id, quantity, remaining_quantity, unit_price, currency, acquired_at, created_at, updated_at
+		FROM lots
+		WHERE asset_id = $1
+	`
+	if openOnly {
+		query += " AND remaining_quantity > 0"
+	}
+	query += " ORDER BY acquired_at ASC, created_at ASC"
+
+	var lots []*lot.Lot
+	err := r.db.SelectContext(ctx, &lots, query, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return lots, nil
+}
+
+func (r *PostgresRepository) GetDisposals(ctx context.Context, userID uuid.UUID, assetID *uuid.UUID, from, to *time.Time) ([]*lot.Disposal, error) {
+	baseQuery := `
+		SELECT id, user_id, lot_id, asset_id, transaction_id, kind, quantity, unit_cost, unit_proceeds, currency, acquired_at, disposed_at, created_at
+		FROM lot_disposals
+		WHERE user_id = $1
+	`
+
+	var conditions []string
+	var args []interface{}
+	args = append(args, userID)
+	argIndex := 2
+
+	if assetID != nil {
+		conditions = append(conditions, fmt.Sprintf("asset_id = $%d", argIndex))
+		args = append(args, *assetID)
+		argIndex++
+	}
+
+	if from != nil {
+		conditions = append(conditions, fmt.Sprintf("disposed_at >= $%d", argIndex))
+		args = append(args, *from)
+		argIndex++
+	}
+
+	if to != nil {
+		conditions = append(conditions, fmt.Sprintf("disposed_at <= $%d", argIndex))
+		args = append(args, *to)
+	}
+
+	if len(conditions) > 0 {
+		baseQuery += " AND " + strings.Join(conditions, " AND ")
+	}
+
+	baseQuery += " ORDER BY disposed_at ASC, created_at ASC"
+
+	var disposals []*lot.Disposal
+	err := r.db.SelectContext(ctx, &disposals, baseQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	return disposals, nil
+}
@@ -0,0 +1,157 @@
+package portfoliorepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/portfolio"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// snapshotRow is the stored form of a snapshot with its breakdowns as JSON.
+type snapshotRow struct {
+	ID           uuid.UUID `db:"id"`
+	UserID       uuid.UUID `db:"user_id"`
+	SnapshotDate time.Time `db:"snapshot_date"`
+	BaseCurrency string    `db:"base_currency"`
+	TotalValue   float64   `db:"total_value"`
+	Unpriced     int       `db:"unpriced"`
+	ByAccount    []byte    `db:"by_account"`
+	ByAssetType  []byte    `db:"by_asset_type"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func (r *PostgresRepository) SaveSnapshot(ctx context.Context, snapshot *portfolio.Snapshot) error {
+	byAccount, err := json.Marshal(snapshot.ByAccount)
+	if err != nil {
+		return err
+	}
+	if snapshot.ByAccount == nil {
+		byAccount = []byte("[]")
+	}
+	byAssetType, err := json.Marshal(snapshot.ByAssetType)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO portfolio_snapshots (id, user_id, snapshot_date, base_currency, total_value, unpriced, by_account, by_asset_type, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+		ON CONFLICT (user_id, snapshot_date)
+		DO UPDATE SET base_currency = EXCLUDED.base_currency,
+			total_value = EXCLUDED.total_value,
+			unpriced = EXCLUDED.unpriced,
+			by_account = EXCLUDED.by_account,
+			by_asset_type = EXCLUDED.by_asset_type,
+			created_at = EXCLUDED.created_at
+	`
+	_, err = r.db.ExecContext(ctx, query,
+		snapshot.ID,
+		snapshot.UserID,
+		snapshot.SnapshotDate,
+		snapshot.BaseCurrency,
+		snapshot.TotalValue,
+		snapshot.Unpriced,
+		byAccount,
+		byAssetType,
+		snapshot.CreatedAt,
+	)
+	return err
+}
+
+func (r *PostgresRepository) GetSnapshots(ctx context.Context, userID uuid.UUID, from, to time.Time) ([]*portfolio.Snapshot, error) {
+	query := `
+		SELECT id, user_id, snapshot_date, base_currency, total_value, unpriced, by_account, by_asset_type, created_at
+		FROM portfolio_snapshots
+		WHERE user_id = $1 AND snapshot_date BETWEEN $2 AND $3
+		ORDER BY snapshot_date ASC
+	`
+
+	var rows []snapshotRow
+	if err := r.db.SelectContext(ctx, &rows, query, userID, from, to); err != nil {
+		return nil, err
+	}
+
+	snapshots := make([]*portfolio.Snapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshot := &portfolio.Snapshot{
+			ID:           row.ID,
+			UserID:       row.UserID,
+			SnapshotDate: row.SnapshotDate,
+			BaseCurrency: row.BaseCurrency,
+			TotalValue:   row.TotalValue,
+			Unpriced:     row.Unpriced,
+			CreatedAt:    row.CreatedAt,
+		}
+		if err := json.Unmarshal(row.ByAccount, &snapshot.ByAccount); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(row.ByAssetType, &snapshot.ByAssetType); err != nil {
+			return nil, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+func (r *PostgresRepository) GetUserIDs(ctx context.Context) ([]uuid.UUID, error) {
+	var userIDs []uuid.UUID
+	err := r.db.SelectContext(ctx, &userIDs, "SELECT id FROM users ORDER BY created_at ASC")
+	if err != nil {
+		return nil, err
+	}
+	return userIDs, nil
+}
+
+func (r *PostgresRepository) ReplaceTargets(ctx context.Context, userID uuid.UUID, targets []*portfolio.Target) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.ExecContext(ctx, "DELETE FROM allocation_targets WHERE user_id = $1", userID); err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO allocation_targets (id, user_id, group_by, key, weight, created_at, updated_at)
+		VALUES (:id, :user_id, :group_by, :key, :weight, :created_at, :updated_at)
+	`
+	for _, t := range targets {
+		if _, err := tx.NamedExecContext(ctx, query, t); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetTargets(ctx context.Context, userID uuid.UUID) ([]*portfolio.Target, error) {
+	query := `
+		SELECT id, user_id, group_by, key, weight, created_at, updated_at
+		FROM allocation_targets
+		WHERE user_id = $1
+		ORDER BY weight DESC, key ASC
+	`
+
+	var targets []*portfolio.Target
+	err := r.db.SelectContext(ctx, &targets, query, userID)
+	if err != nil {
+		return nil, err
+	}
+	return targets, nil
+}
@@ -0,0 +1,129 @@
+package pricerepo
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+
+	"siyahsensei/wallet-service/domain/price"
+)
+
+const priceColumns = "id, definition_id, quote_currency, priced_at, price, source, created_at"
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) BulkUpsert(ctx context.Context, prices []*price.Price) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO price_history (` + priceColumns + `)
+		VALUES (:id, :definition_id, :quote_currency, :priced_at, :price, :source, :created_at)
+		ON CONFLICT (definition_id, quote_currency, priced_at, source)
+		DO UPDATE SET price = EXCLUDED.price
+	`
+	for _, p := range prices {
+		if _, err := tx.NamedExecContext(ctx, query, p); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetLatest(ctx context.Context, definitionID uuid.UUID, quoteCurrency string) (*price.Price, error) {
+	return r.GetAt(ctx, definitionID, quoteCurrency, time.Now())
+}
+
+func (r *PostgresRepository) GetAt(ctx context.Context, definitionID uuid.UUID, quoteCurrency string, at time.Time) (*price.Price, error) {
+	query := `
+		SELECT ` + priceColumns + `
+		FROM price_history
+		WHERE definition_id = $1 AND quote_currency = $2 AND priced_at <= $3
+		ORDER BY priced_at DESC, created_at DESC
+		LIMIT 1
+	`
+
+	var p price.Price
+	err := r.db.GetContext(ctx, &p, query, definitionID, quoteCurrency, at)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresRepository) GetHistory(ctx context.Context, query price.GetPriceHistoryQuery) ([]*price.Price, error) {
+	baseQuery := `
+		SELECT ` + priceColumns + `
+		FROM price_history
+		WHERE definition_id = $1 AND quote_currency = $2
+	`
+
+	var conditions []string
+	var args []interface{}
+	args = append(args, query.DefinitionID, query.QuoteCurrency)
+	argIndex := 3
+
+	if query.From != nil {
+		conditions = append(conditions, fmt.Sprintf("priced_at >= $%d", argIndex))
+		args = append(args, *query.From)
+		argIndex++
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, fmt.Sprintf("priced_at <= $%d", argIndex))
+		args = append(args, *query.To)
+		argIndex++
+	}
+
+	if len(conditions) > 0 {
+		baseQuery += " AND " + strings.Join(conditions, " AND ")
+	}
+
+	baseQuery += fmt.Sprintf(" ORDER BY priced_at DESC LIMIT $%d OFFSET $%d", argIndex, argIndex+1)
+	args = append(args, query.Limit, query.Offset)
+
+	var prices []*price.Price
+	err := r.db.SelectContext(ctx, &prices, baseQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+func (r *PostgresRepository) GetLatestForDefinitions(ctx context.Context, definitionIDs []uuid.UUID, at time.Time) ([]*price.Price, error) {
+	ids := make([]string, len(definitionIDs))
+	for i, id := range definitionIDs {
+		ids[i] = id.String()
+	}
+
+	query := `
+		SELECT DISTINCT ON (definition_id, quote_currency) ` + priceColumns + `
+		FROM price_history
+		WHERE definition_id = ANY($1::uuid[]) AND priced_at <= $2
+		ORDER BY definition_id, quote_currency, priced_at DESC, created_at DESC
+	`
+
+	var prices []*price.Price
+	err := r.db.SelectContext(ctx, &prices, query, pq.Array(ids), at)
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
@@ -0,0 +1,161 @@
+package realestaterepo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/realestate"
+)
+
+const propertyColumns = `id, user_id, asset_id, currency, purchase_price, purchase_costs, purchase_date, mortgage_loan_id,
+	created_at, updated_at`
+
+const appraisalColumns = `id, property_id, user_id, value, appraised_at, COALESCE(source, '') AS source,
+	COALESCE(notes, '') AS notes, created_at`
+
+const expenseColumns = `id, property_id, user_id, category, amount, paid_at, COALESCE(notes, '') AS notes, created_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, p *realestate.Property) error {
+	query := `
+		INSERT INTO properties (
+			id, user_id, asset_id, currency, purchase_price, purchase_costs, purchase_date, mortgage_loan_id,
+			created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :currency, :purchase_price, :purchase_costs, :purchase_date, :mortgage_loan_id,
+			:created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, p)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, p *realestate.Property) error {
+	query := `
+		UPDATE properties SET
+			currency = :currency,
+			purchase_price = :purchase_price,
+			purchase_costs = :purchase_costs,
+			purchase_date = :purchase_date,
+			mortgage_loan_id = :mortgage_loan_id,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, p)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM properties WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*realestate.Property, error) {
+	var p realestate.Property
+	err := r.db.GetContext(ctx, &p, "SELECT "+propertyColumns+" FROM properties WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) (*realestate.Property, error) {
+	var p realestate.Property
+	err := r.db.GetContext(ctx, &p, "SELECT "+propertyColumns+" FROM properties WHERE asset_id = $1", assetID)
+	if err != nil {
+		return nil, err
+	}
+	return &p, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*realestate.Property, error) {
+	var properties []*realestate.Property
+	err := r.db.SelectContext(ctx, &properties, "SELECT "+propertyColumns+" FROM properties WHERE user_id = $1 ORDER BY purchase_date ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return properties, nil
+}
+
+func (r *PostgresRepository) AddAppraisal(ctx context.Context, a *realestate.Appraisal) error {
+	query := `
+		INSERT INTO property_appraisals (
+			id, property_id, user_id, value, appraised_at, source, notes, created_at
+		) VALUES (
+			:id, :property_id, :user_id, :value, :appraised_at, :source, :notes, :created_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, a)
+	return err
+}
+
+func (r *PostgresRepository) GetAppraisal(ctx context.Context, id uuid.UUID) (*realestate.Appraisal, error) {
+	var a realestate.Appraisal
+	err := r.db.GetContext(ctx, &a, "SELECT "+appraisalColumns+" FROM property_appraisals WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &a, nil
+}
+
+func (r *PostgresRepository) DeleteAppraisal(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM property_appraisals WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetAppraisals(ctx context.Context, propertyID uuid.UUID) ([]*realestate.Appraisal, error) {
+	var appraisals []*realestate.Appraisal
+	err := r.db.SelectContext(ctx, &appraisals, "SELECT "+appraisalColumns+` FROM property_appraisals
+		WHERE property_id = $1 ORDER BY appraised_at ASC, created_at ASC`, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	return appraisals, nil
+}
+
+func (r *PostgresRepository) AddExpense(ctx context.Context, e *realestate.Expense) error {
+	query := `
+		INSERT INTO property_expenses (
+			id, property_id, user_id, category, amount, paid_at, notes, created_at
+		) VALUES (
+			:id, :property_id, :user_id, :category, :amount, :paid_at, :notes, :created_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, e)
+	return err
+}
+
+func (r *PostgresRepository) GetExpense(ctx context.Context, id uuid.UUID) (*realestate.Expense, error) {
+	var e realestate.Expense
+	err := r.db.GetContext(ctx, &e, "SELECT "+expenseColumns+" FROM property_expenses WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+func (r *PostgresRepository) DeleteExpense(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM property_expenses WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetExpenses(ctx context.Context, propertyID uuid.UUID) ([]*realestate.Expense, error) {
+	var expenses []*realestate.Expense
+	err := r.db.SelectContext(ctx, &expenses, "SELECT "+expenseColumns+` FROM property_expenses
+		WHERE property_id = $1 ORDER BY paid_at ASC, created_at ASC`, propertyID)
+	if err != nil {
+		return nil, err
+	}
+	return expenses, nil
+}
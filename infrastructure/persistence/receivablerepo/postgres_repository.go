@@ -0,0 +1,223 @@
+package receivablerepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/receivable"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+const receivableColumns = `id, user_id, asset_id, counterparty_name, COALESCE(counterparty_contact, '') AS counterparty_contact,
+	amount, currency, issue_date, due_date, repaid, written_off, written_off_at, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, rec *receivable.Receivable) error {
+	query := `
+		INSERT INTO receivables (
+			id, user_id, asset_id, counterparty_name, counterparty_contact, amount, currency,
+			issue_date, due_date, repaid, written_off, written_off_at, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :counterparty_name, :counterparty_contact, :amount, :currency,
+			:issue_date, :due_date, :repaid, :written_off, :written_off_at, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, rec)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, rec *receivable.Receivable) error {
+	query := `
+		UPDATE receivables SET
+			counterparty_name = :counterparty_name,
+			counterparty_contact = :counterparty_contact,
+			amount = :amount,
+			issue_date = :issue_date,
+			due_date = :due_date,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, rec)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM receivables WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*receivable.Receivable, error) {
+	var rec receivable.Receivable
+	err := r.db.GetContext(ctx, &rec, "SELECT "+receivableColumns+" FROM receivables WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) (*receivable.Receivable, error) {
+	var rec receivable.Receivable
+	err := r.db.GetContext(ctx, &rec, "SELECT "+receivableColumns+" FROM receivables WHERE asset_id = $1", assetID)
+	if err != nil {
+		return nil, err
+	}
+	return &rec, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*receivable.Receivable, error) {
+	var receivables []*receivable.Receivable
+	err := r.db.SelectContext(ctx, &receivables, "SELECT "+receivableColumns+" FROM receivables WHERE user_id = $1 ORDER BY due_date ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return receivables, nil
+}
+
+func (r *PostgresRepository) GetRepayments(ctx context.Context, receivableID uuid.UUID) ([]*receivable.Repayment, error) {
+	query := `
+		SELECT id, receivable_id, user_id, repaid_at, amount, to_asset_id, transaction_id,
+			COALESCE(notes, '') AS notes, created_at
+		FROM receivable_repayments
+		WHERE receivable_id = $1
+		ORDER BY repaid_at ASC, created_at ASC
+	`
+
+	var repayments []*receivable.Repayment
+	err := r.db.SelectContext(ctx, &repayments, query, receivableID)
+	if err != nil {
+		return nil, err
+	}
+	return repayments, nil
+}
+
+func (r *PostgresRepository) RecordRepayment(ctx context.Context, rec *receivable.Receivable, repayment *receivable.Repayment) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	outstanding, err := lockOutstanding(ctx, tx, rec.ID)
+	if err != nil {
+		return err
+	}
+	if repayment.Amount > outstanding {
+		return errors.New("repayment exceeds the outstanding amount")
+	}
+
+	notes := "Repayment from " + rec.CounterpartyName
+	if repayment.Notes != "" {
+		notes += ": " + repayment.Notes
+	}
+
+	entry := ledgerEntry(rec, rec.AssetID, transaction.Withdraw, repayment.Amount, repayment.RepaidAt, notes)
+	if _, err := transactionrepo.Post(ctx, tx, entry); err != nil {
+		return err
+	}
+	repayment.TransactionID = &entry.ID
+
+	if repayment.ToAssetID != nil {
+		inflow := ledgerEntry(rec, *repayment.ToAssetID, transaction.Deposit, repayment.Amount, repayment.RepaidAt, notes)
+		if _, err := transactionrepo.Post(ctx, tx, inflow); err != nil {
+			return err
+		}
+	}
+
+	query := `
+		INSERT INTO receivable_repayments (
+			id, receivable_id, user_id, repaid_at, amount, to_asset_id, transaction_id, notes, created_at
+		) VALUES (
+			:id, :receivable_id, :user_id, :repaid_at, :amount, :to_asset_id, :transaction_id, :notes, :created_at
+		)
+	`
+	if _, err := tx.NamedExecContext(ctx, query, repayment); err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE receivables SET repaid = repaid + $1, updated_at = $2 WHERE id = $3",
+		repayment.Amount, time.Now(), rec.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) WriteOff(ctx context.Context, rec *receivable.Receivable, date time.Time, notes string) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	outstanding, err := lockOutstanding(ctx, tx, rec.ID)
+	if err != nil {
+		return err
+	}
+
+	entryNotes := "Written off: " + rec.CounterpartyName
+	if notes != "" {
+		entryNotes += ": " + notes
+	}
+	if outstanding > 0 {
+		entry := ledgerEntry(rec, rec.AssetID, transaction.Withdraw, outstanding, date, entryNotes)
+		if _, err := transactionrepo.Post(ctx, tx, entry); err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.ExecContext(ctx, "UPDATE receivables SET written_off = $1, written_off_at = $2, updated_at = $3 WHERE id = $4",
+		outstanding, date, time.Now(), rec.ID)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// lockOutstanding locks a receivable that is not written off and returns what is still
+// owed on it, so concurrent repayments cannot settle more than the amount.
+func lockOutstanding(ctx context.Context, tx *sqlx.Tx, id uuid.UUID) (float64, error) {
+	var outstanding float64
+	err := tx.GetContext(ctx, &outstanding, `
+		SELECT GREATEST(amount - repaid - written_off, 0)
+		FROM receivables
+		WHERE id = $1 AND written_off_at IS NULL
+		FOR UPDATE
+	`, id)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, errors.New("receivable is written off")
+	}
+	return outstanding, err
+}
+
+// ledgerEntry builds an entry in the currency of the receivable, hence the unit price of one.
+func ledgerEntry(rec *receivable.Receivable, assetID uuid.UUID, transactionType transaction.TransactionType, amount float64, date time.Time, notes string) *transaction.Transaction {
+	now := time.Now()
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		UserID:          rec.UserID,
+		AssetID:         assetID,
+		Type:            transactionType,
+		Quantity:        amount,
+		Price:           1,
+		Currency:        rec.Currency,
+		Notes:           notes,
+		TransactionDate: date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
@@ -0,0 +1,198 @@
+package recurringrepo
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/recurring"
+	"siyahsensei/wallet-service/infrastructure/persistence/assetrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+const ruleColumns = `id, user_id, account_id, definition_id, name, asset_type, transaction_type, amount, price, currency,
+	frequency, frequency_interval, day_of_month, day_of_week, month_of_year, start_date, end_date, next_run_at, last_run_at,
+	active, COALESCE(notes, '') AS notes, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, rule *recurring.Rule) error {
+	query := `
+		INSERT INTO recurring_rules (
+			id, user_id, account_id, definition_id, name, asset_type, transaction_type, amount, price, currency,
+			frequency, frequency_interval, day_of_month, day_of_week, month_of_year, start_date, end_date, next_run_at, last_run_at,
+			active, notes, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :account_id, :definition_id, :name, :asset_type, :transaction_type, :amount, :price, :currency,
+			:frequency, :frequency_interval, :day_of_month, :day_of_week, :month_of_year, :start_date, :end_date, :next_run_at, :last_run_at,
+			:active, :notes, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, rule)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, rule *recurring.Rule) error {
+	_, err := r.db.NamedExecContext(ctx, updateQuery, rule)
+	return err
+}
+
+const updateQuery = `
+	UPDATE recurring_rules SET
+		name = :name,
+		amount = :amount,
+		price = :price,
+		currency = :currency,
+		frequency = :frequency,
+		frequency_interval = :frequency_interval,
+		day_of_month = :day_of_month,
+		day_of_week = :day_of_week,
+		month_of_year = :month_of_year,
+		end_date = :end_date,
+		next_run_at = :next_run_at,
+		last_run_at = :last_run_at,
+		active = :active,
+		notes = :notes,
+		updated_at = :updated_at
+	WHERE id = :id
+`
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM recurring_rules WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*recurring.Rule, error) {
+	var rule recurring.Rule
+	err := r.db.GetContext(ctx, &rule, "SELECT "+ruleColumns+" FROM recurring_rules WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &rule, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*recurring.Rule, error) {
+	var rules []*recurring.Rule
+	err := r.db.SelectContext(ctx, &rules, "SELECT "+ruleColumns+" FROM recurring_rules WHERE user_id = $1 ORDER BY name ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *PostgresRepository) GetOccurrences(ctx context.Context, ruleID uuid.UUID) ([]*recurring.Occurrence, error) {
+	query := `
+		SELECT id, rule_id, occurrence_date, transaction_id, status, error, created_at
+		FROM recurring_occurrences
+		WHERE rule_id = $1
+		ORDER BY occurrence_date DESC
+	`
+
+	var occurrences []*recurring.Occurrence
+	err := r.db.SelectContext(ctx, &occurrences, query, ruleID)
+	if err != nil {
+		return nil, err
+	}
+	return occurrences, nil
+}
+
+func (r *PostgresRepository) GetDue(ctx context.Context, now time.Time) ([]*recurring.Rule, error) {
+	query := "SELECT " + ruleColumns + " FROM recurring_rules WHERE active AND next_run_at <= $1 ORDER BY next_run_at ASC"
+
+	var rules []*recurring.Rule
+	err := r.db.SelectContext(ctx, &rules, query, now)
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+func (r *PostgresRepository) Materialise(ctx context.Context, rule *recurring.Rule, occurrence time.Time) (*recurring.Occurrence, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	o := &recurring.Occurrence{
+		ID:             uuid.New(),
+		RuleID:         rule.ID,
+		OccurrenceDate: occurrence,
+		Status:         recurring.Posted,
+		CreatedAt:      time.Now(),
+	}
+
+	// Claiming the occurrence first makes a second worker, or a retry, a no-op
+	result, err := tx.ExecContext(ctx, `
+		INSERT INTO recurring_occurrences (id, rule_id, occurrence_date, status, error, created_at)
+		VALUES ($1, $2, $3, $4, '', $5)
+		ON CONFLICT (rule_id, occurrence_date) DO NOTHING
+	`, o.ID, o.RuleID, o.OccurrenceDate, o.Status, o.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	claimed, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+
+	if claimed == 1 {
+		if err := r.post(ctx, tx, rule, o); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := tx.NamedExecContext(ctx, updateQuery, rule); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return o, nil
+}
+
+// post writes the ledger entry of a claimed occurrence. When the ledger refuses it,
+// for example a withdrawal larger than the balance, the occurrence is kept as skipped
+// so that the schedule moves on instead of retrying forever.
+func (r *PostgresRepository) post(ctx context.Context, tx *sqlx.Tx, rule *recurring.Rule, o *recurring.Occurrence) error {
+	assetID, err := assetrepo.FindOrCreate(ctx, tx, rule.UserID, rule.AccountID, rule.DefinitionID, rule.AssetType, o.OccurrenceDate)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ExecContext(ctx, "SAVEPOINT recurring_post"); err != nil {
+		return err
+	}
+
+	t := rule.Transaction(assetID, o.OccurrenceDate)
+	if _, postErr := transactionrepo.Post(ctx, tx, t); postErr != nil {
+		if !errors.Is(postErr, transactionrepo.ErrInsufficientQuantity) {
+			return postErr
+		}
+		if _, err := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT recurring_post"); err != nil {
+			return err
+		}
+		o.Status = recurring.Skipped
+		o.Error = postErr.Error()
+	} else {
+		o.TransactionID = &t.ID
+	}
+
+	_, err = tx.ExecContext(ctx,
+		"UPDATE recurring_occurrences SET transaction_id = $1, status = $2, error = $3 WHERE id = $4",
+		o.TransactionID, o.Status, o.Error, o.ID,
+	)
+	return err
+}
@@ -0,0 +1,216 @@
+package termdepositrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/termdeposit"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/assetrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+const depositColumns = `id, user_id, asset_id, principal, currency, annual_rate, compounding, start_date, maturity_date,
+	withholding_tax_rate, maturity_action, payout_account_id, status, rollovers, matured_at, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, deposit *termdeposit.TermDeposit) error {
+	query := `
+		INSERT INTO term_deposits (
+			id, user_id, asset_id, principal, currency, annual_rate, compounding, start_date, maturity_date,
+			withholding_tax_rate, maturity_action, payout_account_id, status, rollovers, matured_at, created_at, updated_at
+		) VALUES (
+			:id, :user_id, :asset_id, :principal, :currency, :annual_rate, :compounding, :start_date, :maturity_date,
+			:withholding_tax_rate, :maturity_action, :payout_account_id, :status, :rollovers, :matured_at, :created_at, :updated_at
+		)
+	`
+	_, err := r.db.NamedExecContext(ctx, query, deposit)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, deposit *termdeposit.TermDeposit) error {
+	_, err := r.db.NamedExecContext(ctx, updateQuery, deposit)
+	return err
+}
+
+const updateQuery = `
+	UPDATE term_deposits SET
+		principal = :principal,
+		annual_rate = :annual_rate,
+		compounding = :compounding,
+		start_date = :start_date,
+		maturity_date = :maturity_date,
+		withholding_tax_rate = :withholding_tax_rate,
+		maturity_action = :maturity_action,
+		payout_account_id = :payout_account_id,
+		status = :status,
+		rollovers = :rollovers,
+		matured_at = :matured_at,
+		updated_at = :updated_at
+	WHERE id = :id
+`
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM term_deposits WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*termdeposit.TermDeposit, error) {
+	var deposit termdeposit.TermDeposit
+	err := r.db.GetContext(ctx, &deposit, "SELECT "+depositColumns+" FROM term_deposits WHERE id = $1", id)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) (*termdeposit.TermDeposit, error) {
+	var deposit termdeposit.TermDeposit
+	err := r.db.GetContext(ctx, &deposit, "SELECT "+depositColumns+" FROM term_deposits WHERE asset_id = $1", assetID)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit, nil
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*termdeposit.TermDeposit, error) {
+	var deposits []*termdeposit.TermDeposit
+	err := r.db.SelectContext(ctx, &deposits, "SELECT "+depositColumns+" FROM term_deposits WHERE user_id = $1 ORDER BY maturity_date ASC", userID)
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+func (r *PostgresRepository) GetDue(ctx context.Context, now time.Time) ([]*termdeposit.TermDeposit, error) {
+	query := "SELECT " + depositColumns + " FROM term_deposits WHERE status = $1 AND maturity_date <= $2 ORDER BY maturity_date ASC"
+
+	var deposits []*termdeposit.TermDeposit
+	err := r.db.SelectContext(ctx, &deposits, query, termdeposit.Active, now)
+	if err != nil {
+		return nil, err
+	}
+	return deposits, nil
+}
+
+func (r *PostgresRepository) Settle(ctx context.Context, deposit *termdeposit.TermDeposit, settlement *termdeposit.Settlement) (bool, error) {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback()
+
+	// Locking the unsettled term makes a second worker, or a retry, a no-op
+	var accountID uuid.UUID
+	lockQuery := `
+		SELECT a.account_id
+		FROM term_deposits td
+		JOIN assets a ON a.id = td.asset_id
+		WHERE td.id = $1 AND td.status = $2 AND td.maturity_date = $3
+		FOR UPDATE OF td
+	`
+	err = tx.GetContext(ctx, &accountID, lockQuery, settlement.TermDepositID, termdeposit.Active, settlement.Date)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if settlement.GrossInterest > 0 {
+		interest := ledgerEntry(deposit.UserID, settlement.AssetID, transaction.Deposit, settlement.GrossInterest, settlement, "Term deposit interest")
+		if _, err := transactionrepo.Post(ctx, tx, interest); err != nil {
+			return false, err
+		}
+	}
+	if settlement.WithholdingTax > 0 {
+		tax := ledgerEntry(deposit.UserID, settlement.AssetID, transaction.Fee, settlement.WithholdingTax, settlement, "Withholding tax on term deposit interest")
+		if _, err := transactionrepo.Post(ctx, tx, tax); err != nil {
+			return false, err
+		}
+	}
+
+	if settlement.Action == termdeposit.MoveToCash {
+		if deposit.PayoutAccountID != nil {
+			accountID = *deposit.PayoutAccountID
+		}
+		if err := moveToCash(ctx, tx, deposit.UserID, accountID, settlement); err != nil {
+			return false, err
+		}
+	}
+
+	if _, err := tx.NamedExecContext(ctx, updateQuery, deposit); err != nil {
+		return false, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// moveToCash transfers the whole balance of the deposit to a cash asset of the same
+// definition in the payout account, carrying its lots over.
+func moveToCash(ctx context.Context, tx *sqlx.Tx, userID, accountID uuid.UUID, settlement *termdeposit.Settlement) error {
+	var balance struct {
+		Quantity     float64   `db:"quantity"`
+		DefinitionID uuid.UUID `db:"definition_id"`
+	}
+	err := tx.GetContext(ctx, &balance, "SELECT quantity, definition_id FROM assets WHERE id = $1", settlement.AssetID)
+	if err != nil {
+		return err
+	}
+	if balance.Quantity <= 0 {
+		return nil
+	}
+
+	cashAssetID, err := assetrepo.FindOrCreateOfType(ctx, tx, userID, accountID, balance.DefinitionID, asset.Cash, settlement.Date)
+	if err != nil {
+		return err
+	}
+
+	transferID := uuid.New()
+	notes := "Term deposit matured"
+	withdraw := transaction.NewTransferLeg(transferID, userID, settlement.AssetID, transaction.Withdraw, balance.Quantity, notes, settlement.Date)
+	relieved, err := transactionrepo.Post(ctx, tx, withdraw)
+	if err != nil {
+		return err
+	}
+
+	deposit := transaction.NewTransferLeg(transferID, userID, cashAssetID, transaction.Deposit, balance.Quantity, notes, settlement.Date)
+	return transactionrepo.PostCarried(ctx, tx, deposit, relieved)
+}
+
+// ledgerEntry builds an interest or tax line of a settlement. The amount is in the
+// currency of the deposit itself, hence the unit price of one.
+func ledgerEntry(userID, assetID uuid.UUID, transactionType transaction.TransactionType, amount float64, settlement *termdeposit.Settlement, notes string) *transaction.Transaction {
+	now := time.Now()
+	return &transaction.Transaction{
+		ID:              uuid.New(),
+		UserID:          userID,
+		AssetID:         assetID,
+		Type:            transactionType,
+		Quantity:        amount,
+		Price:           1,
+		Currency:        settlement.Currency,
+		Notes:           notes,
+		TransactionDate: settlement.Date,
+		CreatedAt:       now,
+		UpdatedAt:       now,
+	}
+}
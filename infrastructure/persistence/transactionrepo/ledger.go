@@ -0,0 +1,137 @@
+package transactionrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/lot"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/eventrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/lotrepo"
+)
+
+// ErrInsufficientQuantity is returned when a posting would take an asset below zero.
+// Callers that skip such entries instead of failing match it with errors.Is.
+var ErrInsufficientQuantity = errors.New("insufficient quantity")
+
+// SignedQuantity is the effect a transactions row has on the quantity of its asset.
+const SignedQuantity = `CASE WHEN type IN ('SELL', 'WITHDRAW', 'FEE') THEN -quantity ELSE quantity END`
+
+// Post records t inside tx, opens or relieves lots for it and re-derives the quantity
+// of its asset from the ledger. Every write that moves a balance goes through here so
+// assets.quantity never drifts from the transaction history, and so every entry writes
+// its creation event to the outbox. The disposals created when t reduces the quantity
+// are returned.
+func Post(ctx context.Context, tx *sqlx.Tx, t *transaction.Transaction) ([]*lot.Disposal, error) {
+	return post(ctx, tx, t, nil)
+}
+
+// PostCarried records an incoming transfer leg whose lots are carried over from the
+// disposals of the outgoing leg instead of being opened at the transaction price.
+func PostCarried(ctx context.Context, tx *sqlx.Tx, t *transaction.Transaction, carried []*lot.Disposal) error {
+	_, err := post(ctx, tx, t, carried)
+	return err
+}
+
+func post(ctx context.Context, tx *sqlx.Tx, t *transaction.Transaction, carried []*lot.Disposal) ([]*lot.Disposal, error) {
+	var current struct {
+		Quantity        float64               `db:"quantity"`
+		CostBasisMethod asset.CostBasisMethod `db:"cost_basis_method"`
+	}
+	err := tx.GetContext(ctx, &current, `SELECT quantity, cost_basis_method FROM assets WHERE id = $1 FOR UPDATE`, t.AssetID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("asset not found")
+		}
+		return nil, err
+	}
+	if current.Quantity+t.Delta() < 0 {
+		return nil, ErrInsufficientQuantity
+	}
+
+	query := `
+		INSERT INTO transactions (
+			id, user_id, asset_id, type, quantity, price, currency, notes, transfer_id, transaction_date, created_at, updated_at
+		) VALUES (
+			$1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12
+		)
+	`
+	_, err = tx.ExecContext(
+		ctx,
+		query,
+		t.ID,
+		t.UserID,
+		t.AssetID,
+		t.Type,
+		t.Quantity,
+		t.Price,
+		t.Currency,
+		t.Notes,
+		t.TransferID,
+		t.TransactionDate,
+		t.CreatedAt,
+		t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := eventrepo.Insert(ctx, tx, event.New(event.TransactionCreated, t.UserID, t)); err != nil {
+		return nil, err
+	}
+
+	var disposals []*lot.Disposal
+	switch {
+	case t.Delta() > 0 && carried != nil:
+		err = lotrepo.Insert(ctx, tx, lot.Carry(carried, t)...)
+	case t.Delta() > 0:
+		err = lotrepo.Insert(ctx, tx, lot.NewLot(t))
+	case t.Delta() < 0:
+		var open []*lot.Lot
+		open, err = lotrepo.GetOpenForUpdate(ctx, tx, t.AssetID)
+		if err != nil {
+			return nil, err
+		}
+		if disposals, err = lot.Relieve(open, t, current.CostBasisMethod); err != nil {
+			return nil, err
+		}
+		err = lotrepo.SaveRelief(ctx, tx, open, disposals)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := SyncAssetQuantity(ctx, tx, t.AssetID); err != nil {
+		return nil, err
+	}
+	return disposals, nil
+}
+
+// SyncAssetQuantity recomputes assets.quantity from the ledger and returns the new value.
+func SyncAssetQuantity(ctx context.Context, tx *sqlx.Tx, assetID uuid.UUID) (float64, error) {
+	query := `
+		UPDATE assets
+		SET quantity = (
+			SELECT COALESCE(SUM(` + SignedQuantity + `), 0)
+			FROM transactions
+			WHERE asset_id = $1
+		), updated_at = $2
+		WHERE id = $1
+		RETURNING quantity
+	`
+	var quantity float64
+	err := tx.GetContext(ctx, &quantity, query, assetID, time.Now())
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, errors.New("asset not found")
+		}
+		return 0, err
+	}
+	return quantity, nil
+}
@@ -0,0 +1,207 @@
+package transactionrepo
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/event"
+	"siyahsensei/wallet-service/domain/transaction"
+	"siyahsensei/wallet-service/infrastructure/persistence/eventrepo"
+	"siyahsensei/wallet-service/infrastructure/persistence/lotrepo"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, t *transaction.Transaction) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := Post(ctx, tx, t); err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// Delete removes a transaction, reverts its lot effects and re-derives the quantity of
+// every asset it touched. Transfer legs are only meaningful together, so deleting one
+// leg removes the whole transfer. A deletion event is written for every leg removed.
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	legsQuery := `
+		SELECT id, asset_id
+		FROM transactions
+		WHERE id = $1
+		OR transfer_id = (SELECT transfer_id FROM transactions WHERE id = $1)
+		FOR UPDATE
+	`
+	var legs []struct {
+		ID      uuid.UUID `db:"id"`
+		AssetID uuid.UUID `db:"asset_id"`
+	}
+	err = tx.SelectContext(ctx, &legs, legsQuery, id)
+	if err != nil {
+		return err
+	}
+	if len(legs) == 0 {
+		return errors.New("transaction not found")
+	}
+
+	transactionIDs := make([]uuid.UUID, 0, len(legs))
+	assetIDs := make([]uuid.UUID, 0, len(legs))
+	for _, leg := range legs {
+		transactionIDs = append(transactionIDs, leg.ID)
+		assetIDs = append(assetIDs, leg.AssetID)
+	}
+
+	if err := lotrepo.Unwind(ctx, tx, transactionIDs); err != nil {
+		return err
+	}
+
+	deleteQuery := `
+		DELETE FROM transactions
+		WHERE id = $1
+		RETURNING id, user_id, asset_id, type, quantity, price, currency, notes, transfer_id, transaction_date, created_at, updated_at
+	`
+	for _, transactionID := range transactionIDs {
+		var deleted transaction.Transaction
+		if err := tx.GetContext(ctx, &deleted, deleteQuery, transactionID); err != nil {
+			return err
+		}
+		if err := eventrepo.Insert(ctx, tx, event.New(event.TransactionDeleted, deleted.UserID, &deleted)); err != nil {
+			return err
+		}
+	}
+
+	synced := make(map[uuid.UUID]bool)
+	for _, assetID := range assetIDs {
+		if synced[assetID] {
+			continue
+		}
+		synced[assetID] = true
+
+		quantity, err := SyncAssetQuantity(ctx, tx, assetID)
+		if err != nil {
+			return err
+		}
+		if quantity < 0 {
+			return ErrInsufficientQuantity
+		}
+	}
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*transaction.Transaction, error) {
+	query := `
+		SELECT id, user_id, asset_id, type, quantity, price, currency, notes, transfer_id, transaction_date, created_at, updated_at
+		FROM transactions
+		WHERE id = $1
+	`
+	var t transaction.Transaction
+	err := r.db.GetContext(ctx, &t, query, id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.New("transaction not found")
+		}
+		return nil, err
+	}
+	return &t, nil
+}
+
+func (r *PostgresRepository) GetByAssetID(ctx context.Context, assetID uuid.UUID) ([]*transaction.Transaction, error) {
+	query := `
+		SELECT id, user_id, asset_id, type, quantity, price, currency, notes, transfer_id, transaction_date, created_at, updated_at
+		FROM transactions
+		WHERE asset_id = $1
+		ORDER BY transaction_date ASC, created_at ASC
+	`
+	var transactions []*transaction.Transaction
+	err := r.db.SelectContext(ctx, &transactions, query, assetID)
+	if err != nil {
+		return nil, err
+	}
+	return transactions, nil
+}
+
+func (r *PostgresRepository) Filter(ctx context.Context, query transaction.FilterTransactionsQuery) ([]*transaction.Transaction, error) {
+	baseQuery := `
+		SELECT id, user_id, asset_id, type, quantity, price, currency, notes, transfer_id, transaction_date, created_at, updated_at
+		FROM transactions
+		WHERE user_id = $1
+	`
+
+	var conditions []string
+	var args []interface{}
+	args = append(args, query.UserID)
+	argIndex := 2
+
+	if query.AssetID != nil {
+		conditions = append(conditions, fmt.Sprintf("asset_id = $%d", argIndex))
+		args = append(args, *query.AssetID)
+		argIndex++
+	}
+
+	if query.Type != nil {
+		conditions = append(conditions, fmt.Sprintf("type = $%d", argIndex))
+		args = append(args, *query.Type)
+		argIndex++
+	}
+
+	if query.From != nil {
+		conditions = append(conditions, fmt.Sprintf("transaction_date >= $%d", argIndex))
+		args = append(args, *query.From)
+		argIndex++
+	}
+
+	if query.To != nil {
+		conditions = append(conditions, fmt.Sprintf("transaction_date <= $%d", argIndex))
+		args = append(args, *query.To)
+		argIndex++
+	}
+
+	if len(conditions) > 0 {
+		baseQuery += " AND " + strings.Join(conditions, " AND ")
+	}
+
+	baseQuery += " ORDER BY transaction_date DESC, created_at DESC"
+
+	if query.Limit > 0 {
+		baseQuery += fmt.Sprintf(" LIMIT $%d", argIndex)
+		args = append(args, query.Limit)
+		argIndex++
+	}
+
+	if query.Offset > 0 {
+		baseQuery += fmt.Sprintf(" OFFSET $%d", argIndex)
+		args = append(args, query.Offset)
+	}
+
+	var transactions []*transaction.Transaction
+	err := r.db.SelectContext(ctx, &transactions, baseQuery, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
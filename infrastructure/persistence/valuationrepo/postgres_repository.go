@@ -0,0 +1,94 @@
+package valuationrepo
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/account"
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/valuation"
+	"siyahsensei/wallet-service/infrastructure/persistence/transactionrepo"
+)
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// GetHoldings loads every asset of the user that was non-empty at the given time with
+// its account, its definition, the user's last trade price for the definition up to then
+// as a valuation fallback and, for properties, the latest appraisal up to then or else the
+// purchase price. Quantities are rebuilt from the transactions dated up to then.
+func (r *PostgresRepository) GetHoldings(ctx context.Context, userID uuid.UUID, at time.Time) ([]*valuation.Holding, error) {
+	query := `
+		SELECT
+			a.id AS asset_id,
+			acc.id AS account_id,
+			acc.name AS account_name,
+			acc.account_type AS account_type,
+			d.id AS definition_id,
+			a.type AS asset_type,
+			d.abbreviation AS symbol,
+			d.name AS name,
+			q.quantity AS quantity,
+			p.price AS trade_price,
+			p.currency AS trade_currency,
+			pv.value AS property_value,
+			pv.currency AS property_currency
+		FROM assets a
+		JOIN accounts acc ON acc.id = a.account_id
+		JOIN definitions d ON d.id = a.definition_id
+		CROSS JOIN LATERAL (
+			SELECT COALESCE(SUM(` + transactionrepo.SignedQuantity + `), 0) AS quantity
+			FROM transactions
+			WHERE asset_id = a.id AND transaction_date <= $2
+		) q
+		LEFT JOIN LATERAL (
+			SELECT t.price, t.currency
+			FROM transactions t
+			JOIN assets ta ON ta.id = t.asset_id
+			WHERE ta.user_id = a.user_id
+			AND ta.definition_id = a.definition_id
+			AND t.type IN ('BUY', 'SELL')
+			AND t.price > 0
+			AND t.currency <> ''
+			AND t.transaction_date <= $2
+			ORDER BY t.transaction_date DESC, t.created_at DESC
+			LIMIT 1
+		) p ON true
+		LEFT JOIN LATERAL (
+			SELECT
+				COALESCE((
+					SELECT pa.value
+					FROM property_appraisals pa
+					WHERE pa.property_id = pr.id AND pa.appraised_at <= $2
+					ORDER BY pa.appraised_at DESC, pa.created_at DESC
+					LIMIT 1
+				), pr.purchase_price) AS value,
+				pr.currency
+			FROM properties pr
+			WHERE pr.asset_id = a.id
+		) pv ON true
+		WHERE a.user_id = $1 AND q.quantity <> 0
+		ORDER BY acc.name ASC, d.name ASC
+	`
+
+	var holdings []*valuation.Holding
+	err := r.db.SelectContext(ctx, &holdings, query, userID, at)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, h := range holdings {
+		h.Liability = asset.AssetType(h.AssetType).IsLiability() || account.AccountType(h.AccountType).IsLiability()
+	}
+	return holdings, nil
+}
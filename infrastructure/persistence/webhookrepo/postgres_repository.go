@@ -0,0 +1,198 @@
+package webhookrepo
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jmoiron/sqlx"
+
+	"siyahsensei/wallet-service/domain/webhook"
+)
+
+const subscriptionColumns = `id, user_id, url, secret, event_types, COALESCE(description, '') AS description,
+	enabled, created_at, updated_at`
+
+const deliveryColumns = `id, subscription_id, user_id, event_id, event_type, payload, status, attempts,
+	next_attempt_at, last_attempt_at, response_status, COALESCE(response_body, '') AS response_body,
+	COALESCE(error, '') AS error, duration_ms, redelivery_of, created_at, updated_at`
+
+type PostgresRepository struct {
+	db *sqlx.DB
+}
+
+func NewPostgresRepository(db *sqlx.DB) *PostgresRepository {
+	return &PostgresRepository{
+		db: db,
+	}
+}
+
+// subscriptionRow is the stored form of a subscription with its event types as JSON.
+type subscriptionRow struct {
+	webhook.Subscription
+	EventTypesJSON []byte `db:"event_types"`
+}
+
+func toSubscriptionRow(s *webhook.Subscription) (*subscriptionRow, error) {
+	eventTypes, err := json.Marshal(s.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+	return &subscriptionRow{Subscription: *s, EventTypesJSON: eventTypes}, nil
+}
+
+func (row *subscriptionRow) toSubscription() (*webhook.Subscription, error) {
+	s := row.Subscription
+	if err := json.Unmarshal(row.EventTypesJSON, &s.EventTypes); err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (r *PostgresRepository) Create(ctx context.Context, s *webhook.Subscription) error {
+	row, err := toSubscriptionRow(s)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO webhook_subscriptions (id, user_id, url, secret, event_types, description, enabled, created_at, updated_at)
+		VALUES (:id, :user_id, :url, :secret, :event_types, NULLIF(:description, ''), :enabled, :created_at, :updated_at)
+	`
+	_, err = r.db.NamedExecContext(ctx, query, row)
+	return err
+}
+
+func (r *PostgresRepository) Update(ctx context.Context, s *webhook.Subscription) error {
+	row, err := toSubscriptionRow(s)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE webhook_subscriptions
+		SET url = :url, secret = :secret, event_types = :event_types, description = NULLIF(:description, ''),
+			enabled = :enabled, updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err = r.db.NamedExecContext(ctx, query, row)
+	return err
+}
+
+func (r *PostgresRepository) Delete(ctx context.Context, id uuid.UUID) error {
+	_, err := r.db.ExecContext(ctx, "DELETE FROM webhook_subscriptions WHERE id = $1", id)
+	return err
+}
+
+func (r *PostgresRepository) GetByID(ctx context.Context, id uuid.UUID) (*webhook.Subscription, error) {
+	var row subscriptionRow
+	query := "SELECT " + subscriptionColumns + " FROM webhook_subscriptions WHERE id = $1"
+	if err := r.db.GetContext(ctx, &row, query, id); err != nil {
+		return nil, err
+	}
+	return row.toSubscription()
+}
+
+func (r *PostgresRepository) GetByUserID(ctx context.Context, userID uuid.UUID) ([]*webhook.Subscription, error) {
+	query := "SELECT " + subscriptionColumns + " FROM webhook_subscriptions WHERE user_id = $1 ORDER BY created_at ASC"
+	return r.selectSubscriptions(ctx, query, userID)
+}
+
+func (r *PostgresRepository) GetEnabledByUserID(ctx context.Context, userID uuid.UUID) ([]*webhook.Subscription, error) {
+	query := "SELECT " + subscriptionColumns + " FROM webhook_subscriptions WHERE user_id = $1 AND enabled ORDER BY created_at ASC"
+	return r.selectSubscriptions(ctx, query, userID)
+}
+
+func (r *PostgresRepository) selectSubscriptions(ctx context.Context, query string, args ...interface{}) ([]*webhook.Subscription, error) {
+	var rows []subscriptionRow
+	if err := r.db.SelectContext(ctx, &rows, query, args...); err != nil {
+		return nil, err
+	}
+
+	subscriptions := make([]*webhook.Subscription, 0, len(rows))
+	for i := range rows {
+		s, err := rows[i].toSubscription()
+		if err != nil {
+			return nil, err
+		}
+		subscriptions = append(subscriptions, s)
+	}
+	return subscriptions, nil
+}
+
+func (r *PostgresRepository) Enqueue(ctx context.Context, deliveries []*webhook.Delivery) error {
+	tx, err := r.db.BeginTxx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	query := `
+		INSERT INTO webhook_deliveries (id, subscription_id, user_id, event_id, event_type, payload, status, attempts,
+			next_attempt_at, last_attempt_at, response_status, response_body, error, duration_ms, redelivery_of,
+			created_at, updated_at)
+		VALUES (:id, :subscription_id, :user_id, :event_id, :event_type, :payload, :status, :attempts,
+			:next_attempt_at, :last_attempt_at, :response_status, NULLIF(:response_body, ''), NULLIF(:error, ''),
+			:duration_ms, :redelivery_of, :created_at, :updated_at)
+	`
+	for _, d := range deliveries {
+		if _, err := tx.NamedExecContext(ctx, query, d); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func (r *PostgresRepository) ClaimDue(ctx context.Context, limit int, lease time.Duration) ([]*webhook.Delivery, error) {
+	now := time.Now()
+	var deliveries []*webhook.Delivery
+	query := `
+		UPDATE webhook_deliveries SET next_attempt_at = $1
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status = $2 AND next_attempt_at <= $3
+			ORDER BY next_attempt_at
+			LIMIT $4
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+	err := r.db.SelectContext(ctx, &deliveries, query, now.Add(lease), webhook.Pending, now, limit)
+	return deliveries, err
+}
+
+func (r *PostgresRepository) SaveAttempt(ctx context.Context, d *webhook.Delivery) error {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = :status, attempts = :attempts, next_attempt_at = :next_attempt_at,
+			last_attempt_at = :last_attempt_at, response_status = :response_status,
+			response_body = NULLIF(:response_body, ''), error = NULLIF(:error, ''), duration_ms = :duration_ms,
+			updated_at = :updated_at
+		WHERE id = :id
+	`
+	_, err := r.db.NamedExecContext(ctx, query, d)
+	return err
+}
+
+func (r *PostgresRepository) GetDeliveries(ctx context.Context, subscriptionID uuid.UUID, status *webhook.Status, limit, offset int) ([]*webhook.Delivery, error) {
+	var deliveries []*webhook.Delivery
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries WHERE subscription_id = $1"
+	args := []interface{}{subscriptionID, limit, offset}
+	if status != nil {
+		query += " AND status = $4"
+		args = append(args, *status)
+	}
+	query += " ORDER BY created_at DESC LIMIT $2 OFFSET $3"
+	err := r.db.SelectContext(ctx, &deliveries, query, args...)
+	return deliveries, err
+}
+
+func (r *PostgresRepository) GetDeliveryByID(ctx context.Context, id uuid.UUID) (*webhook.Delivery, error) {
+	var delivery webhook.Delivery
+	query := "SELECT " + deliveryColumns + " FROM webhook_deliveries WHERE id = $1"
+	if err := r.db.GetContext(ctx, &delivery, query, id); err != nil {
+		return nil, err
+	}
+	return &delivery, nil
+}
@@ -0,0 +1,115 @@
+package pricing
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"siyahsensei/wallet-service/domain/price"
+)
+
+// FileProvider reads quotes from a local CSV or JSON file. The file is read on
+// every fetch so fixtures can be edited while the service runs.
+//
+// CSV files need a header with symbol and price columns and may add currency
+// and timestamp columns. JSON files hold an array of quote objects.
+type FileProvider struct {
+	path            string
+	defaultCurrency string
+}
+
+func NewFileProvider(path, defaultCurrency string) *FileProvider {
+	return &FileProvider{
+		path:            path,
+		defaultCurrency: defaultCurrency,
+	}
+}
+
+func (p *FileProvider) Name() string {
+	return "file:" + filepath.Base(p.path)
+}
+
+func (p *FileProvider) FetchQuotes(ctx context.Context, abbreviations []string) ([]price.Quote, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var records []quoteRecord
+	if strings.EqualFold(filepath.Ext(p.path), ".json") {
+		if err := json.NewDecoder(f).Decode(&records); err != nil {
+			return nil, fmt.Errorf("invalid price file: %w", err)
+		}
+	} else {
+		records, err = readCSVQuotes(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return filterQuotes(records, abbreviations, p.defaultCurrency), nil
+}
+
+func readCSVQuotes(r io.Reader) ([]quoteRecord, error) {
+	reader := csv.NewReader(r)
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("invalid price file: %w", err)
+	}
+
+	columns := make(map[string]int)
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	symbolIndex, ok := columns["symbol"]
+	if !ok {
+		return nil, fmt.Errorf("invalid price file: missing symbol column")
+	}
+	priceIndex, ok := columns["price"]
+	if !ok {
+		return nil, fmt.Errorf("invalid price file: missing price column")
+	}
+	currencyIndex, hasCurrency := columns["currency"]
+	timestampIndex, hasTimestamp := columns["timestamp"]
+
+	var records []quoteRecord
+	for line := 2; ; line++ {
+		row, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid price file: %w", err)
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimSpace(row[priceIndex]), 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price on line %d", line)
+		}
+
+		record := quoteRecord{
+			Symbol: strings.TrimSpace(row[symbolIndex]),
+			Price:  value,
+		}
+		if hasCurrency {
+			record.Currency = strings.TrimSpace(row[currencyIndex])
+		}
+		if hasTimestamp {
+			record.Timestamp, err = parseTimestamp(row[timestampIndex])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", line, err)
+			}
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
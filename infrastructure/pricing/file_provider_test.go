@@ -0,0 +1,100 @@
+package pricing
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestFileProviderFetchQuotes(t *testing.T) {
+	tests := []struct {
+		name     string
+		file     string
+		content  string
+		want     map[string]float64
+		currency string
+		pricedAt time.Time
+		err      string
+	}{
+		{
+			name:     "csv",
+			file:     "prices.csv",
+			content:  "Symbol, Price, Currency, Timestamp\nBTC, 60000, usd, 2024-03-15T00:00:00Z\nETH, 3000, , 1710460800\nXAU, 2100, USD,\n",
+			want:     map[string]float64{"BTC": 60000, "ETH": 3000},
+			currency: "USD",
+			pricedAt: time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "json",
+			file:     "prices.JSON",
+			content:  `[{"symbol":"btc","price":60000,"currency":"usd","timestamp":1710460800},{"symbol":"XAU","price":2100}]`,
+			want:     map[string]float64{"btc": 60000},
+			currency: "USD",
+			pricedAt: time.Unix(1710460800, 0),
+		},
+		{
+			name:    "csv without a price column",
+			file:    "prices.csv",
+			content: "symbol,value\nBTC,60000\n",
+			err:     "invalid price file: missing price column",
+		},
+		{
+			name:    "csv with a bad price",
+			file:    "prices.csv",
+			content: "symbol,price\nBTC,60000\nETH,n/a\n",
+			err:     "invalid price on line 3",
+		},
+		{
+			name:    "csv with a bad timestamp",
+			file:    "prices.csv",
+			content: "symbol,price,timestamp\nBTC,60000,yesterday\n",
+			err:     `line 2: invalid timestamp "yesterday"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			quotes, err := NewFileProvider(path, "EUR").FetchQuotes(context.Background(), []string{"BTC", "eth"})
+			if tt.err != "" {
+				if err == nil || err.Error() != tt.err {
+					t.Fatalf("FetchQuotes error = %v, want %q", err, tt.err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("FetchQuotes: %v", err)
+			}
+			if len(quotes) != len(tt.want) {
+				t.Fatalf("FetchQuotes returned %d quotes, want %d", len(quotes), len(tt.want))
+			}
+			for _, q := range quotes {
+				if q.Price != tt.want[q.Abbreviation] {
+					t.Errorf("%s = %v, want %v", q.Abbreviation, q.Price, tt.want[q.Abbreviation])
+				}
+			}
+			if quotes[0].QuoteCurrency != tt.currency || !quotes[0].PricedAt.Equal(tt.pricedAt) {
+				t.Errorf("first quote in %s at %v, want %s at %v", quotes[0].QuoteCurrency, quotes[0].PricedAt, tt.currency, tt.pricedAt)
+			}
+		})
+	}
+}
+
+func TestFileProviderDefaultCurrency(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.csv")
+	if err := os.WriteFile(path, []byte("symbol,price\nBTC,60000\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	quotes, err := NewFileProvider(path, "eur").FetchQuotes(context.Background(), []string{"BTC"})
+	if err != nil {
+		t.Fatalf("FetchQuotes: %v", err)
+	}
+	if len(quotes) != 1 || quotes[0].QuoteCurrency != "EUR" || !quotes[0].PricedAt.IsZero() {
+		t.Errorf("FetchQuotes = %+v, want one EUR quote without a time", quotes)
+	}
+}
@@ -0,0 +1,108 @@
+package pricing
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/domain/price"
+)
+
+const httpBatchSize = 100
+
+// HTTPProvider asks a JSON endpoint for quotes with GET <url>?symbols=A,B,C.
+// The endpoint answers with an array of quote objects or with {"quotes": [...]}.
+type HTTPProvider struct {
+	endpoint        string
+	apiKey          string
+	defaultCurrency string
+	client          *http.Client
+}
+
+func NewHTTPProvider(endpoint, apiKey, defaultCurrency string) *HTTPProvider {
+	return &HTTPProvider{
+		endpoint:        endpoint,
+		apiKey:          apiKey,
+		defaultCurrency: defaultCurrency,
+		client:          &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+func (p *HTTPProvider) Name() string {
+	if u, err := url.Parse(p.endpoint); err == nil && u.Host != "" {
+		return "http:" + u.Host
+	}
+	return "http"
+}
+
+func (p *HTTPProvider) FetchQuotes(ctx context.Context, abbreviations []string) ([]price.Quote, error) {
+	var quotes []price.Quote
+	for start := 0; start < len(abbreviations); start += httpBatchSize {
+		end := start + httpBatchSize
+		if end > len(abbreviations) {
+			end = len(abbreviations)
+		}
+
+		records, err := p.fetch(ctx, abbreviations[start:end])
+		if err != nil {
+			return nil, err
+		}
+		quotes = append(quotes, filterQuotes(records, abbreviations[start:end], p.defaultCurrency)...)
+	}
+	return quotes, nil
+}
+
+func (p *HTTPProvider) fetch(ctx context.Context, abbreviations []string) ([]quoteRecord, error) {
+	u, err := url.Parse(p.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	params := u.Query()
+	params.Set("symbols", strings.Join(abbreviations, ","))
+	u.RawQuery = params.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price provider responded with status %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []quoteRecord
+	if trimmed := bytes.TrimSpace(body); len(trimmed) > 0 && trimmed[0] == '[' {
+		err = json.Unmarshal(trimmed, &records)
+	} else {
+		var envelope struct {
+			Quotes []quoteRecord `json:"quotes"`
+		}
+		err = json.Unmarshal(trimmed, &envelope)
+		records = envelope.Quotes
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid price provider response: %w", err)
+	}
+	return records, nil
+}
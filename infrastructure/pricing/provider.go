@@ -0,0 +1,97 @@
+package pricing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"siyahsensei/wallet-service/configs"
+	"siyahsensei/wallet-service/domain/price"
+)
+
+const (
+	ProviderFile = "file"
+	ProviderHTTP = "http"
+)
+
+// NewProvider builds the price provider selected in the configuration. It returns
+// nil when no provider is configured.
+func NewProvider(config *configs.Config) (price.PriceProvider, error) {
+	switch strings.ToLower(config.PriceProvider) {
+	case "":
+		return nil, nil
+	case ProviderFile:
+		if config.PriceProviderSource == "" {
+			return nil, fmt.Errorf("price provider %q requires PRICE_PROVIDER_SOURCE", ProviderFile)
+		}
+		return NewFileProvider(config.PriceProviderSource, config.PriceQuoteCurrency), nil
+	case ProviderHTTP:
+		if config.PriceProviderSource == "" {
+			return nil, fmt.Errorf("price provider %q requires PRICE_PROVIDER_SOURCE", ProviderHTTP)
+		}
+		return NewHTTPProvider(config.PriceProviderSource, config.PriceProviderAPIKey, config.PriceQuoteCurrency), nil
+	default:
+		return nil, fmt.Errorf("unknown price provider %q", config.PriceProvider)
+	}
+}
+
+// quoteRecord is the wire format shared by the JSON file and HTTP providers.
+type quoteRecord struct {
+	Symbol    string  `json:"symbol"`
+	Price     float64 `json:"price"`
+	Currency  string  `json:"currency"`
+	Timestamp int64   `json:"timestamp"`
+}
+
+func (r quoteRecord) toQuote(defaultCurrency string) price.Quote {
+	currency := r.Currency
+	if currency == "" {
+		currency = defaultCurrency
+	}
+
+	var pricedAt time.Time
+	if r.Timestamp > 0 {
+		pricedAt = time.Unix(r.Timestamp, 0)
+	}
+
+	return price.Quote{
+		Abbreviation:  r.Symbol,
+		QuoteCurrency: strings.ToUpper(currency),
+		Price:         r.Price,
+		PricedAt:      pricedAt,
+	}
+}
+
+// filterQuotes keeps the records whose symbol was requested.
+func filterQuotes(records []quoteRecord, abbreviations []string, defaultCurrency string) []price.Quote {
+	wanted := make(map[string]bool, len(abbreviations))
+	for _, a := range abbreviations {
+		wanted[strings.ToUpper(a)] = true
+	}
+
+	var quotes []price.Quote
+	for _, r := range records {
+		if !wanted[strings.ToUpper(r.Symbol)] {
+			continue
+		}
+		quotes = append(quotes, r.toQuote(defaultCurrency))
+	}
+	return quotes
+}
+
+// parseTimestamp accepts unix seconds or RFC3339.
+func parseTimestamp(value string) (int64, error) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, nil
+	}
+	if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+		return seconds, nil
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid timestamp %q", value)
+	}
+	return t.Unix(), nil
+}
@@ -0,0 +1,91 @@
+package storage
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const extension = ".zip"
+
+// FileStorage keeps generated files in a local directory, one file per ID.
+type FileStorage struct {
+	directory string
+}
+
+func NewFileStorage(directory string) (*FileStorage, error) {
+	if err := os.MkdirAll(directory, 0o700); err != nil {
+		return nil, err
+	}
+	return &FileStorage{
+		directory: directory,
+	}, nil
+}
+
+// Create writes to a temporary file that only takes the final name once closed, so a
+// half written file is never served.
+func (s *FileStorage) Create(id uuid.UUID) (io.WriteCloser, error) {
+	file, err := os.CreateTemp(s.directory, id.String()+"-*.tmp")
+	if err != nil {
+		return nil, err
+	}
+	return &pendingFile{File: file, path: s.path(id)}, nil
+}
+
+func (s *FileStorage) Open(id uuid.UUID) (io.ReadCloser, error) {
+	return os.Open(s.path(id))
+}
+
+func (s *FileStorage) Remove(id uuid.UUID) error {
+	err := os.Remove(s.path(id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	return err
+}
+
+func (s *FileStorage) Prune(t time.Time) (int, error) {
+	entries, err := os.ReadDir(s.directory)
+	if err != nil {
+		return 0, err
+	}
+
+	removed := 0
+	for _, entry := range entries {
+		if entry.IsDir() || (!strings.HasSuffix(entry.Name(), extension) && !strings.HasSuffix(entry.Name(), ".tmp")) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil || !info.ModTime().Before(t) {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.directory, entry.Name())); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return removed, err
+		}
+		removed++
+	}
+	return removed, nil
+}
+
+func (s *FileStorage) path(id uuid.UUID) string {
+	return filepath.Join(s.directory, id.String()+extension)
+}
+
+type pendingFile struct {
+	*os.File
+	path string
+}
+
+func (f *pendingFile) Close() error {
+	if err := f.File.Close(); err != nil {
+		os.Remove(f.File.Name())
+		return err
+	}
+	return os.Rename(f.File.Name(), f.path)
+}
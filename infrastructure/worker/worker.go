@@ -0,0 +1,75 @@
+package worker
+
+import (
+	"context"
+	"time"
+
+	customLogger "siyahsensei/wallet-service/infrastructure/configuration/logger"
+)
+
+// Job is a unit of background work run on a fixed interval.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	// Trigger, when set, also runs the job whenever it receives, without waiting for the interval
+	Trigger <-chan struct{}
+	Run     func(ctx context.Context) error
+}
+
+// Start runs every job once right away and then on its interval, or when triggered,
+// until ctx is cancelled. Each job has its own goroutine, so a slow job never delays
+// another and a single job never overlaps with itself. Jobs without a positive interval
+// are skipped.
+func Start(ctx context.Context, jobs ...Job) {
+	for _, job := range jobs {
+		if job.Interval <= 0 {
+			customLogger.Warn("Background job skipped, its interval is not positive", map[string]interface{}{
+				"job":      job.Name,
+				"interval": job.Interval.String(),
+			})
+			continue
+		}
+		go run(ctx, job)
+	}
+}
+
+func run(ctx context.Context, job Job) {
+	customLogger.Info("Background job started", map[string]interface{}{
+		"job":      job.Name,
+		"interval": job.Interval.String(),
+	})
+
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		execute(ctx, job)
+
+		select {
+		case <-ctx.Done():
+			customLogger.Info("Background job stopped", map[string]interface{}{
+				"job": job.Name,
+			})
+			return
+		case <-ticker.C:
+		case <-job.Trigger:
+		}
+	}
+}
+
+func execute(ctx context.Context, job Job) {
+	defer func() {
+		if r := recover(); r != nil {
+			customLogger.Warn("Background job panicked", map[string]interface{}{
+				"job":   job.Name,
+				"panic": r,
+			})
+		}
+	}()
+
+	if err := job.Run(ctx); err != nil && ctx.Err() == nil {
+		customLogger.Error("Background job failed", err, map[string]interface{}{
+			"job": job.Name,
+		})
+	}
+}
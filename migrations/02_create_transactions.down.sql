@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_transactions_transaction_date;
+DROP INDEX IF EXISTS idx_transactions_asset_id;
+DROP INDEX IF EXISTS idx_transactions_user_id;
+
+DROP TABLE IF EXISTS transactions;
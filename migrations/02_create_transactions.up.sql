@@ -0,0 +1,26 @@
+-- +migrate Up
+-- Ledger of individual asset movements; assets.quantity is derived from these rows
+
+CREATE TABLE transactions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    quantity DECIMAL(20,8) NOT NULL,
+    price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    notes TEXT NOT NULL DEFAULT '',
+    transaction_date TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_transactions_user_id ON transactions(user_id);
+CREATE INDEX idx_transactions_asset_id ON transactions(asset_id);
+CREATE INDEX idx_transactions_transaction_date ON transactions(transaction_date);
+
+-- Existing balances become opening deposits, as for new assets, so the derived quantity matches
+INSERT INTO transactions (id, user_id, asset_id, type, quantity, notes, transaction_date, created_at, updated_at)
+SELECT gen_random_uuid(), user_id, id, 'DEPOSIT', quantity, 'Opening balance', created_at, NOW(), NOW()
+FROM assets
+WHERE quantity <> 0;
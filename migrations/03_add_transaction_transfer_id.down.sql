@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_transactions_transfer_id;
+
+ALTER TABLE transactions DROP COLUMN IF EXISTS transfer_id;
@@ -0,0 +1,6 @@
+-- +migrate Up
+-- Links the legs of an account-to-account transfer together
+
+ALTER TABLE transactions ADD COLUMN transfer_id UUID;
+
+CREATE INDEX idx_transactions_transfer_id ON transactions(transfer_id);
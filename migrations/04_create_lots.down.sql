@@ -0,0 +1,14 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_lot_disposals_transaction_id;
+DROP INDEX IF EXISTS idx_lot_disposals_asset_id;
+DROP INDEX IF EXISTS idx_lot_disposals_lot_id;
+DROP INDEX IF EXISTS idx_lots_transaction_id;
+DROP INDEX IF EXISTS idx_lots_asset_id;
+
+DROP TABLE IF EXISTS lot_disposals;
+DROP TABLE IF EXISTS lots;
+
+ALTER TABLE assets DROP COLUMN IF EXISTS purchase_currency;
+ALTER TABLE assets DROP COLUMN IF EXISTS purchase_price;
+ALTER TABLE assets DROP COLUMN IF EXISTS cost_basis_method;
@@ -0,0 +1,49 @@
+-- +migrate Up
+-- Per-lot cost basis tracking. Acquisitions open lots, disposals relieve them
+-- according to the cost basis method of the asset.
+
+ALTER TABLE assets ADD COLUMN cost_basis_method VARCHAR(20) NOT NULL DEFAULT 'FIFO';
+ALTER TABLE assets ADD COLUMN purchase_price DECIMAL(20,8) NOT NULL DEFAULT 0;
+ALTER TABLE assets ADD COLUMN purchase_currency VARCHAR(10) NOT NULL DEFAULT '';
+
+CREATE TABLE lots (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE CASCADE,
+    quantity DECIMAL(20,8) NOT NULL,
+    remaining_quantity DECIMAL(20,8) NOT NULL,
+    unit_price DECIMAL(20,8) NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    acquired_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE lot_disposals (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    lot_id UUID NOT NULL REFERENCES lots(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    kind VARCHAR(20) NOT NULL,
+    quantity DECIMAL(20,8) NOT NULL,
+    unit_cost DECIMAL(20,8) NOT NULL,
+    unit_proceeds DECIMAL(20,8) NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    acquired_at TIMESTAMP NOT NULL,
+    disposed_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_lots_asset_id ON lots(asset_id);
+CREATE INDEX idx_lots_transaction_id ON lots(transaction_id);
+CREATE INDEX idx_lot_disposals_lot_id ON lot_disposals(lot_id);
+CREATE INDEX idx_lot_disposals_asset_id ON lot_disposals(asset_id);
+CREATE INDEX idx_lot_disposals_transaction_id ON lot_disposals(transaction_id);
+
+-- Existing holdings get a single lot with unknown (zero) cost
+INSERT INTO lots (id, user_id, asset_id, quantity, remaining_quantity, acquired_at, created_at, updated_at)
+SELECT gen_random_uuid(), user_id, id, quantity, quantity, created_at, NOW(), NOW()
+FROM assets
+WHERE quantity > 0;
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_price_history_lookup;
+
+DROP TABLE IF EXISTS price_history;
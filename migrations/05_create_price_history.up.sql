@@ -0,0 +1,15 @@
+-- +migrate Up
+-- What a definition was worth in a quote currency at a point in time
+
+CREATE TABLE price_history (
+    id UUID PRIMARY KEY,
+    definition_id UUID NOT NULL REFERENCES definitions(id) ON DELETE CASCADE,
+    quote_currency VARCHAR(10) NOT NULL,
+    priced_at TIMESTAMP NOT NULL,
+    price DECIMAL(28,10) NOT NULL,
+    source VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (definition_id, quote_currency, priced_at, source)
+);
+
+CREATE INDEX idx_price_history_lookup ON price_history(definition_id, quote_currency, priced_at DESC);
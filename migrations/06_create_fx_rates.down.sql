@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_fx_rates_pair;
+
+DROP TABLE IF EXISTS fx_rates;
@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Exchange rates: one unit of base_currency buys rate units of quote_currency
+
+CREATE TABLE fx_rates (
+    id UUID PRIMARY KEY,
+    base_currency VARCHAR(10) NOT NULL,
+    quote_currency VARCHAR(10) NOT NULL,
+    rate DECIMAL(28,10) NOT NULL,
+    observed_at TIMESTAMP NOT NULL,
+    source VARCHAR(50) NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (base_currency, quote_currency, observed_at, source)
+);
+
+CREATE INDEX idx_fx_rates_pair ON fx_rates(base_currency, quote_currency, observed_at DESC);
@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_portfolio_snapshots_user_date;
+
+DROP TABLE IF EXISTS portfolio_snapshots;
@@ -0,0 +1,17 @@
+-- +migrate Up
+-- One valuation of a user's holdings per day, broken down by account and asset type
+
+CREATE TABLE portfolio_snapshots (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    snapshot_date DATE NOT NULL,
+    base_currency VARCHAR(10) NOT NULL,
+    total_value DECIMAL(28,10) NOT NULL,
+    unpriced INTEGER NOT NULL DEFAULT 0,
+    by_account JSONB NOT NULL DEFAULT '[]',
+    by_asset_type JSONB NOT NULL DEFAULT '{}',
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (user_id, snapshot_date)
+);
+
+CREATE INDEX idx_portfolio_snapshots_user_date ON portfolio_snapshots(user_id, snapshot_date);
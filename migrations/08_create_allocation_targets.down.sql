@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_allocation_targets_user_id;
+
+DROP TABLE IF EXISTS allocation_targets;
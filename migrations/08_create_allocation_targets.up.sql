@@ -0,0 +1,15 @@
+-- +migrate Up
+-- Target weights a user wants the portfolio to hold, per asset type or per definition
+
+CREATE TABLE allocation_targets (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    group_by VARCHAR(20) NOT NULL,
+    key VARCHAR(100) NOT NULL,
+    weight DECIMAL(9,4) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (user_id, group_by, key)
+);
+
+CREATE INDEX idx_allocation_targets_user_id ON allocation_targets(user_id);
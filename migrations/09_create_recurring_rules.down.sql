@@ -0,0 +1,8 @@
+-- +migrate Down
+
+DROP TABLE IF EXISTS recurring_occurrences;
+
+DROP INDEX IF EXISTS idx_recurring_rules_due;
+DROP INDEX IF EXISTS idx_recurring_rules_user_id;
+
+DROP TABLE IF EXISTS recurring_rules;
@@ -0,0 +1,42 @@
+-- +migrate Up
+-- Rules that post a ledger entry on a schedule, and the occurrences already handled
+
+CREATE TABLE recurring_rules (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    definition_id UUID NOT NULL REFERENCES definitions(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    asset_type VARCHAR(50) NOT NULL,
+    transaction_type VARCHAR(20) NOT NULL,
+    amount DECIMAL(28,10) NOT NULL,
+    price DECIMAL(28,10) NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    frequency VARCHAR(20) NOT NULL,
+    frequency_interval INTEGER NOT NULL DEFAULT 1,
+    day_of_month INTEGER NOT NULL DEFAULT 0,
+    day_of_week INTEGER NOT NULL DEFAULT 0,
+    month_of_year INTEGER NOT NULL DEFAULT 0,
+    start_date TIMESTAMP NOT NULL,
+    end_date TIMESTAMP,
+    next_run_at TIMESTAMP,
+    last_run_at TIMESTAMP,
+    active BOOLEAN NOT NULL DEFAULT TRUE,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_recurring_rules_user_id ON recurring_rules(user_id);
+CREATE INDEX idx_recurring_rules_due ON recurring_rules(next_run_at) WHERE active;
+
+CREATE TABLE recurring_occurrences (
+    id UUID PRIMARY KEY,
+    rule_id UUID NOT NULL REFERENCES recurring_rules(id) ON DELETE CASCADE,
+    occurrence_date TIMESTAMP NOT NULL,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (rule_id, occurrence_date)
+);
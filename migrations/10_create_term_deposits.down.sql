@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_term_deposits_due;
+DROP INDEX IF EXISTS idx_term_deposits_user_id;
+
+DROP TABLE IF EXISTS term_deposits;
@@ -0,0 +1,25 @@
+-- +migrate Up
+-- Terms of TERM_DEPOSIT assets; the balance itself stays on the ledger
+
+CREATE TABLE term_deposits (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL UNIQUE REFERENCES assets(id) ON DELETE CASCADE,
+    principal DECIMAL(28,10) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    annual_rate DECIMAL(12,6) NOT NULL,
+    compounding VARCHAR(20) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    maturity_date TIMESTAMP NOT NULL,
+    withholding_tax_rate DECIMAL(12,6) NOT NULL DEFAULT 0,
+    maturity_action VARCHAR(20) NOT NULL,
+    payout_account_id UUID REFERENCES accounts(id) ON DELETE SET NULL,
+    status VARCHAR(20) NOT NULL,
+    rollovers INTEGER NOT NULL DEFAULT 0,
+    matured_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_term_deposits_user_id ON term_deposits(user_id);
+CREATE INDEX idx_term_deposits_due ON term_deposits(maturity_date) WHERE status = 'ACTIVE';
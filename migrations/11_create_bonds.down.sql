@@ -0,0 +1,5 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_bonds_user_id;
+
+DROP TABLE IF EXISTS bonds;
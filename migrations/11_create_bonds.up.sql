@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Terms of BOND assets; the asset quantity is the number of bonds held
+
+CREATE TABLE bonds (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL UNIQUE REFERENCES assets(id) ON DELETE CASCADE,
+    face_value DECIMAL(28,10) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    coupon_rate DECIMAL(12,6) NOT NULL DEFAULT 0,
+    coupon_frequency INTEGER NOT NULL DEFAULT 0,
+    issue_date TIMESTAMP NOT NULL,
+    maturity_date TIMESTAMP NOT NULL,
+    day_count VARCHAR(20) NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_bonds_user_id ON bonds(user_id);
@@ -0,0 +1,6 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_income_asset_id;
+DROP INDEX IF EXISTS idx_income_user_id_pay_date;
+
+DROP TABLE IF EXISTS income;
@@ -0,0 +1,19 @@
+-- +migrate Up
+-- Dividends, interest, coupons, staking rewards and rent received on assets
+
+CREATE TABLE income (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL REFERENCES assets(id) ON DELETE CASCADE,
+    type VARCHAR(20) NOT NULL,
+    gross_amount DECIMAL(28,10) NOT NULL,
+    withholding_tax DECIMAL(28,10) NOT NULL DEFAULT 0,
+    currency VARCHAR(10) NOT NULL,
+    pay_date TIMESTAMP NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_income_user_id_pay_date ON income(user_id, pay_date);
+CREATE INDEX idx_income_asset_id ON income(asset_id);
@@ -0,0 +1,9 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_loan_payments_loan_id;
+
+DROP TABLE IF EXISTS loan_payments;
+
+DROP INDEX IF EXISTS idx_loans_user_id;
+
+DROP TABLE IF EXISTS loans;
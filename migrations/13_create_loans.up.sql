@@ -0,0 +1,36 @@
+-- +migrate Up
+-- Terms of DEBT assets that are loans, and the payments made on them
+
+CREATE TABLE loans (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL UNIQUE REFERENCES assets(id) ON DELETE CASCADE,
+    principal DECIMAL(28,10) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    annual_rate DECIMAL(12,6) NOT NULL,
+    term_periods INTEGER NOT NULL,
+    payment_frequency INTEGER NOT NULL,
+    method VARCHAR(20) NOT NULL,
+    start_date TIMESTAMP NOT NULL,
+    first_payment_date TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_loans_user_id ON loans(user_id);
+
+CREATE TABLE loan_payments (
+    id UUID PRIMARY KEY,
+    loan_id UUID NOT NULL REFERENCES loans(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    paid_at TIMESTAMP NOT NULL,
+    amount DECIMAL(28,10) NOT NULL,
+    principal DECIMAL(28,10) NOT NULL,
+    interest DECIMAL(28,10) NOT NULL,
+    from_asset_id UUID REFERENCES assets(id) ON DELETE SET NULL,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_loan_payments_loan_id ON loan_payments(loan_id, paid_at);
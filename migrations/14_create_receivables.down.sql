@@ -0,0 +1,9 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_receivable_repayments_receivable_id;
+
+DROP TABLE IF EXISTS receivable_repayments;
+
+DROP INDEX IF EXISTS idx_receivables_user_id;
+
+DROP TABLE IF EXISTS receivables;
@@ -0,0 +1,35 @@
+-- +migrate Up
+-- Terms of RECEIVABLE assets with their counterparty, and the repayments made on them
+
+CREATE TABLE receivables (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL UNIQUE REFERENCES assets(id) ON DELETE CASCADE,
+    counterparty_name VARCHAR(255) NOT NULL,
+    counterparty_contact VARCHAR(255),
+    amount DECIMAL(28,10) NOT NULL,
+    currency VARCHAR(10) NOT NULL DEFAULT '',
+    issue_date TIMESTAMP NOT NULL,
+    due_date TIMESTAMP NOT NULL,
+    repaid DECIMAL(28,10) NOT NULL DEFAULT 0,
+    written_off DECIMAL(28,10) NOT NULL DEFAULT 0,
+    written_off_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_receivables_user_id ON receivables(user_id, due_date);
+
+CREATE TABLE receivable_repayments (
+    id UUID PRIMARY KEY,
+    receivable_id UUID NOT NULL REFERENCES receivables(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    repaid_at TIMESTAMP NOT NULL,
+    amount DECIMAL(28,10) NOT NULL,
+    to_asset_id UUID REFERENCES assets(id) ON DELETE SET NULL,
+    transaction_id UUID REFERENCES transactions(id) ON DELETE SET NULL,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_receivable_repayments_receivable_id ON receivable_repayments(receivable_id, repaid_at);
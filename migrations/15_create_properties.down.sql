@@ -0,0 +1,13 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_property_expenses_property_id;
+
+DROP TABLE IF EXISTS property_expenses;
+
+DROP INDEX IF EXISTS idx_property_appraisals_property_id;
+
+DROP TABLE IF EXISTS property_appraisals;
+
+DROP INDEX IF EXISTS idx_properties_user_id;
+
+DROP TABLE IF EXISTS properties;
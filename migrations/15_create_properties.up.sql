@@ -0,0 +1,43 @@
+-- +migrate Up
+-- Purchase details of REAL_ESTATE assets, their appraisals over time and running expenses
+
+CREATE TABLE properties (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    asset_id UUID NOT NULL UNIQUE REFERENCES assets(id) ON DELETE CASCADE,
+    currency VARCHAR(10) NOT NULL,
+    purchase_price DECIMAL(28,10) NOT NULL,
+    purchase_costs DECIMAL(28,10) NOT NULL DEFAULT 0,
+    purchase_date TIMESTAMP NOT NULL,
+    mortgage_loan_id UUID REFERENCES loans(id) ON DELETE SET NULL,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_properties_user_id ON properties(user_id);
+
+CREATE TABLE property_appraisals (
+    id UUID PRIMARY KEY,
+    property_id UUID NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    value DECIMAL(28,10) NOT NULL,
+    appraised_at TIMESTAMP NOT NULL,
+    source VARCHAR(100),
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_property_appraisals_property_id ON property_appraisals(property_id, appraised_at);
+
+CREATE TABLE property_expenses (
+    id UUID PRIMARY KEY,
+    property_id UUID NOT NULL REFERENCES properties(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    category VARCHAR(20) NOT NULL,
+    amount DECIMAL(28,10) NOT NULL,
+    paid_at TIMESTAMP NOT NULL,
+    notes TEXT,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_property_expenses_property_id ON property_expenses(property_id, paid_at);
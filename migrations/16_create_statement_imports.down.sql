@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_statement_transactions_transaction_id;
+
+DROP TABLE IF EXISTS statement_transactions;
+
+DROP TABLE IF EXISTS statement_accounts;
@@ -0,0 +1,25 @@
+-- +migrate Up
+-- Accounts bank statements were imported into, and the statement lines already posted
+-- so that overlapping statements are not counted twice
+
+CREATE TABLE statement_accounts (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    account_key VARCHAR(255) NOT NULL,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL,
+    UNIQUE (user_id, account_key)
+);
+
+CREATE TABLE statement_transactions (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    account_id UUID NOT NULL REFERENCES accounts(id) ON DELETE CASCADE,
+    fitid VARCHAR(255) NOT NULL,
+    transaction_id UUID NOT NULL REFERENCES transactions(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL,
+    UNIQUE (account_id, fitid)
+);
+
+CREATE INDEX idx_statement_transactions_transaction_id ON statement_transactions(transaction_id);
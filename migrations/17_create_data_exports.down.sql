@@ -0,0 +1,7 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_data_exports_status;
+
+DROP INDEX IF EXISTS idx_data_exports_user_id;
+
+DROP TABLE IF EXISTS data_exports;
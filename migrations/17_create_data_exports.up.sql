@@ -0,0 +1,21 @@
+-- +migrate Up
+-- Archives of a user's data generated in the background, downloadable until they expire
+
+CREATE TABLE data_exports (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    status VARCHAR(20) NOT NULL,
+    file_name VARCHAR(255),
+    size BIGINT NOT NULL DEFAULT 0,
+    records INTEGER NOT NULL DEFAULT 0,
+    error TEXT,
+    started_at TIMESTAMP,
+    completed_at TIMESTAMP,
+    expires_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_data_exports_user_id ON data_exports(user_id, created_at);
+
+CREATE INDEX idx_data_exports_status ON data_exports(status, created_at);
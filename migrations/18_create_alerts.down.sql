@@ -0,0 +1,13 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_alert_triggers_inbox;
+
+DROP INDEX IF EXISTS idx_alert_triggers_alert_id;
+
+DROP INDEX IF EXISTS idx_alerts_definition_id;
+
+DROP INDEX IF EXISTS idx_alerts_user_id;
+
+DROP TABLE IF EXISTS alert_triggers;
+
+DROP TABLE IF EXISTS alerts;
@@ -0,0 +1,47 @@
+-- +migrate Up
+-- Price and portfolio alerts, and the record of every time one fired, which doubles as the in-app inbox
+
+CREATE TABLE alerts (
+    id UUID PRIMARY KEY,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    kind VARCHAR(30) NOT NULL,
+    definition_id UUID REFERENCES definitions(id) ON DELETE CASCADE,
+    account_id UUID REFERENCES accounts(id) ON DELETE CASCADE,
+    currency VARCHAR(10) NOT NULL,
+    threshold DECIMAL(20,8) NOT NULL,
+    channels JSONB NOT NULL DEFAULT '["INBOX"]',
+    webhook_url TEXT,
+    webhook_secret VARCHAR(100),
+    email VARCHAR(255),
+    enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    triggered BOOLEAN NOT NULL DEFAULT FALSE,
+    last_value DECIMAL(20,8),
+    last_evaluated_at TIMESTAMP,
+    last_triggered_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL,
+    updated_at TIMESTAMP NOT NULL
+);
+
+CREATE TABLE alert_triggers (
+    id UUID PRIMARY KEY,
+    alert_id UUID NOT NULL REFERENCES alerts(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    kind VARCHAR(30) NOT NULL,
+    value DECIMAL(20,8) NOT NULL,
+    threshold DECIMAL(20,8) NOT NULL,
+    message TEXT NOT NULL,
+    inbox BOOLEAN NOT NULL DEFAULT FALSE,
+    deliveries JSONB NOT NULL DEFAULT '[]',
+    triggered_at TIMESTAMP NOT NULL,
+    read_at TIMESTAMP,
+    created_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX idx_alerts_user_id ON alerts(user_id);
+
+CREATE INDEX idx_alerts_definition_id ON alerts(definition_id) WHERE enabled;
+
+CREATE INDEX idx_alert_triggers_alert_id ON alert_triggers(alert_id, triggered_at);
+
+CREATE INDEX idx_alert_triggers_inbox ON alert_triggers(user_id, triggered_at) WHERE inbox;
@@ -0,0 +1,15 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_event_outbox_occurred_at;
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_subscription_id;
+
+DROP INDEX IF EXISTS idx_webhook_deliveries_due;
+
+DROP INDEX IF EXISTS idx_webhook_subscriptions_user_id;
+
+DROP TABLE IF EXISTS event_outbox;
+
+DROP TABLE IF EXISTS webhook_deliveries;
+
+DROP TABLE IF EXISTS webhook_subscriptions;
@@ -0,0 +1,11 @@
+-- +migrate Down
+
+DROP INDEX IF EXISTS idx_definitions_user_abbreviation;
+
+DROP INDEX IF EXISTS idx_definitions_shared_abbreviation;
+
+DELETE FROM definitions WHERE user_id IS NOT NULL;
+
+ALTER TABLE definitions ADD CONSTRAINT definitions_abbreviation_key UNIQUE (abbreviation);
+
+ALTER TABLE definitions DROP COLUMN IF EXISTS user_id;
@@ -0,0 +1,12 @@
+-- +migrate Up
+-- Definitions owned by one user, such as those a restore creates for symbols this
+-- instance does not know. Shared definitions keep a NULL user_id, and abbreviations are
+-- unique among the shared ones and among the definitions of each user
+
+ALTER TABLE definitions ADD COLUMN user_id UUID REFERENCES users(id) ON DELETE CASCADE;
+
+ALTER TABLE definitions DROP CONSTRAINT IF EXISTS definitions_abbreviation_key;
+
+CREATE UNIQUE INDEX idx_definitions_shared_abbreviation ON definitions(UPPER(abbreviation)) WHERE user_id IS NULL;
+
+CREATE UNIQUE INDEX idx_definitions_user_abbreviation ON definitions(user_id, UPPER(abbreviation)) WHERE user_id IS NOT NULL;
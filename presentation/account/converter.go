@@ -42,9 +42,45 @@ func ToAccountWithAssetsResponse(a *account.AccountWithAssets) AccountWithAssets
 }
 
 func ToAccountSummaryResponse(s *account.AccountSummary) AccountSummaryResponse {
+	var accounts []AccountValueResponse
+	for _, a := range s.Accounts {
+		var assets []AssetValueResponse
+		for _, v := range a.Assets {
+			assets = append(assets, AssetValueResponse{
+				AssetID:       v.AssetID.String(),
+				DefinitionID:  v.DefinitionID.String(),
+				Type:          v.AssetType,
+				Symbol:        v.Symbol,
+				Name:          v.Name,
+				Quantity:      v.Quantity,
+				Currency:      v.Currency,
+				Price:         v.Price,
+				PriceCurrency: v.PriceCurrency,
+				PriceSource:   string(v.PriceSource),
+				Value:         v.Value,
+				Liability:     v.Liability,
+				Priced:        v.Priced,
+			})
+		}
+
+		accounts = append(accounts, AccountValueResponse{
+			AccountID:   a.AccountID.String(),
+			Name:        a.Name,
+			AccountType: string(a.AccountType),
+			Value:       a.Value,
+			Assets:      assets,
+		})
+	}
+
 	return AccountSummaryResponse{
-		TotalAccounts: s.TotalAccounts,
-		ByType:        s.ByType,
-		ByCurrency:    s.ByCurrency,
+		TotalAccounts:    s.TotalAccounts,
+		ByType:           s.ByType,
+		BaseCurrency:     s.BaseCurrency,
+		GrossAssets:      s.GrossAssets,
+		GrossLiabilities: s.GrossLiabilities,
+		TotalValue:       s.TotalValue,
+		ByCurrency:       s.ByCurrency,
+		Unpriced:         s.Unpriced,
+		Accounts:         accounts,
 	}
 }
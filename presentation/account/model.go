@@ -58,7 +58,37 @@ type AccountsWithAssetsListResponse struct {
 }
 
 type AccountSummaryResponse struct {
-	TotalAccounts int                         `json:"totalAccounts"`
-	ByType        map[account.AccountType]int `json:"byType"`
-	ByCurrency    map[string]float64          `json:"byCurrency"`
+	TotalAccounts    int                         `json:"totalAccounts"`
+	ByType           map[account.AccountType]int `json:"byType"`
+	BaseCurrency     string                      `json:"baseCurrency"`
+	GrossAssets      float64                     `json:"grossAssets"`
+	GrossLiabilities float64                     `json:"grossLiabilities"`
+	TotalValue       float64                     `json:"totalValue"`
+	ByCurrency       map[string]float64          `json:"byCurrency"`
+	Unpriced         int                         `json:"unpriced"`
+	Accounts         []AccountValueResponse      `json:"accounts"`
+}
+
+type AccountValueResponse struct {
+	AccountID   string               `json:"accountId"`
+	Name        string               `json:"name"`
+	AccountType string               `json:"accountType"`
+	Value       float64              `json:"value"`
+	Assets      []AssetValueResponse `json:"assets"`
+}
+
+type AssetValueResponse struct {
+	AssetID       string  `json:"assetId"`
+	DefinitionID  string  `json:"definitionId"`
+	Type          string  `json:"type"`
+	Symbol        string  `json:"symbol"`
+	Name          string  `json:"name"`
+	Quantity      float64 `json:"quantity"`
+	Currency      string  `json:"currency"`
+	Price         float64 `json:"price"`
+	PriceCurrency string  `json:"priceCurrency"`
+	PriceSource   string  `json:"priceSource"`
+	Value         float64 `json:"value"`
+	Liability     bool    `json:"liability"`
+	Priced        bool    `json:"priced"`
 }
@@ -0,0 +1,75 @@
+package presentation
+
+import (
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/alert"
+)
+
+func ToAlertResponse(a *alert.Alert) AlertResponse {
+	channels := make([]string, 0, len(a.Channels))
+	for _, c := range a.Channels {
+		channels = append(channels, string(c))
+	}
+
+	return AlertResponse{
+		ID:              a.ID.String(),
+		Name:            a.Name,
+		Kind:            string(a.Kind),
+		DefinitionID:    optionalID(a.DefinitionID),
+		AccountID:       optionalID(a.AccountID),
+		Currency:        a.Currency,
+		Threshold:       a.Threshold,
+		Channels:        channels,
+		WebhookURL:      a.WebhookURL,
+		Email:           a.Email,
+		Enabled:         a.Enabled,
+		Triggered:       a.Triggered,
+		LastValue:       a.LastValue,
+		LastEvaluatedAt: a.LastEvaluatedAt,
+		LastTriggeredAt: a.LastTriggeredAt,
+		CreatedAt:       a.CreatedAt,
+		UpdatedAt:       a.UpdatedAt,
+	}
+}
+
+// ToSavedAlertResponse includes the secret signing the webhook deliveries of the alert,
+// which is not listed otherwise.
+func ToSavedAlertResponse(a *alert.Alert) AlertResponse {
+	response := ToAlertResponse(a)
+	response.WebhookSecret = a.WebhookSecret
+	return response
+}
+
+func ToTriggerResponse(t *alert.Trigger) TriggerResponse {
+	deliveries := make([]AlertDeliveryResponse, 0, len(t.Deliveries))
+	for _, d := range t.Deliveries {
+		deliveries = append(deliveries, AlertDeliveryResponse{
+			Channel:     string(d.Channel),
+			Status:      string(d.Status),
+			Error:       d.Error,
+			AttemptedAt: d.AttemptedAt,
+		})
+	}
+
+	return TriggerResponse{
+		ID:          t.ID.String(),
+		AlertID:     t.AlertID.String(),
+		Kind:        string(t.Kind),
+		Value:       t.Value,
+		Threshold:   t.Threshold,
+		Message:     t.Message,
+		Inbox:       t.Inbox,
+		Deliveries:  deliveries,
+		TriggeredAt: t.TriggeredAt,
+		ReadAt:      t.ReadAt,
+	}
+}
+
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
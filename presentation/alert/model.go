@@ -0,0 +1,85 @@
+package presentation
+
+import (
+	"time"
+)
+
+type CreateAlertRequest struct {
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind" validate:"required"`
+	DefinitionID *string  `json:"definitionId,omitempty"`
+	AccountID    *string  `json:"accountId,omitempty"`
+	Currency     string   `json:"currency"`
+	Threshold    float64  `json:"threshold" validate:"required"`
+	Channels     []string `json:"channels"`
+	WebhookURL   string   `json:"webhookUrl"`
+	Email        string   `json:"email"`
+}
+
+type UpdateAlertRequest struct {
+	Name       string   `json:"name"`
+	Currency   string   `json:"currency"`
+	Threshold  float64  `json:"threshold" validate:"required"`
+	Channels   []string `json:"channels"`
+	WebhookURL string   `json:"webhookUrl"`
+	Email      string   `json:"email"`
+	Enabled    *bool    `json:"enabled,omitempty"`
+}
+
+type AlertResponse struct {
+	ID           string   `json:"id"`
+	Name         string   `json:"name"`
+	Kind         string   `json:"kind"`
+	DefinitionID *string  `json:"definitionId,omitempty"`
+	AccountID    *string  `json:"accountId,omitempty"`
+	Currency     string   `json:"currency"`
+	Threshold    float64  `json:"threshold"`
+	Channels     []string `json:"channels"`
+	WebhookURL   string   `json:"webhookUrl,omitempty"`
+	// WebhookSecret is only returned when the alert is created or updated
+	WebhookSecret   string     `json:"webhookSecret,omitempty"`
+	Email           string     `json:"email,omitempty"`
+	Enabled         bool       `json:"enabled"`
+	Triggered       bool       `json:"triggered"`
+	LastValue       *float64   `json:"lastValue,omitempty"`
+	LastEvaluatedAt *time.Time `json:"lastEvaluatedAt,omitempty"`
+	LastTriggeredAt *time.Time `json:"lastTriggeredAt,omitempty"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type AlertsListResponse struct {
+	Alerts []AlertResponse `json:"alerts"`
+	Total  int             `json:"total"`
+}
+
+type AlertDeliveryResponse struct {
+	Channel     string    `json:"channel"`
+	Status      string    `json:"status"`
+	Error       string    `json:"error,omitempty"`
+	AttemptedAt time.Time `json:"attemptedAt"`
+}
+
+type TriggerResponse struct {
+	ID          string                  `json:"id"`
+	AlertID     string                  `json:"alertId"`
+	Kind        string                  `json:"kind"`
+	Value       float64                 `json:"value"`
+	Threshold   float64                 `json:"threshold"`
+	Message     string                  `json:"message"`
+	Inbox       bool                    `json:"inbox"`
+	Deliveries  []AlertDeliveryResponse `json:"deliveries"`
+	TriggeredAt time.Time               `json:"triggeredAt"`
+	ReadAt      *time.Time              `json:"readAt,omitempty"`
+}
+
+type TriggersListResponse struct {
+	Triggers []TriggerResponse `json:"triggers"`
+	Total    int               `json:"total"`
+}
+
+type InboxResponse struct {
+	Notifications []TriggerResponse `json:"notifications"`
+	Total         int               `json:"total"`
+	Unread        int               `json:"unread"`
+}
@@ -0,0 +1,64 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/archive"
+)
+
+// ToExportResponse links the download of an export once its file is available.
+func ToExportResponse(e *archive.Export, downloadPrefix string) ExportResponse {
+	response := ExportResponse{
+		ID:          e.ID.String(),
+		Status:      string(e.Status),
+		FileName:    e.FileName,
+		Size:        e.Size,
+		Records:     e.Records,
+		Error:       e.Error,
+		StartedAt:   e.StartedAt,
+		CompletedAt: e.CompletedAt,
+		ExpiresAt:   e.ExpiresAt,
+		CreatedAt:   e.CreatedAt,
+	}
+	if e.IsAvailable(time.Now()) {
+		response.DownloadURL = downloadPrefix + "/" + e.ID.String() + "/download"
+	}
+	return response
+}
+
+func ToExportsListResponse(exports []*archive.Export, downloadPrefix string) ExportsListResponse {
+	var exportResponses []ExportResponse
+	for _, e := range exports {
+		exportResponses = append(exportResponses, ToExportResponse(e, downloadPrefix))
+	}
+
+	return ExportsListResponse{
+		Exports: exportResponses,
+		Total:   len(exportResponses),
+	}
+}
+
+func ToRestoreResponse(r *archive.RestoreResult) RestoreResponse {
+	response := RestoreResponse{
+		Mode:               string(r.Mode),
+		DefinitionsMatched: r.DefinitionsMatched,
+		DefinitionsCreated: r.DefinitionsCreated,
+	}
+	for _, t := range r.Tables {
+		response.Restored += t.Restored
+		response.Skipped += t.Skipped
+		response.Tables = append(response.Tables, TableCountResponse{
+			Table:    t.Table,
+			Restored: t.Restored,
+			Skipped:  t.Skipped,
+		})
+	}
+	for _, s := range r.Secrets {
+		response.Secrets = append(response.Secrets, RestoredSecretResponse{
+			Table:  s.Table,
+			ID:     s.ID.String(),
+			Secret: s.Secret,
+		})
+	}
+	return response
+}
@@ -0,0 +1,48 @@
+package presentation
+
+import (
+	"time"
+)
+
+type ExportResponse struct {
+	ID          string     `json:"id"`
+	Status      string     `json:"status"`
+	FileName    string     `json:"fileName,omitempty"`
+	Size        int64      `json:"size"`
+	Records     int        `json:"records"`
+	Error       string     `json:"error,omitempty"`
+	DownloadURL string     `json:"downloadUrl,omitempty"`
+	StartedAt   *time.Time `json:"startedAt,omitempty"`
+	CompletedAt *time.Time `json:"completedAt,omitempty"`
+	ExpiresAt   *time.Time `json:"expiresAt,omitempty"`
+	CreatedAt   time.Time  `json:"createdAt"`
+}
+
+type ExportsListResponse struct {
+	Exports []ExportResponse `json:"exports"`
+	Total   int              `json:"total"`
+}
+
+type TableCountResponse struct {
+	Table    string `json:"table"`
+	Restored int    `json:"restored"`
+	Skipped  int    `json:"skipped"`
+}
+
+type RestoreResponse struct {
+	Mode               string               `json:"mode"`
+	DefinitionsMatched int                  `json:"definitionsMatched"`
+	DefinitionsCreated int                  `json:"definitionsCreated"`
+	Restored           int                  `json:"restored"`
+	Skipped            int                  `json:"skipped"`
+	Tables             []TableCountResponse `json:"tables"`
+	// Secrets are the new signing secrets of the restored webhooks and alerts, which
+	// are not shown again
+	Secrets []RestoredSecretResponse `json:"secrets,omitempty"`
+}
+
+type RestoredSecretResponse struct {
+	Table  string `json:"table"`
+	ID     string `json:"id"`
+	Secret string `json:"secret"`
+}
@@ -4,29 +4,49 @@ import "siyahsensei/wallet-service/domain/asset"
 
 func ToAssetResponse(a *asset.Asset) AssetResponse {
 	return AssetResponse{
-		ID:           a.ID.String(),
-		UserID:       a.UserID.String(),
-		AccountID:    a.AccountID.String(),
-		DefinitionID: a.DefinitionID.String(),
-		Type:         string(a.Type),
-		Quantity:     a.Quantity,
-		Notes:        a.Notes,
-		PurchaseDate: a.PurchaseDate,
-		CreatedAt:    a.CreatedAt,
-		UpdatedAt:    a.UpdatedAt,
+		ID:               a.ID.String(),
+		UserID:           a.UserID.String(),
+		AccountID:        a.AccountID.String(),
+		DefinitionID:     a.DefinitionID.String(),
+		Type:             string(a.Type),
+		Quantity:         a.Quantity,
+		Notes:            a.Notes,
+		PurchaseDate:     a.PurchaseDate,
+		PurchasePrice:    a.PurchasePrice,
+		PurchaseCurrency: a.PurchaseCurrency,
+		CostBasisMethod:  string(a.CostBasisMethod),
+		CreatedAt:        a.CreatedAt,
+		UpdatedAt:        a.UpdatedAt,
+	}
+}
+
+func ToTransferResponse(r *asset.TransferResult) TransferResponse {
+	return TransferResponse{
+		TransferID:   r.Transfer.ID.String(),
+		Quantity:     r.Transfer.Quantity,
+		Fee:          r.Transfer.Fee,
+		TransferDate: r.Transfer.TransferDate,
+		SourceAsset:  ToAssetResponse(r.SourceAsset),
+		TargetAsset:  ToAssetResponse(r.TargetAsset),
 	}
 }
 
 func ToAssetPerformanceResponse(ap *asset.AssetPerformance) AssetPerformanceResponse {
 	return AssetPerformanceResponse{
-		AssetID:        ap.AssetID.String(),
-		Name:           ap.Name,
-		Symbol:         ap.Symbol,
-		Type:           string(ap.Type),
-		InitialValue:   ap.InitialValue,
-		CurrentValue:   ap.CurrentValue,
-		ProfitLoss:     ap.ProfitLoss,
-		ProfitLossPerc: ap.ProfitLossPerc,
-		Currency:       ap.Currency,
+		AssetID:              ap.AssetID.String(),
+		Name:                 ap.Name,
+		Symbol:               ap.Symbol,
+		Type:                 string(ap.Type),
+		Quantity:             ap.Quantity,
+		CostBasis:            ap.CostBasis,
+		InitialValue:         ap.InitialValue,
+		CurrentPrice:         ap.CurrentPrice,
+		CurrentValue:         ap.CurrentValue,
+		RealizedProfitLoss:   ap.RealizedProfitLoss,
+		UnrealizedProfitLoss: ap.UnrealizedProfitLoss,
+		ProfitLoss:           ap.ProfitLoss,
+		ProfitLossPerc:       ap.ProfitLossPerc,
+		Currency:             ap.Currency,
+		Unconverted:          ap.Unconverted,
 	}
 }
\ No newline at end of file
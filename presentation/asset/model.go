@@ -6,34 +6,58 @@ import (
 )
 
 type CreateAssetRequest struct {
-	AccountID    string          `json:"accountId" validate:"required"`
-	DefinitionID string          `json:"definitionId" validate:"required"`
-	Type         asset.AssetType `json:"type" validate:"required"`
-	Quantity     float64         `json:"quantity" validate:"required"`
-	Notes        string          `json:"notes"`
-	PurchaseDate int64           `json:"purchaseDate" validate:"required"`
+	AccountID        string                `json:"accountId" validate:"required"`
+	DefinitionID     string                `json:"definitionId" validate:"required"`
+	Type             asset.AssetType       `json:"type" validate:"required"`
+	Quantity         float64               `json:"quantity" validate:"required"`
+	Notes            string                `json:"notes"`
+	PurchaseDate     int64                 `json:"purchaseDate" validate:"required"`
+	PurchasePrice    float64               `json:"purchasePrice"`
+	PurchaseCurrency string                `json:"purchaseCurrency"`
+	CostBasisMethod  asset.CostBasisMethod `json:"costBasisMethod"`
 }
 
 type UpdateAssetRequest struct {
-	AccountID    string          `json:"accountId" validate:"required"`
-	DefinitionID string          `json:"definitionId" validate:"required"`
-	Type         asset.AssetType `json:"type" validate:"required"`
-	Quantity     float64         `json:"quantity" validate:"required"`
-	Notes        string          `json:"notes"`
-	PurchaseDate int64           `json:"purchaseDate" validate:"required"`
+	AccountID       string                `json:"accountId" validate:"required"`
+	DefinitionID    string                `json:"definitionId" validate:"required"`
+	Type            asset.AssetType       `json:"type" validate:"required"`
+	Notes           string                `json:"notes"`
+	PurchaseDate    int64                 `json:"purchaseDate" validate:"required"`
+	CostBasisMethod asset.CostBasisMethod `json:"costBasisMethod"`
+}
+
+type TransferAssetRequest struct {
+	SourceAssetID   string  `json:"sourceAssetId" validate:"required"`
+	TargetAccountID string  `json:"targetAccountId" validate:"required"`
+	Quantity        float64 `json:"quantity" validate:"required"`
+	Fee             float64 `json:"fee"`
+	Notes           string  `json:"notes"`
+	TransferDate    int64   `json:"transferDate" validate:"required"`
 }
 
 type AssetResponse struct {
-	ID           string    `json:"id"`
-	UserID       string    `json:"userId"`
-	AccountID    string    `json:"accountId"`
-	DefinitionID string    `json:"definitionId"`
-	Type         string    `json:"type"`
-	Quantity     float64   `json:"quantity"`
-	Notes        string    `json:"notes"`
-	PurchaseDate time.Time `json:"purchaseDate"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	ID               string    `json:"id"`
+	UserID           string    `json:"userId"`
+	AccountID        string    `json:"accountId"`
+	DefinitionID     string    `json:"definitionId"`
+	Type             string    `json:"type"`
+	Quantity         float64   `json:"quantity"`
+	Notes            string    `json:"notes"`
+	PurchaseDate     time.Time `json:"purchaseDate"`
+	PurchasePrice    float64   `json:"purchasePrice"`
+	PurchaseCurrency string    `json:"purchaseCurrency"`
+	CostBasisMethod  string    `json:"costBasisMethod"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+type TransferResponse struct {
+	TransferID   string        `json:"transferId"`
+	Quantity     float64       `json:"quantity"`
+	Fee          float64       `json:"fee"`
+	TransferDate time.Time     `json:"transferDate"`
+	SourceAsset  AssetResponse `json:"sourceAsset"`
+	TargetAsset  AssetResponse `json:"targetAsset"`
 }
 
 type AssetsListResponse struct {
@@ -42,15 +66,27 @@ type AssetsListResponse struct {
 }
 
 type AssetPerformanceResponse struct {
-	AssetID        string  `json:"assetId"`
-	Name           string  `json:"name"`
-	Symbol         string  `json:"symbol"`
-	Type           string  `json:"type"`
-	InitialValue   float64 `json:"initialValue"`
-	CurrentValue   float64 `json:"currentValue"`
-	ProfitLoss     float64 `json:"profitLoss"`
-	ProfitLossPerc float64 `json:"profitLossPercentage"`
-	Currency       string  `json:"currency"`
+	AssetID              string  `json:"assetId"`
+	Name                 string  `json:"name"`
+	Symbol               string  `json:"symbol"`
+	Type                 string  `json:"type"`
+	Quantity             float64 `json:"quantity"`
+	CostBasis            float64 `json:"costBasis"`
+	InitialValue         float64 `json:"initialValue"`
+	CurrentPrice         float64 `json:"currentPrice"`
+	CurrentValue         float64 `json:"currentValue"`
+	RealizedProfitLoss   float64 `json:"realizedProfitLoss"`
+	UnrealizedProfitLoss float64 `json:"unrealizedProfitLoss"`
+	ProfitLoss           float64 `json:"profitLoss"`
+	ProfitLossPerc       float64 `json:"profitLossPercentage"`
+	Currency             string  `json:"currency"`
+	// Unconverted lists the currencies of costs left out for lack of an exchange rate
+	Unconverted []string `json:"unconverted,omitempty"`
+}
+
+type AssetPerformanceListResponse struct {
+	Performance []AssetPerformanceResponse `json:"performance"`
+	Total       int                        `json:"total"`
 }
 
 type TotalValueResponse struct {
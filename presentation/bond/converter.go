@@ -0,0 +1,49 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/bond"
+
+func ToBondResponse(b *bond.Bond) BondResponse {
+	return BondResponse{
+		ID:              b.ID.String(),
+		AssetID:         b.AssetID.String(),
+		FaceValue:       b.FaceValue,
+		Currency:        b.Currency,
+		CouponRate:      b.CouponRate,
+		CouponFrequency: b.CouponFrequency,
+		IssueDate:       b.IssueDate,
+		MaturityDate:    b.MaturityDate,
+		DayCount:        string(b.DayCount),
+		CreatedAt:       b.CreatedAt,
+		UpdatedAt:       b.UpdatedAt,
+	}
+}
+
+func ToCouponResponse(c *bond.Coupon) CouponResponse {
+	return CouponResponse{
+		BondID:            c.BondID.String(),
+		AssetID:           c.AssetID.String(),
+		Date:              c.Date,
+		PeriodStart:       c.PeriodStart,
+		CouponPerBond:     c.CouponPerBond,
+		RedemptionPerBond: c.RedemptionPerBond,
+		Quantity:          c.Quantity,
+		CouponAmount:      c.CouponAmount,
+		RedemptionAmount:  c.RedemptionAmount,
+		Currency:          c.Currency,
+	}
+}
+
+func ToPricingResponse(p *bond.Pricing) PricingResponse {
+	return PricingResponse{
+		SettlementDate:  p.SettlementDate,
+		CleanPrice:      p.CleanPrice,
+		PriceSource:     p.PriceSource,
+		AccruedInterest: p.AccruedInterest,
+		DirtyPrice:      p.DirtyPrice,
+		CurrentYield:    p.CurrentYield,
+		YieldToMaturity: p.YieldToMaturity,
+		Quantity:        p.Quantity,
+		MarketValue:     p.MarketValue,
+		Currency:        p.Currency,
+	}
+}
@@ -0,0 +1,78 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/bond"
+)
+
+type CreateBondRequest struct {
+	AssetID         string        `json:"assetId" validate:"required"`
+	FaceValue       float64       `json:"faceValue" validate:"required"`
+	Currency        string        `json:"currency" validate:"required"`
+	CouponRate      float64       `json:"couponRate"`
+	CouponFrequency int           `json:"couponFrequency"`
+	IssueDate       int64         `json:"issueDate" validate:"required"`
+	MaturityDate    int64         `json:"maturityDate" validate:"required"`
+	DayCount        bond.DayCount `json:"dayCount"`
+}
+
+type UpdateBondRequest struct {
+	FaceValue       float64       `json:"faceValue" validate:"required"`
+	Currency        string        `json:"currency" validate:"required"`
+	CouponRate      float64       `json:"couponRate"`
+	CouponFrequency int           `json:"couponFrequency"`
+	IssueDate       int64         `json:"issueDate" validate:"required"`
+	MaturityDate    int64         `json:"maturityDate" validate:"required"`
+	DayCount        bond.DayCount `json:"dayCount"`
+}
+
+type BondResponse struct {
+	ID              string    `json:"id"`
+	AssetID         string    `json:"assetId"`
+	FaceValue       float64   `json:"faceValue"`
+	Currency        string    `json:"currency"`
+	CouponRate      float64   `json:"couponRate"`
+	CouponFrequency int       `json:"couponFrequency"`
+	IssueDate       time.Time `json:"issueDate"`
+	MaturityDate    time.Time `json:"maturityDate"`
+	DayCount        string    `json:"dayCount"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type BondsListResponse struct {
+	Bonds []BondResponse `json:"bonds"`
+	Total int            `json:"total"`
+}
+
+type CouponResponse struct {
+	BondID            string    `json:"bondId"`
+	AssetID           string    `json:"assetId"`
+	Date              time.Time `json:"date"`
+	PeriodStart       time.Time `json:"periodStart"`
+	CouponPerBond     float64   `json:"couponPerBond"`
+	RedemptionPerBond float64   `json:"redemptionPerBond"`
+	Quantity          float64   `json:"quantity"`
+	CouponAmount      float64   `json:"couponAmount"`
+	RedemptionAmount  float64   `json:"redemptionAmount"`
+	Currency          string    `json:"currency"`
+}
+
+type CouponsListResponse struct {
+	Coupons []CouponResponse `json:"coupons"`
+	Total   int              `json:"total"`
+}
+
+type PricingResponse struct {
+	SettlementDate  time.Time `json:"settlementDate"`
+	CleanPrice      float64   `json:"cleanPrice"`
+	PriceSource     string    `json:"priceSource"`
+	AccruedInterest float64   `json:"accruedInterest"`
+	DirtyPrice      float64   `json:"dirtyPrice"`
+	CurrentYield    float64   `json:"currentYield"`
+	YieldToMaturity float64   `json:"yieldToMaturity"`
+	Quantity        float64   `json:"quantity"`
+	MarketValue     float64   `json:"marketValue"`
+	Currency        string    `json:"currency"`
+}
@@ -0,0 +1,68 @@
+package presentation
+
+import (
+	"siyahsensei/wallet-service/domain/capitalgains"
+)
+
+func ToGainLineResponse(l *capitalgains.Line) GainLineResponse {
+	return GainLineResponse{
+		DisposalID:       l.DisposalID.String(),
+		LotID:            l.LotID.String(),
+		AssetID:          l.AssetID.String(),
+		TransactionID:    l.TransactionID.String(),
+		Symbol:           l.Symbol,
+		AssetName:        l.AssetName,
+		AssetType:        string(l.AssetType),
+		AccountName:      l.AccountName,
+		Quantity:         l.Quantity,
+		AcquiredAt:       l.AcquiredAt,
+		DisposedAt:       l.DisposedAt,
+		HoldingDays:      l.HoldingDays,
+		Term:             string(l.Term),
+		Currency:         l.Currency,
+		ProceedsCurrency: l.ProceedsCurrency,
+		Proceeds:         l.Proceeds,
+		Cost:             l.Cost,
+		Gain:             l.Gain,
+		Converted:        l.Converted,
+		ReportProceeds:   l.ReportProceeds,
+		ReportCost:       l.ReportCost,
+		ReportGain:       l.ReportGain,
+		Exempt:           l.Exempt,
+		ExemptReason:     l.ExemptReason,
+	}
+}
+
+func ToTermTotalsResponse(t capitalgains.TermTotals) TermTotalsResponse {
+	return TermTotalsResponse{
+		Count:    t.Count,
+		Proceeds: t.Proceeds,
+		Cost:     t.Cost,
+		Gains:    t.Gains,
+		Losses:   t.Losses,
+		Net:      t.Net,
+	}
+}
+
+func ToCapitalGainsResponse(r *capitalgains.Report) CapitalGainsResponse {
+	var lines []GainLineResponse
+	for _, l := range r.Lines {
+		lines = append(lines, ToGainLineResponse(l))
+	}
+
+	return CapitalGainsResponse{
+		Year:         r.Year,
+		Jurisdiction: r.Jurisdiction,
+		Currency:     r.Currency,
+		From:         r.From,
+		To:           r.To,
+		Lines:        lines,
+		ShortTerm:    ToTermTotalsResponse(r.ShortTerm),
+		LongTerm:     ToTermTotalsResponse(r.LongTerm),
+		ExemptGain:   r.ExemptGain,
+		NetGain:      r.NetGain,
+		TaxableGain:  r.TaxableGain,
+		Unconverted:  r.Unconverted,
+		Total:        len(lines),
+	}
+}
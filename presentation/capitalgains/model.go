@@ -0,0 +1,57 @@
+package presentation
+
+import (
+	"time"
+)
+
+type GainLineResponse struct {
+	DisposalID       string    `json:"disposalId"`
+	LotID            string    `json:"lotId"`
+	AssetID          string    `json:"assetId"`
+	TransactionID    string    `json:"transactionId"`
+	Symbol           string    `json:"symbol"`
+	AssetName        string    `json:"assetName"`
+	AssetType        string    `json:"assetType"`
+	AccountName      string    `json:"accountName"`
+	Quantity         float64   `json:"quantity"`
+	AcquiredAt       time.Time `json:"acquiredAt"`
+	DisposedAt       time.Time `json:"disposedAt"`
+	HoldingDays      int       `json:"holdingDays"`
+	Term             string    `json:"term"`
+	Currency         string    `json:"currency"`
+	ProceedsCurrency string    `json:"proceedsCurrency"`
+	Proceeds         float64   `json:"proceeds"`
+	Cost             float64   `json:"cost"`
+	Gain             float64   `json:"gain"`
+	Converted        bool      `json:"converted"`
+	ReportProceeds   float64   `json:"reportProceeds"`
+	ReportCost       float64   `json:"reportCost"`
+	ReportGain       float64   `json:"reportGain"`
+	Exempt           bool      `json:"exempt"`
+	ExemptReason     string    `json:"exemptReason,omitempty"`
+}
+
+type TermTotalsResponse struct {
+	Count    int     `json:"count"`
+	Proceeds float64 `json:"proceeds"`
+	Cost     float64 `json:"cost"`
+	Gains    float64 `json:"gains"`
+	Losses   float64 `json:"losses"`
+	Net      float64 `json:"net"`
+}
+
+type CapitalGainsResponse struct {
+	Year         int                `json:"year"`
+	Jurisdiction string             `json:"jurisdiction"`
+	Currency     string             `json:"currency"`
+	From         time.Time          `json:"from"`
+	To           time.Time          `json:"to"`
+	Lines        []GainLineResponse `json:"lines"`
+	ShortTerm    TermTotalsResponse `json:"shortTerm"`
+	LongTerm     TermTotalsResponse `json:"longTerm"`
+	ExemptGain   float64            `json:"exemptGain"`
+	NetGain      float64            `json:"netGain"`
+	TaxableGain  float64            `json:"taxableGain"`
+	Unconverted  int                `json:"unconverted"`
+	Total        int                `json:"total"`
+}
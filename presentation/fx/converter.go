@@ -0,0 +1,37 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/fx"
+
+func ToRateResponse(r *fx.Rate) RateResponse {
+	return RateResponse{
+		ID:            r.ID.String(),
+		BaseCurrency:  r.BaseCurrency,
+		QuoteCurrency: r.QuoteCurrency,
+		Rate:          r.Rate,
+		ObservedAt:    r.ObservedAt,
+		Source:        r.Source,
+	}
+}
+
+func ToRatesListResponse(rates []*fx.Rate) RatesListResponse {
+	var responses []RateResponse
+	for _, r := range rates {
+		responses = append(responses, ToRateResponse(r))
+	}
+
+	return RatesListResponse{
+		Rates: responses,
+		Total: len(responses),
+	}
+}
+
+func ToConversionResponse(c *fx.Conversion) ConversionResponse {
+	return ConversionResponse{
+		From:      c.From,
+		To:        c.To,
+		Rate:      c.Rate,
+		Amount:    c.Amount,
+		Converted: c.Converted,
+		At:        c.At,
+	}
+}
@@ -0,0 +1,40 @@
+package presentation
+
+import (
+	"time"
+)
+
+type RateItemRequest struct {
+	BaseCurrency  string  `json:"baseCurrency" validate:"required"`
+	QuoteCurrency string  `json:"quoteCurrency" validate:"required"`
+	Rate          float64 `json:"rate" validate:"required"`
+	ObservedAt    int64   `json:"observedAt" validate:"required"`
+	Source        string  `json:"source"`
+}
+
+type RecordRatesRequest struct {
+	Rates []RateItemRequest `json:"rates" validate:"required"`
+}
+
+type RateResponse struct {
+	ID            string    `json:"id"`
+	BaseCurrency  string    `json:"baseCurrency"`
+	QuoteCurrency string    `json:"quoteCurrency"`
+	Rate          float64   `json:"rate"`
+	ObservedAt    time.Time `json:"observedAt"`
+	Source        string    `json:"source"`
+}
+
+type RatesListResponse struct {
+	Rates []RateResponse `json:"rates"`
+	Total int            `json:"total"`
+}
+
+type ConversionResponse struct {
+	From      string    `json:"from"`
+	To        string    `json:"to"`
+	Rate      float64   `json:"rate"`
+	Amount    float64   `json:"amount"`
+	Converted float64   `json:"converted"`
+	At        time.Time `json:"at"`
+}
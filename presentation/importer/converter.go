@@ -0,0 +1,95 @@
+package presentation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/importer"
+)
+
+func ToImportRowResponse(r *importer.Row) ImportRowResponse {
+	return ImportRowResponse{
+		Line:          r.Line,
+		Date:          r.Date,
+		Symbol:        r.Symbol,
+		Quantity:      r.Quantity,
+		Price:         r.Price,
+		Currency:      r.Currency,
+		Account:       r.Account,
+		Type:          string(r.Type),
+		AssetType:     string(r.AssetType),
+		Notes:         r.Notes,
+		AccountID:     resolvedID(r.AccountID),
+		DefinitionID:  resolvedID(r.DefinitionID),
+		AssetID:       optionalID(r.AssetID),
+		TransactionID: optionalID(r.TransactionID),
+		Errors:        r.Errors,
+		FITID:         r.FITID,
+		Opening:       r.Opening,
+		Skipped:       r.Skipped,
+		SkipReason:    r.SkipReason,
+	}
+}
+
+func ToImportStatementResponse(s *importer.Statement) ImportStatementResponse {
+	return ImportStatementResponse{
+		Format:         string(s.Format),
+		AccountKey:     s.AccountKey,
+		AccountName:    s.AccountName,
+		AccountType:    string(s.AccountType),
+		AccountID:      resolvedID(s.AccountID),
+		AccountCreated: s.NewAccount != nil,
+		Currency:       s.Currency,
+		StartDate:      optionalTime(s.StartDate),
+		EndDate:        optionalTime(s.EndDate),
+		LedgerBalance:  s.LedgerBalance,
+		Entries:        len(s.Entries),
+	}
+}
+
+func ToImportResultResponse(r *importer.Result) ImportResultResponse {
+	var rows []ImportRowResponse
+	for _, row := range r.Rows {
+		rows = append(rows, ToImportRowResponse(row))
+	}
+
+	var statements []ImportStatementResponse
+	for _, statement := range r.Statements {
+		statements = append(statements, ToImportStatementResponse(statement))
+	}
+
+	return ImportResultResponse{
+		DryRun:     r.DryRun,
+		Committed:  r.Committed,
+		Total:      r.Total,
+		Valid:      r.Valid,
+		Invalid:    r.Invalid,
+		Skipped:    r.Skipped,
+		Rows:       rows,
+		Statements: statements,
+	}
+}
+
+func resolvedID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
+
+func optionalTime(t time.Time) *time.Time {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
@@ -0,0 +1,53 @@
+package presentation
+
+import (
+	"time"
+)
+
+type ImportRowResponse struct {
+	Line          int       `json:"line"`
+	Date          time.Time `json:"date"`
+	Symbol        string    `json:"symbol"`
+	Quantity      float64   `json:"quantity"`
+	Price         float64   `json:"price"`
+	Currency      string    `json:"currency"`
+	Account       string    `json:"account"`
+	Type          string    `json:"type"`
+	AssetType     string    `json:"assetType"`
+	Notes         string    `json:"notes"`
+	AccountID     *string   `json:"accountId,omitempty"`
+	DefinitionID  *string   `json:"definitionId,omitempty"`
+	AssetID       *string   `json:"assetId,omitempty"`
+	TransactionID *string   `json:"transactionId,omitempty"`
+	Errors        []string  `json:"errors,omitempty"`
+	FITID         string    `json:"fitid,omitempty"`
+	Opening       bool      `json:"opening,omitempty"`
+	Skipped       bool      `json:"skipped,omitempty"`
+	SkipReason    string    `json:"skipReason,omitempty"`
+}
+
+type ImportStatementResponse struct {
+	Format         string     `json:"format"`
+	AccountKey     string     `json:"accountKey,omitempty"`
+	AccountName    string     `json:"accountName"`
+	AccountType    string     `json:"accountType"`
+	AccountID      *string    `json:"accountId,omitempty"`
+	AccountCreated bool       `json:"accountCreated"`
+	Currency       string     `json:"currency"`
+	StartDate      *time.Time `json:"startDate,omitempty"`
+	EndDate        *time.Time `json:"endDate,omitempty"`
+	LedgerBalance  *float64   `json:"ledgerBalance,omitempty"`
+	Entries        int        `json:"entries"`
+}
+
+type ImportResultResponse struct {
+	DryRun    bool                `json:"dryRun"`
+	Committed bool                `json:"committed"`
+	Total     int                 `json:"total"`
+	Valid     int                 `json:"valid"`
+	Invalid   int                 `json:"invalid"`
+	Skipped   int                 `json:"skipped"`
+	Rows      []ImportRowResponse `json:"rows"`
+	// Statements lists the accounts of a bank statement import
+	Statements []ImportStatementResponse `json:"statements,omitempty"`
+}
@@ -0,0 +1,62 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/income"
+
+func ToIncomeResponse(i *income.Income) IncomeResponse {
+	return IncomeResponse{
+		ID:             i.ID.String(),
+		AssetID:        i.AssetID.String(),
+		Symbol:         i.Symbol,
+		AssetType:      string(i.AssetType),
+		Type:           string(i.Type),
+		GrossAmount:    i.GrossAmount,
+		WithholdingTax: i.WithholdingTax,
+		NetAmount:      i.NetAmount(),
+		Currency:       i.Currency,
+		PayDate:        i.PayDate,
+		Notes:          i.Notes,
+		CreatedAt:      i.CreatedAt,
+		UpdatedAt:      i.UpdatedAt,
+	}
+}
+
+func ToReportResponse(r *income.Report) ReportResponse {
+	response := ReportResponse{
+		Year:           r.Year,
+		BaseCurrency:   r.BaseCurrency,
+		RatesAt:        r.RatesAt,
+		Unconverted:    r.Unconverted,
+		TotalsResponse: toTotalsResponse(r.Totals),
+	}
+	for _, m := range r.ByMonth {
+		response.ByMonth = append(response.ByMonth, MonthTotalResponse{
+			Month:          m.Month,
+			TotalsResponse: toTotalsResponse(m.Totals),
+		})
+	}
+	for _, t := range r.ByType {
+		response.ByType = append(response.ByType, TypeTotalResponse{
+			Type:           string(t.Type),
+			TotalsResponse: toTotalsResponse(t.Totals),
+		})
+	}
+	for _, a := range r.ByAsset {
+		response.ByAsset = append(response.ByAsset, AssetTotalResponse{
+			AssetID:        a.AssetID.String(),
+			Symbol:         a.Symbol,
+			AssetType:      string(a.AssetType),
+			CostBasis:      a.CostBasis,
+			YieldOnCost:    a.YieldOnCost,
+			TotalsResponse: toTotalsResponse(a.Totals),
+		})
+	}
+	return response
+}
+
+func toTotalsResponse(t income.Totals) TotalsResponse {
+	return TotalsResponse{
+		Gross:          t.Gross,
+		WithholdingTax: t.WithholdingTax,
+		Net:            t.Net,
+	}
+}
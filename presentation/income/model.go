@@ -0,0 +1,83 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/income"
+)
+
+type CreateIncomeRequest struct {
+	AssetID        string            `json:"assetId" validate:"required"`
+	Type           income.IncomeType `json:"type" validate:"required"`
+	GrossAmount    float64           `json:"grossAmount" validate:"required"`
+	WithholdingTax float64           `json:"withholdingTax"`
+	Currency       string            `json:"currency" validate:"required"`
+	PayDate        int64             `json:"payDate" validate:"required"`
+	Notes          string            `json:"notes"`
+}
+
+type UpdateIncomeRequest struct {
+	Type           income.IncomeType `json:"type" validate:"required"`
+	GrossAmount    float64           `json:"grossAmount" validate:"required"`
+	WithholdingTax float64           `json:"withholdingTax"`
+	Currency       string            `json:"currency" validate:"required"`
+	PayDate        int64             `json:"payDate" validate:"required"`
+	Notes          string            `json:"notes"`
+}
+
+type IncomeResponse struct {
+	ID             string    `json:"id"`
+	AssetID        string    `json:"assetId"`
+	Symbol         string    `json:"symbol"`
+	AssetType      string    `json:"assetType"`
+	Type           string    `json:"type"`
+	GrossAmount    float64   `json:"grossAmount"`
+	WithholdingTax float64   `json:"withholdingTax"`
+	NetAmount      float64   `json:"netAmount"`
+	Currency       string    `json:"currency"`
+	PayDate        time.Time `json:"payDate"`
+	Notes          string    `json:"notes"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+type IncomeListResponse struct {
+	Income []IncomeResponse `json:"income"`
+	Total  int              `json:"total"`
+}
+
+type TotalsResponse struct {
+	Gross          float64 `json:"gross"`
+	WithholdingTax float64 `json:"withholdingTax"`
+	Net            float64 `json:"net"`
+}
+
+type MonthTotalResponse struct {
+	Month int `json:"month"`
+	TotalsResponse
+}
+
+type TypeTotalResponse struct {
+	Type string `json:"type"`
+	TotalsResponse
+}
+
+type AssetTotalResponse struct {
+	AssetID     string  `json:"assetId"`
+	Symbol      string  `json:"symbol"`
+	AssetType   string  `json:"assetType"`
+	CostBasis   float64 `json:"costBasis"`
+	YieldOnCost float64 `json:"yieldOnCost"`
+	TotalsResponse
+}
+
+type ReportResponse struct {
+	Year         int                  `json:"year"`
+	BaseCurrency string               `json:"baseCurrency"`
+	RatesAt      time.Time            `json:"ratesAt"`
+	Unconverted  int                  `json:"unconverted"`
+	ByMonth      []MonthTotalResponse `json:"byMonth"`
+	ByType       []TypeTotalResponse  `json:"byType"`
+	ByAsset      []AssetTotalResponse `json:"byAsset"`
+	TotalsResponse
+}
@@ -0,0 +1,93 @@
+package presentation
+
+import (
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/loan"
+)
+
+func ToLoanResponse(l *loan.Loan) LoanResponse {
+	return LoanResponse{
+		ID:               l.ID.String(),
+		AssetID:          l.AssetID.String(),
+		Principal:        l.Principal,
+		Currency:         l.Currency,
+		AnnualRate:       l.AnnualRate,
+		TermPeriods:      l.TermPeriods,
+		PaymentFrequency: l.PaymentFrequency,
+		Method:           string(l.Method),
+		StartDate:        l.StartDate,
+		FirstPaymentDate: l.FirstPaymentDate,
+		MaturityDate:     l.MaturityDate(),
+		CreatedAt:        l.CreatedAt,
+		UpdatedAt:        l.UpdatedAt,
+	}
+}
+
+func ToInstallmentResponse(i *loan.Installment) InstallmentResponse {
+	return InstallmentResponse{
+		Number:             i.Number,
+		DueDate:            i.DueDate,
+		Payment:            i.Payment,
+		Principal:          i.Principal,
+		Interest:           i.Interest,
+		RemainingPrincipal: i.RemainingPrincipal,
+	}
+}
+
+func ToScheduleResponse(installments []*loan.Installment) ScheduleResponse {
+	var response ScheduleResponse
+	for _, i := range installments {
+		response.Installments = append(response.Installments, ToInstallmentResponse(i))
+		response.TotalPayment += i.Payment
+		response.TotalInterest += i.Interest
+	}
+	response.Total = len(response.Installments)
+	return response
+}
+
+func ToPaymentResponse(p *loan.Payment) PaymentResponse {
+	return PaymentResponse{
+		ID:            p.ID.String(),
+		LoanID:        p.LoanID.String(),
+		PaidAt:        p.PaidAt,
+		Amount:        p.Amount,
+		Principal:     p.Principal,
+		Interest:      p.Interest,
+		FromAssetID:   optionalID(p.FromAssetID),
+		TransactionID: optionalID(p.TransactionID),
+		Notes:         p.Notes,
+		CreatedAt:     p.CreatedAt,
+	}
+}
+
+func ToStatusResponse(s *loan.Status) StatusResponse {
+	response := StatusResponse{
+		AsOf:                        s.AsOf,
+		OriginalPrincipal:           s.OriginalPrincipal,
+		PrincipalPaid:               s.PrincipalPaid,
+		InterestPaid:                s.InterestPaid,
+		RemainingPrincipal:          s.RemainingPrincipal,
+		PaymentsMade:                s.PaymentsMade,
+		InstallmentsDue:             s.InstallmentsDue,
+		ScheduledPrincipalToDate:    s.ScheduledPrincipalToDate,
+		ScheduledInterestToDate:     s.ScheduledInterestToDate,
+		ScheduledRemainingPrincipal: s.ScheduledRemainingPrincipal,
+		ScheduledInterestRemaining:  s.ScheduledInterestRemaining,
+		Arrears:                     s.Arrears,
+		MaturityDate:                s.MaturityDate,
+	}
+	if s.NextInstallment != nil {
+		next := ToInstallmentResponse(s.NextInstallment)
+		response.NextInstallment = &next
+	}
+	return response
+}
+
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
@@ -0,0 +1,108 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/loan"
+)
+
+type CreateLoanRequest struct {
+	AssetID          string      `json:"assetId" validate:"required"`
+	Principal        float64     `json:"principal"`
+	AnnualRate       float64     `json:"annualRate"`
+	TermPeriods      int         `json:"termPeriods" validate:"required"`
+	PaymentFrequency int         `json:"paymentFrequency"`
+	Method           loan.Method `json:"method"`
+	StartDate        int64       `json:"startDate" validate:"required"`
+	FirstPaymentDate int64       `json:"firstPaymentDate"`
+}
+
+type UpdateLoanRequest struct {
+	Principal        float64     `json:"principal" validate:"required"`
+	AnnualRate       float64     `json:"annualRate"`
+	TermPeriods      int         `json:"termPeriods" validate:"required"`
+	PaymentFrequency int         `json:"paymentFrequency"`
+	Method           loan.Method `json:"method"`
+	StartDate        int64       `json:"startDate" validate:"required"`
+	FirstPaymentDate int64       `json:"firstPaymentDate"`
+}
+
+type LoanResponse struct {
+	ID               string    `json:"id"`
+	AssetID          string    `json:"assetId"`
+	Principal        float64   `json:"principal"`
+	Currency         string    `json:"currency"`
+	AnnualRate       float64   `json:"annualRate"`
+	TermPeriods      int       `json:"termPeriods"`
+	PaymentFrequency int       `json:"paymentFrequency"`
+	Method           string    `json:"method"`
+	StartDate        time.Time `json:"startDate"`
+	FirstPaymentDate time.Time `json:"firstPaymentDate"`
+	MaturityDate     time.Time `json:"maturityDate"`
+	CreatedAt        time.Time `json:"createdAt"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
+
+type LoansListResponse struct {
+	Loans []LoanResponse `json:"loans"`
+	Total int            `json:"total"`
+}
+
+type InstallmentResponse struct {
+	Number             int       `json:"number"`
+	DueDate            time.Time `json:"dueDate"`
+	Payment            float64   `json:"payment"`
+	Principal          float64   `json:"principal"`
+	Interest           float64   `json:"interest"`
+	RemainingPrincipal float64   `json:"remainingPrincipal"`
+}
+
+type ScheduleResponse struct {
+	Installments  []InstallmentResponse `json:"installments"`
+	Total         int                   `json:"total"`
+	TotalPayment  float64               `json:"totalPayment"`
+	TotalInterest float64               `json:"totalInterest"`
+}
+
+type RecordPaymentRequest struct {
+	Amount      float64  `json:"amount" validate:"required"`
+	Interest    *float64 `json:"interest,omitempty"`
+	PaidAt      int64    `json:"paidAt" validate:"required"`
+	FromAssetID *string  `json:"fromAssetId,omitempty"`
+	Notes       string   `json:"notes"`
+}
+
+type PaymentResponse struct {
+	ID            string    `json:"id"`
+	LoanID        string    `json:"loanId"`
+	PaidAt        time.Time `json:"paidAt"`
+	Amount        float64   `json:"amount"`
+	Principal     float64   `json:"principal"`
+	Interest      float64   `json:"interest"`
+	FromAssetID   *string   `json:"fromAssetId,omitempty"`
+	TransactionID *string   `json:"transactionId,omitempty"`
+	Notes         string    `json:"notes"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type PaymentsListResponse struct {
+	Payments []PaymentResponse `json:"payments"`
+	Total    int               `json:"total"`
+}
+
+type StatusResponse struct {
+	AsOf                        time.Time            `json:"asOf"`
+	OriginalPrincipal           float64              `json:"originalPrincipal"`
+	PrincipalPaid               float64              `json:"principalPaid"`
+	InterestPaid                float64              `json:"interestPaid"`
+	RemainingPrincipal          float64              `json:"remainingPrincipal"`
+	PaymentsMade                int                  `json:"paymentsMade"`
+	InstallmentsDue             int                  `json:"installmentsDue"`
+	ScheduledPrincipalToDate    float64              `json:"scheduledPrincipalToDate"`
+	ScheduledInterestToDate     float64              `json:"scheduledInterestToDate"`
+	ScheduledRemainingPrincipal float64              `json:"scheduledRemainingPrincipal"`
+	ScheduledInterestRemaining  float64              `json:"scheduledInterestRemaining"`
+	Arrears                     float64              `json:"arrears"`
+	NextInstallment             *InstallmentResponse `json:"nextInstallment,omitempty"`
+	MaturityDate                time.Time            `json:"maturityDate"`
+}
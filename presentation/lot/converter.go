@@ -0,0 +1,59 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/lot"
+
+func ToLotResponse(l *lot.Lot) LotResponse {
+	var transactionID *string
+	if l.TransactionID != nil {
+		id := l.TransactionID.String()
+		transactionID = &id
+	}
+
+	return LotResponse{
+		ID:                l.ID.String(),
+		AssetID:           l.AssetID.String(),
+		TransactionID:     transactionID,
+		Quantity:          l.Quantity,
+		RemainingQuantity: l.RemainingQuantity,
+		UnitPrice:         l.UnitPrice,
+		Currency:          l.Currency,
+		AcquiredAt:        l.AcquiredAt,
+	}
+}
+
+func ToAssetLotsResponse(a *lot.AssetLots) AssetLotsResponse {
+	var lots []LotResponse
+	for _, l := range a.Lots {
+		lots = append(lots, ToLotResponse(l))
+	}
+
+	return AssetLotsResponse{
+		AssetID:         a.AssetID.String(),
+		CostBasisMethod: string(a.CostBasisMethod),
+		CostBasis: CostBasisResponse{
+			Quantity:    a.CostBasis.Quantity,
+			TotalCost:   a.CostBasis.TotalCost,
+			AverageCost: a.CostBasis.AverageCost,
+			Currency:    a.CostBasis.Currency,
+		},
+		Lots: lots,
+	}
+}
+
+func ToDisposalResponse(d *lot.Disposal) DisposalResponse {
+	return DisposalResponse{
+		ID:            d.ID.String(),
+		LotID:         d.LotID.String(),
+		AssetID:       d.AssetID.String(),
+		TransactionID: d.TransactionID.String(),
+		Kind:          string(d.Kind),
+		Quantity:      d.Quantity,
+		UnitCost:      d.UnitCost,
+		UnitProceeds:  d.UnitProceeds,
+		Cost:          d.Cost(),
+		Proceeds:      d.Proceeds(),
+		Currency:      d.Currency,
+		AcquiredAt:    d.AcquiredAt,
+		DisposedAt:    d.DisposedAt,
+	}
+}
@@ -0,0 +1,51 @@
+package presentation
+
+import (
+	"time"
+)
+
+type LotResponse struct {
+	ID                string    `json:"id"`
+	AssetID           string    `json:"assetId"`
+	TransactionID     *string   `json:"transactionId,omitempty"`
+	Quantity          float64   `json:"quantity"`
+	RemainingQuantity float64   `json:"remainingQuantity"`
+	UnitPrice         float64   `json:"unitPrice"`
+	Currency          string    `json:"currency"`
+	AcquiredAt        time.Time `json:"acquiredAt"`
+}
+
+type CostBasisResponse struct {
+	Quantity    float64 `json:"quantity"`
+	TotalCost   float64 `json:"totalCost"`
+	AverageCost float64 `json:"averageCost"`
+	Currency    string  `json:"currency"`
+}
+
+type AssetLotsResponse struct {
+	AssetID         string            `json:"assetId"`
+	CostBasisMethod string            `json:"costBasisMethod"`
+	CostBasis       CostBasisResponse `json:"costBasis"`
+	Lots            []LotResponse     `json:"lots"`
+}
+
+type DisposalResponse struct {
+	ID            string    `json:"id"`
+	LotID         string    `json:"lotId"`
+	AssetID       string    `json:"assetId"`
+	TransactionID string    `json:"transactionId"`
+	Kind          string    `json:"kind"`
+	Quantity      float64   `json:"quantity"`
+	UnitCost      float64   `json:"unitCost"`
+	UnitProceeds  float64   `json:"unitProceeds"`
+	Cost          float64   `json:"cost"`
+	Proceeds      float64   `json:"proceeds"`
+	Currency      string    `json:"currency"`
+	AcquiredAt    time.Time `json:"acquiredAt"`
+	DisposedAt    time.Time `json:"disposedAt"`
+}
+
+type DisposalsListResponse struct {
+	Disposals []DisposalResponse `json:"disposals"`
+	Total     int                `json:"total"`
+}
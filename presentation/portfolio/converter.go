@@ -0,0 +1,142 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/portfolio"
+
+func ToSnapshotResponse(s *portfolio.Snapshot) SnapshotResponse {
+	var accounts []AccountTotalResponse
+	for _, a := range s.ByAccount {
+		accounts = append(accounts, AccountTotalResponse{
+			AccountID:   a.AccountID.String(),
+			Name:        a.Name,
+			AccountType: a.AccountType,
+			Value:       a.Value,
+		})
+	}
+
+	return SnapshotResponse{
+		Date:         s.SnapshotDate,
+		BaseCurrency: s.BaseCurrency,
+		TotalValue:   s.TotalValue,
+		Unpriced:     s.Unpriced,
+		ByAccount:    accounts,
+		ByAssetType:  s.ByAssetType,
+	}
+}
+
+func ToHistoryResponse(interval portfolio.Interval, snapshots []*portfolio.Snapshot) HistoryResponse {
+	var responses []SnapshotResponse
+	for _, s := range snapshots {
+		responses = append(responses, ToSnapshotResponse(s))
+	}
+
+	return HistoryResponse{
+		Interval:  string(interval),
+		Snapshots: responses,
+		Total:     len(responses),
+	}
+}
+
+func ToNetWorthResponse(n *portfolio.NetWorth) NetWorthResponse {
+	var accounts []AccountNetWorthResponse
+	for _, a := range n.Accounts {
+		accounts = append(accounts, AccountNetWorthResponse{
+			AccountID:   a.AccountID.String(),
+			Name:        a.Name,
+			AccountType: a.AccountType,
+			Assets:      a.Assets,
+			Liabilities: a.Liabilities,
+			NetWorth:    a.NetWorth,
+		})
+	}
+
+	return NetWorthResponse{
+		BaseCurrency:     n.BaseCurrency,
+		At:               n.At,
+		GrossAssets:      n.GrossAssets,
+		GrossLiabilities: n.GrossLiabilities,
+		NetWorth:         n.NetWorth,
+		Unpriced:         n.Unpriced,
+		Accounts:         accounts,
+	}
+}
+
+func ToAllocationResponse(a *portfolio.Allocation) AllocationResponse {
+	var groups []AllocationGroupResponse
+	for _, g := range a.Groups {
+		groups = append(groups, AllocationGroupResponse{
+			Key:        g.Key,
+			Label:      g.Label,
+			Value:      g.Value,
+			Percentage: g.Percentage,
+			Holdings:   g.Holdings,
+		})
+	}
+
+	return AllocationResponse{
+		BaseCurrency: a.BaseCurrency,
+		GroupBy:      string(a.GroupBy),
+		Total:        a.Total,
+		Unpriced:     a.Unpriced,
+		Groups:       groups,
+	}
+}
+
+func ToTargetsListResponse(targets []*portfolio.Target) TargetsListResponse {
+	var responses []TargetResponse
+	for _, t := range targets {
+		responses = append(responses, TargetResponse{
+			ID:      t.ID.String(),
+			GroupBy: string(t.GroupBy),
+			Key:     t.Key,
+			Weight:  t.Weight,
+		})
+	}
+
+	return TargetsListResponse{
+		Targets: responses,
+		Total:   len(responses),
+	}
+}
+
+func ToRebalancePlanResponse(p *portfolio.RebalancePlan) RebalancePlanResponse {
+	var suggestions []RebalanceSuggestionResponse
+	for _, s := range p.Suggestions {
+		var legs []TradeLegResponse
+		for _, l := range s.Legs {
+			legs = append(legs, TradeLegResponse{
+				DefinitionID: l.DefinitionID.String(),
+				Symbol:       l.Symbol,
+				Quantity:     l.Quantity,
+				Amount:       l.Amount,
+			})
+		}
+
+		suggestions = append(suggestions, RebalanceSuggestionResponse{
+			Key:           s.Key,
+			Label:         s.Label,
+			CurrentValue:  s.CurrentValue,
+			CurrentWeight: s.CurrentWeight,
+			TargetWeight:  s.TargetWeight,
+			Drift:         s.Drift,
+			OutOfBand:     s.OutOfBand,
+			Action:        string(s.Action),
+			Amount:        s.Amount,
+			Legs:          legs,
+		})
+	}
+
+	return RebalancePlanResponse{
+		BaseCurrency:   p.BaseCurrency,
+		GroupBy:        string(p.GroupBy),
+		Tolerance:      p.Tolerance,
+		NoSell:         p.NoSell,
+		Total:          p.Total,
+		Cash:           p.Cash,
+		NeedsRebalance: p.NeedsRebalance,
+		TotalBuys:      p.TotalBuys,
+		TotalSells:     p.TotalSells,
+		NetCashFlow:    p.NetCashFlow,
+		Unpriced:       p.Unpriced,
+		Suggestions:    suggestions,
+	}
+}
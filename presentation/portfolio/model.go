@@ -0,0 +1,119 @@
+package presentation
+
+import (
+	"time"
+)
+
+type AccountTotalResponse struct {
+	AccountID   string  `json:"accountId"`
+	Name        string  `json:"name"`
+	AccountType string  `json:"accountType"`
+	Value       float64 `json:"value"`
+}
+
+type SnapshotResponse struct {
+	Date         time.Time              `json:"date"`
+	BaseCurrency string                 `json:"baseCurrency"`
+	TotalValue   float64                `json:"totalValue"`
+	Unpriced     int                    `json:"unpriced"`
+	ByAccount    []AccountTotalResponse `json:"byAccount"`
+	ByAssetType  map[string]float64     `json:"byAssetType"`
+}
+
+type HistoryResponse struct {
+	Interval  string             `json:"interval"`
+	Snapshots []SnapshotResponse `json:"snapshots"`
+	Total     int                `json:"total"`
+}
+
+type AccountNetWorthResponse struct {
+	AccountID   string  `json:"accountId"`
+	Name        string  `json:"name"`
+	AccountType string  `json:"accountType"`
+	Assets      float64 `json:"assets"`
+	Liabilities float64 `json:"liabilities"`
+	NetWorth    float64 `json:"netWorth"`
+}
+
+type NetWorthResponse struct {
+	BaseCurrency     string                    `json:"baseCurrency"`
+	At               time.Time                 `json:"at"`
+	GrossAssets      float64                   `json:"grossAssets"`
+	GrossLiabilities float64                   `json:"grossLiabilities"`
+	NetWorth         float64                   `json:"netWorth"`
+	Unpriced         int                       `json:"unpriced"`
+	Accounts         []AccountNetWorthResponse `json:"accounts"`
+}
+
+type AllocationGroupResponse struct {
+	Key        string  `json:"key"`
+	Label      string  `json:"label"`
+	Value      float64 `json:"value"`
+	Percentage float64 `json:"percentage"`
+	Holdings   int     `json:"holdings"`
+}
+
+type AllocationResponse struct {
+	BaseCurrency string                    `json:"baseCurrency"`
+	GroupBy      string                    `json:"groupBy"`
+	Total        float64                   `json:"total"`
+	Unpriced     int                       `json:"unpriced"`
+	Groups       []AllocationGroupResponse `json:"groups"`
+}
+
+type TargetItemRequest struct {
+	Key    string  `json:"key" validate:"required"`
+	Weight float64 `json:"weight" validate:"required"`
+}
+
+type SetTargetsRequest struct {
+	GroupBy string              `json:"groupBy" validate:"required"`
+	Targets []TargetItemRequest `json:"targets"`
+}
+
+type TargetResponse struct {
+	ID      string  `json:"id"`
+	GroupBy string  `json:"groupBy"`
+	Key     string  `json:"key"`
+	Weight  float64 `json:"weight"`
+}
+
+type TargetsListResponse struct {
+	Targets []TargetResponse `json:"targets"`
+	Total   int              `json:"total"`
+}
+
+type TradeLegResponse struct {
+	DefinitionID string  `json:"definitionId"`
+	Symbol       string  `json:"symbol"`
+	Quantity     float64 `json:"quantity"`
+	Amount       float64 `json:"amount"`
+}
+
+type RebalanceSuggestionResponse struct {
+	Key           string             `json:"key"`
+	Label         string             `json:"label"`
+	CurrentValue  float64            `json:"currentValue"`
+	CurrentWeight float64            `json:"currentWeight"`
+	TargetWeight  float64            `json:"targetWeight"`
+	Drift         float64            `json:"drift"`
+	OutOfBand     bool               `json:"outOfBand"`
+	Action        string             `json:"action"`
+	Amount        float64            `json:"amount"`
+	Legs          []TradeLegResponse `json:"legs"`
+}
+
+type RebalancePlanResponse struct {
+	BaseCurrency   string                        `json:"baseCurrency"`
+	GroupBy        string                        `json:"groupBy"`
+	Tolerance      float64                       `json:"tolerance"`
+	NoSell         bool                          `json:"noSell"`
+	Total          float64                       `json:"total"`
+	Cash           float64                       `json:"cash"`
+	NeedsRebalance bool                          `json:"needsRebalance"`
+	TotalBuys      float64                       `json:"totalBuys"`
+	TotalSells     float64                       `json:"totalSells"`
+	NetCashFlow    float64                       `json:"netCashFlow"`
+	Unpriced       int                           `json:"unpriced"`
+	Suggestions    []RebalanceSuggestionResponse `json:"suggestions"`
+}
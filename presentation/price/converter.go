@@ -0,0 +1,26 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/price"
+
+func ToPriceResponse(p *price.Price) PriceResponse {
+	return PriceResponse{
+		ID:            p.ID.String(),
+		DefinitionID:  p.DefinitionID.String(),
+		QuoteCurrency: p.QuoteCurrency,
+		PricedAt:      p.PricedAt,
+		Price:         p.Price,
+		Source:        p.Source,
+	}
+}
+
+func ToPriceListResponse(prices []*price.Price) PriceListResponse {
+	var responses []PriceResponse
+	for _, p := range prices {
+		responses = append(responses, ToPriceResponse(p))
+	}
+
+	return PriceListResponse{
+		Prices: responses,
+		Total:  len(responses),
+	}
+}
@@ -0,0 +1,31 @@
+package presentation
+
+import (
+	"time"
+)
+
+type PriceItemRequest struct {
+	DefinitionID  string  `json:"definitionId" validate:"required"`
+	QuoteCurrency string  `json:"quoteCurrency" validate:"required"`
+	PricedAt      int64   `json:"pricedAt" validate:"required"`
+	Price         float64 `json:"price" validate:"required"`
+	Source        string  `json:"source"`
+}
+
+type RecordPricesRequest struct {
+	Prices []PriceItemRequest `json:"prices" validate:"required"`
+}
+
+type PriceResponse struct {
+	ID            string    `json:"id"`
+	DefinitionID  string    `json:"definitionId"`
+	QuoteCurrency string    `json:"quoteCurrency"`
+	PricedAt      time.Time `json:"pricedAt"`
+	Price         float64   `json:"price"`
+	Source        string    `json:"source"`
+}
+
+type PriceListResponse struct {
+	Prices []PriceResponse `json:"prices"`
+	Total  int             `json:"total"`
+}
@@ -0,0 +1,75 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/realestate"
+
+func ToPropertyResponse(p *realestate.Property) PropertyResponse {
+	var mortgageLoanID *string
+	if p.MortgageLoanID != nil {
+		id := p.MortgageLoanID.String()
+		mortgageLoanID = &id
+	}
+
+	return PropertyResponse{
+		ID:             p.ID.String(),
+		AssetID:        p.AssetID.String(),
+		Currency:       p.Currency,
+		PurchasePrice:  p.PurchasePrice,
+		PurchaseCosts:  p.PurchaseCosts,
+		CostBasis:      p.CostBasis(),
+		PurchaseDate:   p.PurchaseDate,
+		MortgageLoanID: mortgageLoanID,
+		CreatedAt:      p.CreatedAt,
+		UpdatedAt:      p.UpdatedAt,
+	}
+}
+
+func ToAppraisalResponse(a *realestate.Appraisal) AppraisalResponse {
+	return AppraisalResponse{
+		ID:          a.ID.String(),
+		PropertyID:  a.PropertyID.String(),
+		Value:       a.Value,
+		AppraisedAt: a.AppraisedAt,
+		Source:      a.Source,
+		Notes:       a.Notes,
+		CreatedAt:   a.CreatedAt,
+	}
+}
+
+func ToExpenseResponse(e *realestate.Expense) ExpenseResponse {
+	return ExpenseResponse{
+		ID:         e.ID.String(),
+		PropertyID: e.PropertyID.String(),
+		Category:   string(e.Category),
+		Amount:     e.Amount,
+		PaidAt:     e.PaidAt,
+		Notes:      e.Notes,
+		CreatedAt:  e.CreatedAt,
+	}
+}
+
+func ToPerformanceResponse(p *realestate.Performance) PerformanceResponse {
+	return PerformanceResponse{
+		AsOf:                   p.AsOf,
+		Currency:               p.Currency,
+		PurchasePrice:          p.PurchasePrice,
+		PurchaseCosts:          p.PurchaseCosts,
+		CostBasis:              p.CostBasis,
+		CurrentValue:           p.CurrentValue,
+		ValueSource:            string(p.ValueSource),
+		ValuedAt:               p.ValuedAt,
+		YearsHeld:              p.YearsHeld,
+		Appreciation:           p.Appreciation,
+		AppreciationPercent:    p.AppreciationPercent,
+		AnnualizedAppreciation: p.AnnualizedAppreciation,
+		MortgageBalance:        p.MortgageBalance,
+		Equity:                 p.Equity,
+		LoanToValue:            p.LoanToValue,
+		AnnualRent:             p.AnnualRent,
+		AnnualExpenses:         p.AnnualExpenses,
+		NetOperatingIncome:     p.NetOperatingIncome,
+		GrossYield:             p.GrossYield,
+		NetYield:               p.NetYield,
+		NetYieldOnCost:         p.NetYieldOnCost,
+		UnconvertedRent:        p.UnconvertedRent,
+	}
+}
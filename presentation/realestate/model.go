@@ -0,0 +1,111 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/realestate"
+)
+
+type CreatePropertyRequest struct {
+	AssetID        string  `json:"assetId" validate:"required"`
+	Currency       string  `json:"currency"`
+	PurchasePrice  float64 `json:"purchasePrice" validate:"required"`
+	PurchaseCosts  float64 `json:"purchaseCosts"`
+	PurchaseDate   int64   `json:"purchaseDate" validate:"required"`
+	MortgageLoanID *string `json:"mortgageLoanId,omitempty"`
+}
+
+type UpdatePropertyRequest struct {
+	Currency       string  `json:"currency" validate:"required"`
+	PurchasePrice  float64 `json:"purchasePrice" validate:"required"`
+	PurchaseCosts  float64 `json:"purchaseCosts"`
+	PurchaseDate   int64   `json:"purchaseDate" validate:"required"`
+	MortgageLoanID *string `json:"mortgageLoanId,omitempty"`
+}
+
+type PropertyResponse struct {
+	ID             string    `json:"id"`
+	AssetID        string    `json:"assetId"`
+	Currency       string    `json:"currency"`
+	PurchasePrice  float64   `json:"purchasePrice"`
+	PurchaseCosts  float64   `json:"purchaseCosts"`
+	CostBasis      float64   `json:"costBasis"`
+	PurchaseDate   time.Time `json:"purchaseDate"`
+	MortgageLoanID *string   `json:"mortgageLoanId,omitempty"`
+	CreatedAt      time.Time `json:"createdAt"`
+	UpdatedAt      time.Time `json:"updatedAt"`
+}
+
+type PropertiesListResponse struct {
+	Properties []PropertyResponse `json:"properties"`
+	Total      int                `json:"total"`
+}
+
+type AddAppraisalRequest struct {
+	Value       float64 `json:"value" validate:"required"`
+	AppraisedAt int64   `json:"appraisedAt" validate:"required"`
+	Source      string  `json:"source"`
+	Notes       string  `json:"notes"`
+}
+
+type AppraisalResponse struct {
+	ID          string    `json:"id"`
+	PropertyID  string    `json:"propertyId"`
+	Value       float64   `json:"value"`
+	AppraisedAt time.Time `json:"appraisedAt"`
+	Source      string    `json:"source"`
+	Notes       string    `json:"notes"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+type AppraisalsListResponse struct {
+	Appraisals []AppraisalResponse `json:"appraisals"`
+	Total      int                 `json:"total"`
+}
+
+type AddExpenseRequest struct {
+	Category realestate.ExpenseCategory `json:"category" validate:"required"`
+	Amount   float64                    `json:"amount" validate:"required"`
+	PaidAt   int64                      `json:"paidAt" validate:"required"`
+	Notes    string                     `json:"notes"`
+}
+
+type ExpenseResponse struct {
+	ID         string    `json:"id"`
+	PropertyID string    `json:"propertyId"`
+	Category   string    `json:"category"`
+	Amount     float64   `json:"amount"`
+	PaidAt     time.Time `json:"paidAt"`
+	Notes      string    `json:"notes"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ExpensesListResponse struct {
+	Expenses []ExpenseResponse `json:"expenses"`
+	Total    int               `json:"total"`
+}
+
+type PerformanceResponse struct {
+	AsOf                   time.Time `json:"asOf"`
+	Currency               string    `json:"currency"`
+	PurchasePrice          float64   `json:"purchasePrice"`
+	PurchaseCosts          float64   `json:"purchaseCosts"`
+	CostBasis              float64   `json:"costBasis"`
+	CurrentValue           float64   `json:"currentValue"`
+	ValueSource            string    `json:"valueSource"`
+	ValuedAt               time.Time `json:"valuedAt"`
+	YearsHeld              float64   `json:"yearsHeld"`
+	Appreciation           float64   `json:"appreciation"`
+	AppreciationPercent    float64   `json:"appreciationPercent"`
+	AnnualizedAppreciation float64   `json:"annualizedAppreciation"`
+	MortgageBalance        float64   `json:"mortgageBalance"`
+	Equity                 float64   `json:"equity"`
+	LoanToValue            float64   `json:"loanToValue"`
+	AnnualRent             float64   `json:"annualRent"`
+	AnnualExpenses         float64   `json:"annualExpenses"`
+	NetOperatingIncome     float64   `json:"netOperatingIncome"`
+	GrossYield             float64   `json:"grossYield"`
+	NetYield               float64   `json:"netYield"`
+	NetYieldOnCost         float64   `json:"netYieldOnCost"`
+	UnconvertedRent        int       `json:"unconvertedRent"`
+}
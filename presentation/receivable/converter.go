@@ -0,0 +1,84 @@
+package presentation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"siyahsensei/wallet-service/domain/receivable"
+)
+
+// ToReceivableResponse derives the status and days overdue as of the given date.
+func ToReceivableResponse(r *receivable.Receivable, asOf time.Time) ReceivableResponse {
+	daysOverdue := 0
+	if r.StatusAt(asOf) == receivable.Overdue {
+		daysOverdue = r.DaysOverdue(asOf)
+	}
+
+	return ReceivableResponse{
+		ID:                  r.ID.String(),
+		AssetID:             r.AssetID.String(),
+		CounterpartyName:    r.CounterpartyName,
+		CounterpartyContact: r.CounterpartyContact,
+		Amount:              r.Amount,
+		Currency:            r.Currency,
+		IssueDate:           r.IssueDate,
+		DueDate:             r.DueDate,
+		Repaid:              r.Repaid,
+		WrittenOff:          r.WrittenOff,
+		WrittenOffAt:        r.WrittenOffAt,
+		Outstanding:         r.Outstanding(),
+		Status:              string(r.StatusAt(asOf)),
+		DaysOverdue:         daysOverdue,
+		CreatedAt:           r.CreatedAt,
+		UpdatedAt:           r.UpdatedAt,
+	}
+}
+
+func ToRepaymentResponse(r *receivable.Repayment) RepaymentResponse {
+	return RepaymentResponse{
+		ID:            r.ID.String(),
+		ReceivableID:  r.ReceivableID.String(),
+		RepaidAt:      r.RepaidAt,
+		Amount:        r.Amount,
+		ToAssetID:     optionalID(r.ToAssetID),
+		TransactionID: optionalID(r.TransactionID),
+		Notes:         r.Notes,
+		CreatedAt:     r.CreatedAt,
+	}
+}
+
+func ToAgingBucketResponse(b *receivable.AgingBucket) AgingBucketResponse {
+	return AgingBucketResponse{
+		Label:       b.Label,
+		MinDays:     b.MinDays,
+		MaxDays:     b.MaxDays,
+		Count:       b.Count,
+		Outstanding: b.Outstanding,
+	}
+}
+
+func ToAgingResponse(a *receivable.Aging) AgingResponse {
+	var buckets []AgingBucketResponse
+	for _, b := range a.Buckets {
+		buckets = append(buckets, ToAgingBucketResponse(b))
+	}
+
+	return AgingResponse{
+		AsOf:             a.AsOf,
+		BaseCurrency:     a.BaseCurrency,
+		Current:          ToAgingBucketResponse(a.Current),
+		Buckets:          buckets,
+		TotalOverdue:     a.TotalOverdue,
+		TotalOutstanding: a.TotalOutstanding,
+		Unconverted:      a.Unconverted,
+	}
+}
+
+func optionalID(id *uuid.UUID) *string {
+	if id == nil {
+		return nil
+	}
+	value := id.String()
+	return &value
+}
@@ -0,0 +1,92 @@
+package presentation
+
+import (
+	"time"
+)
+
+type CreateReceivableRequest struct {
+	AssetID             string  `json:"assetId" validate:"required"`
+	CounterpartyName    string  `json:"counterpartyName" validate:"required"`
+	CounterpartyContact string  `json:"counterpartyContact"`
+	Amount              float64 `json:"amount"`
+	IssueDate           int64   `json:"issueDate"`
+	DueDate             int64   `json:"dueDate" validate:"required"`
+}
+
+type UpdateReceivableRequest struct {
+	CounterpartyName    string  `json:"counterpartyName" validate:"required"`
+	CounterpartyContact string  `json:"counterpartyContact"`
+	Amount              float64 `json:"amount" validate:"required"`
+	IssueDate           int64   `json:"issueDate"`
+	DueDate             int64   `json:"dueDate" validate:"required"`
+}
+
+type ReceivableResponse struct {
+	ID                  string     `json:"id"`
+	AssetID             string     `json:"assetId"`
+	CounterpartyName    string     `json:"counterpartyName"`
+	CounterpartyContact string     `json:"counterpartyContact"`
+	Amount              float64    `json:"amount"`
+	Currency            string     `json:"currency"`
+	IssueDate           time.Time  `json:"issueDate"`
+	DueDate             time.Time  `json:"dueDate"`
+	Repaid              float64    `json:"repaid"`
+	WrittenOff          float64    `json:"writtenOff"`
+	WrittenOffAt        *time.Time `json:"writtenOffAt,omitempty"`
+	Outstanding         float64    `json:"outstanding"`
+	Status              string     `json:"status"`
+	DaysOverdue         int        `json:"daysOverdue"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+type ReceivablesListResponse struct {
+	Receivables []ReceivableResponse `json:"receivables"`
+	Total       int                  `json:"total"`
+}
+
+type RecordRepaymentRequest struct {
+	Amount    float64 `json:"amount" validate:"required"`
+	RepaidAt  int64   `json:"repaidAt" validate:"required"`
+	ToAssetID *string `json:"toAssetId,omitempty"`
+	Notes     string  `json:"notes"`
+}
+
+type WriteOffRequest struct {
+	Date  int64  `json:"date"`
+	Notes string `json:"notes"`
+}
+
+type RepaymentResponse struct {
+	ID            string    `json:"id"`
+	ReceivableID  string    `json:"receivableId"`
+	RepaidAt      time.Time `json:"repaidAt"`
+	Amount        float64   `json:"amount"`
+	ToAssetID     *string   `json:"toAssetId,omitempty"`
+	TransactionID *string   `json:"transactionId,omitempty"`
+	Notes         string    `json:"notes"`
+	CreatedAt     time.Time `json:"createdAt"`
+}
+
+type RepaymentsListResponse struct {
+	Repayments []RepaymentResponse `json:"repayments"`
+	Total      int                 `json:"total"`
+}
+
+type AgingBucketResponse struct {
+	Label       string  `json:"label"`
+	MinDays     int     `json:"minDays"`
+	MaxDays     *int    `json:"maxDays,omitempty"`
+	Count       int     `json:"count"`
+	Outstanding float64 `json:"outstanding"`
+}
+
+type AgingResponse struct {
+	AsOf             time.Time             `json:"asOf"`
+	BaseCurrency     string                `json:"baseCurrency"`
+	Current          AgingBucketResponse   `json:"current"`
+	Buckets          []AgingBucketResponse `json:"buckets"`
+	TotalOverdue     float64               `json:"totalOverdue"`
+	TotalOutstanding float64               `json:"totalOutstanding"`
+	Unconverted      int                   `json:"unconverted"`
+}
@@ -0,0 +1,56 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/recurring"
+
+func ToRuleResponse(r *recurring.Rule) RuleResponse {
+	return RuleResponse{
+		ID:              r.ID.String(),
+		AccountID:       r.AccountID.String(),
+		DefinitionID:    r.DefinitionID.String(),
+		Name:            r.Name,
+		AssetType:       string(r.AssetType),
+		TransactionType: string(r.TransactionType),
+		Amount:          r.Amount,
+		Price:           r.Price,
+		Currency:        r.Currency,
+		Frequency:       string(r.Frequency),
+		Interval:        r.Interval,
+		DayOfMonth:      r.DayOfMonth,
+		DayOfWeek:       r.DayOfWeek,
+		MonthOfYear:     r.MonthOfYear,
+		StartDate:       r.StartDate,
+		EndDate:         r.EndDate,
+		NextRunAt:       r.NextRunAt,
+		LastRunAt:       r.LastRunAt,
+		Active:          r.Active,
+		Notes:           r.Notes,
+		CreatedAt:       r.CreatedAt,
+		UpdatedAt:       r.UpdatedAt,
+	}
+}
+
+func ToOccurrenceResponse(o *recurring.Occurrence) OccurrenceResponse {
+	var transactionID *string
+	if o.TransactionID != nil {
+		id := o.TransactionID.String()
+		transactionID = &id
+	}
+
+	return OccurrenceResponse{
+		ID:             o.ID.String(),
+		OccurrenceDate: o.OccurrenceDate,
+		TransactionID:  transactionID,
+		Status:         string(o.Status),
+		Error:          o.Error,
+	}
+}
+
+func ToUpcomingOccurrenceResponse(u *recurring.UpcomingOccurrence) UpcomingOccurrenceResponse {
+	return UpcomingOccurrenceResponse{
+		RuleID:          u.RuleID.String(),
+		Name:            u.Name,
+		Date:            u.Date,
+		TransactionType: string(u.TransactionType),
+		Amount:          u.Amount,
+	}
+}
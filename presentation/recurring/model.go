@@ -0,0 +1,99 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/asset"
+	"siyahsensei/wallet-service/domain/recurring"
+	"siyahsensei/wallet-service/domain/transaction"
+)
+
+type CreateRuleRequest struct {
+	AccountID       string                      `json:"accountId" validate:"required"`
+	DefinitionID    string                      `json:"definitionId" validate:"required"`
+	Name            string                      `json:"name" validate:"required"`
+	AssetType       asset.AssetType             `json:"assetType"`
+	TransactionType transaction.TransactionType `json:"transactionType" validate:"required"`
+	Amount          float64                     `json:"amount" validate:"required"`
+	Price           float64                     `json:"price"`
+	Currency        string                      `json:"currency"`
+	Frequency       recurring.Frequency         `json:"frequency" validate:"required"`
+	Interval        int                         `json:"interval"`
+	DayOfMonth      int                         `json:"dayOfMonth"`
+	DayOfWeek       int                         `json:"dayOfWeek"`
+	MonthOfYear     int                         `json:"monthOfYear"`
+	StartDate       int64                       `json:"startDate" validate:"required"`
+	EndDate         *int64                      `json:"endDate,omitempty"`
+	Notes           string                      `json:"notes"`
+}
+
+type UpdateRuleRequest struct {
+	Name        string              `json:"name" validate:"required"`
+	Amount      float64             `json:"amount" validate:"required"`
+	Price       float64             `json:"price"`
+	Currency    string              `json:"currency"`
+	Frequency   recurring.Frequency `json:"frequency" validate:"required"`
+	Interval    int                 `json:"interval"`
+	DayOfMonth  int                 `json:"dayOfMonth"`
+	DayOfWeek   int                 `json:"dayOfWeek"`
+	MonthOfYear int                 `json:"monthOfYear"`
+	EndDate     *int64              `json:"endDate,omitempty"`
+	Active      bool                `json:"active"`
+	Notes       string              `json:"notes"`
+}
+
+type RuleResponse struct {
+	ID              string     `json:"id"`
+	AccountID       string     `json:"accountId"`
+	DefinitionID    string     `json:"definitionId"`
+	Name            string     `json:"name"`
+	AssetType       string     `json:"assetType"`
+	TransactionType string     `json:"transactionType"`
+	Amount          float64    `json:"amount"`
+	Price           float64    `json:"price"`
+	Currency        string     `json:"currency"`
+	Frequency       string     `json:"frequency"`
+	Interval        int        `json:"interval"`
+	DayOfMonth      int        `json:"dayOfMonth"`
+	DayOfWeek       int        `json:"dayOfWeek"`
+	MonthOfYear     int        `json:"monthOfYear"`
+	StartDate       time.Time  `json:"startDate"`
+	EndDate         *time.Time `json:"endDate,omitempty"`
+	NextRunAt       *time.Time `json:"nextRunAt,omitempty"`
+	LastRunAt       *time.Time `json:"lastRunAt,omitempty"`
+	Active          bool       `json:"active"`
+	Notes           string     `json:"notes"`
+	CreatedAt       time.Time  `json:"createdAt"`
+	UpdatedAt       time.Time  `json:"updatedAt"`
+}
+
+type RulesListResponse struct {
+	Rules []RuleResponse `json:"rules"`
+	Total int            `json:"total"`
+}
+
+type OccurrenceResponse struct {
+	ID             string    `json:"id"`
+	OccurrenceDate time.Time `json:"occurrenceDate"`
+	TransactionID  *string   `json:"transactionId,omitempty"`
+	Status         string    `json:"status"`
+	Error          string    `json:"error,omitempty"`
+}
+
+type OccurrencesListResponse struct {
+	Occurrences []OccurrenceResponse `json:"occurrences"`
+	Total       int                  `json:"total"`
+}
+
+type UpcomingOccurrenceResponse struct {
+	RuleID          string    `json:"ruleId"`
+	Name            string    `json:"name"`
+	Date            time.Time `json:"date"`
+	TransactionType string    `json:"transactionType"`
+	Amount          float64   `json:"amount"`
+}
+
+type PreviewResponse struct {
+	Upcoming []UpcomingOccurrenceResponse `json:"upcoming"`
+	Total    int                          `json:"total"`
+}
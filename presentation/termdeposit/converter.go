@@ -0,0 +1,49 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/termdeposit"
+
+func ToTermDepositResponse(d *termdeposit.TermDeposit) TermDepositResponse {
+	var payoutAccountID *string
+	if d.PayoutAccountID != nil {
+		id := d.PayoutAccountID.String()
+		payoutAccountID = &id
+	}
+
+	return TermDepositResponse{
+		ID:                 d.ID.String(),
+		AssetID:            d.AssetID.String(),
+		Principal:          d.Principal,
+		Currency:           d.Currency,
+		AnnualRate:         d.AnnualRate,
+		Compounding:        string(d.Compounding),
+		StartDate:          d.StartDate,
+		MaturityDate:       d.MaturityDate,
+		WithholdingTaxRate: d.WithholdingTaxRate,
+		MaturityAction:     string(d.MaturityAction),
+		PayoutAccountID:    payoutAccountID,
+		Status:             string(d.Status),
+		Rollovers:          d.Rollovers,
+		MaturedAt:          d.MaturedAt,
+		CreatedAt:          d.CreatedAt,
+		UpdatedAt:          d.UpdatedAt,
+	}
+}
+
+func ToAccrualResponse(a *termdeposit.Accrual) AccrualResponse {
+	return AccrualResponse{
+		AsOf:                   a.AsOf,
+		ElapsedDays:            a.ElapsedDays,
+		TermDays:               a.TermDays,
+		DaysToMaturity:         a.DaysToMaturity,
+		Principal:              a.Principal,
+		AccruedInterest:        a.AccruedInterest,
+		AccruedWithholdingTax:  a.AccruedWithholdingTax,
+		AccruedNetInterest:     a.AccruedNetInterest,
+		CurrentValue:           a.CurrentValue,
+		ExpectedInterest:       a.ExpectedInterest,
+		ExpectedWithholdingTax: a.ExpectedWithholdingTax,
+		ExpectedNetInterest:    a.ExpectedNetInterest,
+		ExpectedPayout:         a.ExpectedPayout,
+		EffectiveAnnualRate:    a.EffectiveAnnualRate,
+	}
+}
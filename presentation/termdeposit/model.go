@@ -0,0 +1,69 @@
+package presentation
+
+import (
+	"time"
+
+	"siyahsensei/wallet-service/domain/termdeposit"
+)
+
+type CreateTermDepositRequest struct {
+	AssetID            string                     `json:"assetId" validate:"required"`
+	Principal          float64                    `json:"principal"`
+	AnnualRate         float64                    `json:"annualRate"`
+	Compounding        termdeposit.Compounding    `json:"compounding"`
+	StartDate          int64                      `json:"startDate" validate:"required"`
+	MaturityDate       int64                      `json:"maturityDate" validate:"required"`
+	WithholdingTaxRate float64                    `json:"withholdingTaxRate"`
+	MaturityAction     termdeposit.MaturityAction `json:"maturityAction"`
+	PayoutAccountID    *string                    `json:"payoutAccountId,omitempty"`
+}
+
+type UpdateTermDepositRequest struct {
+	AnnualRate         float64                    `json:"annualRate"`
+	Compounding        termdeposit.Compounding    `json:"compounding"`
+	MaturityDate       int64                      `json:"maturityDate" validate:"required"`
+	WithholdingTaxRate float64                    `json:"withholdingTaxRate"`
+	MaturityAction     termdeposit.MaturityAction `json:"maturityAction"`
+	PayoutAccountID    *string                    `json:"payoutAccountId,omitempty"`
+}
+
+type TermDepositResponse struct {
+	ID                 string     `json:"id"`
+	AssetID            string     `json:"assetId"`
+	Principal          float64    `json:"principal"`
+	Currency           string     `json:"currency"`
+	AnnualRate         float64    `json:"annualRate"`
+	Compounding        string     `json:"compounding"`
+	StartDate          time.Time  `json:"startDate"`
+	MaturityDate       time.Time  `json:"maturityDate"`
+	WithholdingTaxRate float64    `json:"withholdingTaxRate"`
+	MaturityAction     string     `json:"maturityAction"`
+	PayoutAccountID    *string    `json:"payoutAccountId,omitempty"`
+	Status             string     `json:"status"`
+	Rollovers          int        `json:"rollovers"`
+	MaturedAt          *time.Time `json:"maturedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+type TermDepositsListResponse struct {
+	TermDeposits []TermDepositResponse `json:"termDeposits"`
+	Total        int                   `json:"total"`
+}
+
+type AccrualResponse struct {
+	AsOf                   time.Time `json:"asOf"`
+	ElapsedDays            int       `json:"elapsedDays"`
+	TermDays               int       `json:"termDays"`
+	DaysToMaturity         int       `json:"daysToMaturity"`
+	Principal              float64   `json:"principal"`
+	AccruedInterest        float64   `json:"accruedInterest"`
+	AccruedWithholdingTax  float64   `json:"accruedWithholdingTax"`
+	AccruedNetInterest     float64   `json:"accruedNetInterest"`
+	CurrentValue           float64   `json:"currentValue"`
+	ExpectedInterest       float64   `json:"expectedInterest"`
+	ExpectedWithholdingTax float64   `json:"expectedWithholdingTax"`
+	ExpectedNetInterest    float64   `json:"expectedNetInterest"`
+	ExpectedPayout         float64   `json:"expectedPayout"`
+	EffectiveAnnualRate    float64   `json:"effectiveAnnualRate"`
+}
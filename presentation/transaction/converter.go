@@ -0,0 +1,26 @@
+package presentation
+
+import "siyahsensei/wallet-service/domain/transaction"
+
+func ToTransactionResponse(t *transaction.Transaction) TransactionResponse {
+	var transferID *string
+	if t.TransferID != nil {
+		id := t.TransferID.String()
+		transferID = &id
+	}
+
+	return TransactionResponse{
+		ID:              t.ID.String(),
+		UserID:          t.UserID.String(),
+		AssetID:         t.AssetID.String(),
+		Type:            string(t.Type),
+		Quantity:        t.Quantity,
+		Price:           t.Price,
+		Currency:        t.Currency,
+		Notes:           t.Notes,
+		TransferID:      transferID,
+		TransactionDate: t.TransactionDate,
+		CreatedAt:       t.CreatedAt,
+		UpdatedAt:       t.UpdatedAt,
+	}
+}
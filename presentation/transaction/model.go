@@ -0,0 +1,36 @@
+package presentation
+
+import (
+	"siyahsensei/wallet-service/domain/transaction"
+	"time"
+)
+
+type CreateTransactionRequest struct {
+	AssetID         string                      `json:"assetId" validate:"required"`
+	Type            transaction.TransactionType `json:"type" validate:"required"`
+	Quantity        float64                     `json:"quantity" validate:"required"`
+	Price           float64                     `json:"price"`
+	Currency        string                      `json:"currency"`
+	Notes           string                      `json:"notes"`
+	TransactionDate int64                       `json:"transactionDate" validate:"required"`
+}
+
+type TransactionResponse struct {
+	ID              string    `json:"id"`
+	UserID          string    `json:"userId"`
+	AssetID         string    `json:"assetId"`
+	Type            string    `json:"type"`
+	Quantity        float64   `json:"quantity"`
+	Price           float64   `json:"price"`
+	Currency        string    `json:"currency"`
+	Notes           string    `json:"notes"`
+	TransferID      *string   `json:"transferId,omitempty"`
+	TransactionDate time.Time `json:"transactionDate"`
+	CreatedAt       time.Time `json:"createdAt"`
+	UpdatedAt       time.Time `json:"updatedAt"`
+}
+
+type TransactionsListResponse struct {
+	Transactions []TransactionResponse `json:"transactions"`
+	Total        int                   `json:"total"`
+}
@@ -0,0 +1,58 @@
+package presentation
+
+import (
+	"encoding/json"
+
+	"siyahsensei/wallet-service/domain/webhook"
+)
+
+func ToWebhookResponse(s *webhook.Subscription) WebhookResponse {
+	return WebhookResponse{
+		ID:          s.ID.String(),
+		URL:         s.URL,
+		EventTypes:  s.EventTypes,
+		Description: s.Description,
+		Enabled:     s.Enabled,
+		CreatedAt:   s.CreatedAt,
+		UpdatedAt:   s.UpdatedAt,
+	}
+}
+
+// ToCreatedWebhookResponse includes the signing secret, which is not shown again.
+func ToCreatedWebhookResponse(s *webhook.Subscription) WebhookResponse {
+	response := ToWebhookResponse(s)
+	response.Secret = s.Secret
+	return response
+}
+
+func ToWebhookDeliveryResponse(d *webhook.Delivery) WebhookDeliveryResponse {
+	var redeliveryOf *string
+	if d.RedeliveryOf != nil {
+		value := d.RedeliveryOf.String()
+		redeliveryOf = &value
+	}
+
+	return WebhookDeliveryResponse{
+		ID:             d.ID.String(),
+		WebhookID:      d.SubscriptionID.String(),
+		EventID:        d.EventID.String(),
+		EventType:      string(d.EventType),
+		Status:         string(d.Status),
+		Attempts:       d.Attempts,
+		NextAttemptAt:  d.NextAttemptAt,
+		LastAttemptAt:  d.LastAttemptAt,
+		ResponseStatus: d.ResponseStatus,
+		ResponseBody:   d.ResponseBody,
+		Error:          d.Error,
+		DurationMs:     d.DurationMs,
+		RedeliveryOf:   redeliveryOf,
+		CreatedAt:      d.CreatedAt,
+	}
+}
+
+// ToWebhookDeliveryDetailResponse also includes the payload that was posted.
+func ToWebhookDeliveryDetailResponse(d *webhook.Delivery) WebhookDeliveryResponse {
+	response := ToWebhookDeliveryResponse(d)
+	response.Payload = json.RawMessage(d.Payload)
+	return response
+}
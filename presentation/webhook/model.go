@@ -0,0 +1,65 @@
+package presentation
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type CreateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required"`
+	EventTypes  []string `json:"eventTypes" validate:"required"`
+	Secret      string   `json:"secret"`
+	Description string   `json:"description"`
+}
+
+type UpdateWebhookRequest struct {
+	URL         string   `json:"url" validate:"required"`
+	EventTypes  []string `json:"eventTypes" validate:"required"`
+	Secret      string   `json:"secret"`
+	Description string   `json:"description"`
+	Enabled     *bool    `json:"enabled,omitempty"`
+}
+
+type WebhookResponse struct {
+	ID          string   `json:"id"`
+	URL         string   `json:"url"`
+	EventTypes  []string `json:"eventTypes"`
+	Description string   `json:"description"`
+	Enabled     bool     `json:"enabled"`
+	// Secret is only returned when the webhook is created
+	Secret    string    `json:"secret,omitempty"`
+	CreatedAt time.Time `json:"createdAt"`
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+type WebhooksListResponse struct {
+	Webhooks []WebhookResponse `json:"webhooks"`
+	Total    int               `json:"total"`
+}
+
+type EventTypesResponse struct {
+	EventTypes []string `json:"eventTypes"`
+}
+
+type WebhookDeliveryResponse struct {
+	ID             string          `json:"id"`
+	WebhookID      string          `json:"webhookId"`
+	EventID        string          `json:"eventId"`
+	EventType      string          `json:"eventType"`
+	Status         string          `json:"status"`
+	Attempts       int             `json:"attempts"`
+	NextAttemptAt  *time.Time      `json:"nextAttemptAt,omitempty"`
+	LastAttemptAt  *time.Time      `json:"lastAttemptAt,omitempty"`
+	ResponseStatus *int            `json:"responseStatus,omitempty"`
+	ResponseBody   string          `json:"responseBody,omitempty"`
+	Error          string          `json:"error,omitempty"`
+	DurationMs     int64           `json:"durationMs"`
+	RedeliveryOf   *string         `json:"redeliveryOf,omitempty"`
+	Payload        json.RawMessage `json:"payload,omitempty" swaggertype:"object"`
+	CreatedAt      time.Time       `json:"createdAt"`
+}
+
+type WebhookDeliveriesListResponse struct {
+	Deliveries []WebhookDeliveryResponse `json:"deliveries"`
+	Total      int                       `json:"total"`
+}